@@ -7,6 +7,7 @@ import (
 	"time"
 
 	itemDomain "github.com/minminseo/recall-setter/domain/item"
+	patternDomain "github.com/minminseo/recall-setter/domain/pattern"
 	userDomain "github.com/minminseo/recall-setter/domain/user"
 
 	userController "github.com/minminseo/recall-setter/controller/user"
@@ -24,6 +25,9 @@ import (
 	itemController "github.com/minminseo/recall-setter/controller/item"
 	itemUsecase "github.com/minminseo/recall-setter/usecase/item"
 
+	tagController "github.com/minminseo/recall-setter/controller/tag"
+	tagUsecase "github.com/minminseo/recall-setter/usecase/tag"
+
 	"github.com/minminseo/recall-setter/infrastructure/auth"
 	"github.com/minminseo/recall-setter/infrastructure/db"
 	"github.com/minminseo/recall-setter/infrastructure/mailer"
@@ -72,7 +76,11 @@ func main() {
 	tokenGenerator := auth.NewJWTGenerator()
 
 	// ドメインサービス
-	scheduler := itemDomain.NewScheduler()
+	schedulerRegistry := itemDomain.NewSchedulerRegistry(itemDomain.NewScheduler())
+	schedulerRegistry.Register(patternDomain.SchedulerKindFixedSteps, itemDomain.NewScheduler())
+	schedulerRegistry.Register(patternDomain.SchedulerKindAdaptive, itemDomain.NewAdaptiveScheduler())
+	schedulerRegistry.Register(patternDomain.SchedulerKindFSRS, itemDomain.NewFSRSScheduler())
+	schedulerRegistry.Register(patternDomain.SchedulerKindLeitner, itemDomain.NewLeitnerScheduler())
 
 	// リポジトリ
 	userRepository := repository.NewUserRepository()
@@ -81,13 +89,15 @@ func main() {
 	boxRepository := repository.NewBoxRepository()
 	patternRepository := repository.NewPatternRepository()
 	itemRepository := repository.NewItemRepository()
+	tagRepository := repository.NewTagRepository()
 
 	// ユースケース
 	userUsecase := userUsecase.NewUserUsecase(userRepository, emailVerificationRepository, transactionManager, cryptoService, hasher, emailSender, tokenGenerator)
 	categoryUsecase := categoryUsecase.NewCategoryUsecase(categoryRepository)
 	boxUsecase := boxUsecase.NewBoxUsecase(boxRepository)
-	patternUsecase := patternUsecase.NewPatternUsecase(patternRepository, itemRepository, transactionManager)
-	itemUsecase := itemUsecase.NewItemUsecase(categoryRepository, boxRepository, itemRepository, patternRepository, transactionManager, scheduler)
+	patternUsecase := patternUsecase.NewPatternUsecase(patternRepository, itemRepository, userRepository, transactionManager)
+	itemUsecase := itemUsecase.NewItemUsecase(categoryRepository, boxRepository, itemRepository, patternRepository, userRepository, transactionManager, schedulerRegistry)
+	tagUsecase := tagUsecase.NewTagUsecase(tagRepository, transactionManager)
 
 	// コントローラー
 	userController := userController.NewUserController(userUsecase)
@@ -95,8 +105,9 @@ func main() {
 	boxController := boxController.NewBoxController(boxUsecase)
 	patternController := patternController.NewPatternController(patternUsecase)
 	itemController := itemController.NewItemController(itemUsecase)
+	tagController := tagController.NewTagController(tagUsecase)
 
-	e := router.NewRouter(userController, categoryController, boxController, patternController, itemController)
+	e := router.NewRouter(userController, categoryController, boxController, patternController, itemController, tagController)
 
 	port := os.Getenv("PORT")
 	e.Logger.Fatal(e.Start(":" + port))
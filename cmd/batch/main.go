@@ -44,6 +44,11 @@ func executeBatch(uc batchUsecase.IBatchUsecase, t time.Time) {
 		slog.Error("バッチ処理中にエラーが発生しました。", "error", err)
 		return
 	}
+
+	if err := uc.ExecuteDeleteExpiredItemOperations(ctx); err != nil {
+		slog.Error("バッチ処理中にエラーが発生しました。", "error", err)
+		return
+	}
 }
 
 // IANAのタイムゾーンはUTCからのオフセットが全部15分単位なので、0, 15, 30, 45分のタイミングで実行
@@ -3,7 +3,10 @@ package item
 import (
 	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	itemDomain "github.com/minminseo/recall-setter/domain/item"
@@ -49,6 +52,7 @@ func (ic *itemController) CreateItem(c echo.Context) error {
 	}
 
 	input := itemUsecase.CreateItemInput{
+		ItemID:                   req.ItemID,
 		UserID:                   userID,
 		CategoryID:               req.CategoryID,
 		BoxID:                    req.BoxID,
@@ -62,6 +66,12 @@ func (ic *itemController) CreateItem(c echo.Context) error {
 
 	out, err := ic.iu.CreateItem(ctx, input)
 	if err != nil {
+		if isInvalidCreateItemInput(err) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		if errors.Is(err, itemDomain.ErrItemAlreadyExists) {
+			return c.JSON(http.StatusConflict, echo.Map{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習物の作成に失敗しました: " + err.Error()})
 	}
 	reviewDates := make([]ReviewDateResponse, len(out.Reviewdates))
@@ -78,18 +88,19 @@ func (ic *itemController) CreateItem(c echo.Context) error {
 	}
 
 	res := ItemResponse{
-		ItemID:       out.ItemID,
-		UserID:       out.UserID,
-		CategoryID:   out.CategoryID,
-		BoxID:        out.BoxID,
-		PatternID:    out.PatternID,
-		Name:         out.Name,
-		Detail:       out.Detail,
-		LearnedDate:  out.LearnedDate,
-		IsFinished:   out.IsCompleted,
-		RegisteredAt: out.RegisteredAt,
-		EditedAt:     out.EditedAt,
-		ReviewDates:  reviewDates,
+		ItemID:         out.ItemID,
+		UserID:         out.UserID,
+		CategoryID:     out.CategoryID,
+		BoxID:          out.BoxID,
+		PatternID:      out.PatternID,
+		PatternVersion: out.PatternVersion,
+		Name:           out.Name,
+		Detail:         out.Detail,
+		LearnedDate:    out.LearnedDate,
+		IsFinished:     out.IsCompleted,
+		RegisteredAt:   out.RegisteredAt,
+		EditedAt:       out.EditedAt,
+		ReviewDates:    reviewDates,
 	}
 
 	return c.JSON(http.StatusCreated, res)
@@ -145,23 +156,104 @@ func (ic *itemController) UpdateItem(c echo.Context) error {
 	}
 
 	res := ItemResponse{
-		ItemID:      out.ItemID,
-		UserID:      out.UserID,
-		CategoryID:  out.CategoryID,
-		BoxID:       out.BoxID,
-		PatternID:   out.PatternID,
-		Name:        out.Name,
-		Detail:      out.Detail,
-		LearnedDate: out.LearnedDate,
-		IsFinished:  out.IsFinished,
-		EditedAt:    out.EditedAt,
-		ReviewDates: reviewDates,
+		ItemID:         out.ItemID,
+		UserID:         out.UserID,
+		CategoryID:     out.CategoryID,
+		BoxID:          out.BoxID,
+		PatternID:      out.PatternID,
+		PatternVersion: out.PatternVersion,
+		Name:           out.Name,
+		Detail:         out.Detail,
+		LearnedDate:    out.LearnedDate,
+		IsFinished:     out.IsFinished,
+		EditedAt:       out.EditedAt,
+		ReviewDates:    reviewDates,
 	}
 
 	return c.JSON(http.StatusOK, res)
 
 }
 
+// 復習物作成のプレビュー（永続化しない）
+func (ic *itemController) PreviewCreateItem(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+
+	var req CreateItemRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+
+	input := itemUsecase.CreateItemInput{
+		ItemID:                   req.ItemID,
+		UserID:                   userID,
+		CategoryID:               req.CategoryID,
+		BoxID:                    req.BoxID,
+		PatternID:                req.PatternID,
+		Name:                     req.Name,
+		Detail:                   req.Detail,
+		LearnedDate:              req.LearnedDate,
+		IsMarkOverdueAsCompleted: req.IsMarkOverdueAsCompleted,
+		Today:                    req.Today,
+	}
+
+	out, err := ic.iu.PreviewCreateItem(ctx, input)
+	if err != nil {
+		if isInvalidCreateItemInput(err) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習物作成のプレビューに失敗しました: " + err.Error()})
+	}
+	return c.JSON(http.StatusOK, mapToPreviewItemScheduleResponse(out))
+}
+
+// 復習物の作成・作成のプレビューで、入力値の誤りによるエラーかどうか（復習物名・学習日の検証エラー、日付の形式の誤り、不正な復習物ID）
+func isInvalidCreateItemInput(err error) bool {
+	var validationErr validation.Error
+	var parseErr *time.ParseError
+	return errors.As(err, &validationErr) || errors.As(err, &parseErr) || errors.Is(err, itemDomain.ErrInvalidItemID)
+}
+
+// 復習物更新のプレビュー（永続化しない）
+func (ic *itemController) PreviewUpdateItem(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	itemID := c.Param("item_id")
+
+	var req UpdateItemRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+
+	input := itemUsecase.UpdateItemInput{
+		ItemID:                   itemID,
+		UserID:                   userID,
+		CategoryID:               req.CategoryID,
+		BoxID:                    req.BoxID,
+		PatternID:                req.PatternID,
+		Name:                     req.Name,
+		Detail:                   req.Detail,
+		LearnedDate:              req.LearnedDate,
+		IsMarkOverdueAsCompleted: req.IsMarkOverdueAsCompleted,
+		Today:                    req.Today,
+	}
+
+	out, err := ic.iu.PreviewUpdateItem(ctx, input)
+	if err != nil {
+		if errors.Is(err, itemDomain.ErrNoDiff) || errors.Is(err, itemDomain.ErrHasCompletedReviewDate) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習物更新のプレビューに失敗しました: " + err.Error()})
+	}
+	return c.JSON(http.StatusOK, mapToPreviewItemScheduleResponse(out))
+}
+
 func (ic *itemController) DeleteItem(c echo.Context) error {
 	ctx := c.Request().Context()
 	userID, err := getUserIDFromContext(c)
@@ -176,6 +268,51 @@ func (ic *itemController) DeleteItem(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// 直近の操作を新しい順に取り消す（countを省略した場合は1件）
+func (ic *itemController) UndoItemOperations(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+
+	var req UndoItemOperationsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+	count := 1
+	if req.Count != nil {
+		count = *req.Count
+	}
+
+	input := itemUsecase.UndoItemOperationsInput{
+		UserID: userID,
+		Count:  count,
+	}
+
+	out, err := ic.iu.UndoItemOperations(ctx, input)
+	if err != nil {
+		if errors.Is(err, itemDomain.ErrInvalidUndoCount) || errors.Is(err, itemDomain.ErrNotEnoughItemOperationsToUndo) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		if errors.Is(err, itemDomain.ErrItemOperationReferenceDeleted) {
+			return c.JSON(http.StatusConflict, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "操作の取り消しに失敗しました: " + err.Error()})
+	}
+
+	operations := make([]UndoneItemOperationResponse, len(out.Operations))
+	for i, op := range out.Operations {
+		operations[i] = UndoneItemOperationResponse{
+			OperationID: op.OperationID,
+			Kind:        op.Kind,
+			ItemIDs:     op.ItemIDs,
+			OperatedAt:  op.OperatedAt,
+		}
+	}
+	return c.JSON(http.StatusOK, UndoItemOperationsResponse{Operations: operations})
+}
+
 // 更新系
 func (ic *itemController) UpdateReviewDates(c echo.Context) error {
 	ctx := c.Request().Context()
@@ -275,28 +412,276 @@ func (ic *itemController) UpdateReviewDateAsCompleted(c echo.Context) error {
 	}
 
 	input := itemUsecase.UpdateReviewDateAsCompletedInput{
-		ReviewDateID: reviewDateID,
-		UserID:       userID,
-		ItemID:       itemID,
-		StepNumber:   req.StepNumber,
+		ReviewDateID:    reviewDateID,
+		UserID:          userID,
+		ItemID:          itemID,
+		StepNumber:      req.StepNumber,
+		Grade:           req.Grade,
+		Today:           req.Today,
+		DurationSeconds: req.DurationSeconds,
 	}
 
 	out, err := ic.iu.UpdateReviewDateAsCompleted(ctx, input)
 	if err != nil {
+		if errors.Is(err, itemDomain.ErrInvalidGrade) || errors.Is(err, itemDomain.ErrInvalidDurationSeconds) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習日の完了処理に失敗しました: " + err.Error()})
 	}
+	var reviewDates []ReviewDateResponse
+	if len(out.ReviewDates) > 0 {
+		reviewDates = make([]ReviewDateResponse, len(out.ReviewDates))
+		for i, rd := range out.ReviewDates {
+			reviewDates[i] = ReviewDateResponse{
+				ReviewDateID:         rd.ReviewDateID,
+				UserID:               rd.UserID,
+				CategoryID:           rd.CategoryID,
+				BoxID:                rd.BoxID,
+				ItemID:               rd.ItemID,
+				StepNumber:           rd.StepNumber,
+				InitialScheduledDate: rd.InitialScheduledDate,
+				ScheduledDate:        rd.ScheduledDate,
+				IsCompleted:          rd.IsCompleted,
+			}
+		}
+	}
 	res := UpdateReviewDateAsCompletedResponse{
 		ReviewDateID: out.ReviewDateID,
 		UserID:       out.UserID,
 		IsCompleted:  out.IsCompleted,
 		IsFinished:   out.IsFinished,
 		EditedAt:     out.EditedAt,
+		EaseFactor:   out.EaseFactor,
+		Stability:    out.Stability,
+		Difficulty:   out.Difficulty,
+		ReviewDates:  reviewDates,
 	}
 
 	return c.JSON(http.StatusOK, res)
 
 }
 
+func (ic *itemController) UpdateReviewDateAsFailed(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	itemID := c.Param("item_id")
+	reviewDateID := c.Param("review_date_id")
+
+	var req UpdateReviewDateAsFailedRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+
+	input := itemUsecase.UpdateReviewDateAsFailedInput{
+		ReviewDateID: reviewDateID,
+		UserID:       userID,
+		ItemID:       itemID,
+		Today:        req.Today,
+	}
+
+	out, err := ic.iu.UpdateReviewDateAsFailed(ctx, input)
+	if err != nil {
+		if errors.Is(err, itemDomain.ErrReviewDateNotFound) {
+			return c.JSON(http.StatusNotFound, echo.Map{"error": err.Error()})
+		}
+		if errors.Is(err, itemDomain.ErrReviewDateAlreadyCompleted) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習日の想起失敗処理に失敗しました: " + err.Error()})
+	}
+	reviewDates := make([]ReviewDateResponse, len(out.ReviewDates))
+	for i, rd := range out.ReviewDates {
+		reviewDates[i] = ReviewDateResponse{
+			ReviewDateID:         rd.ReviewDateID,
+			UserID:               rd.UserID,
+			CategoryID:           rd.CategoryID,
+			BoxID:                rd.BoxID,
+			ItemID:               rd.ItemID,
+			StepNumber:           rd.StepNumber,
+			InitialScheduledDate: rd.InitialScheduledDate,
+			ScheduledDate:        rd.ScheduledDate,
+			IsCompleted:          rd.IsCompleted,
+		}
+	}
+	res := UpdateReviewDateAsFailedResponse{
+		ReviewDateID: out.ReviewDateID,
+		UserID:       out.UserID,
+		ItemID:       out.ItemID,
+		EditedAt:     out.EditedAt,
+		ReviewDates:  reviewDates,
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+// 復習日を先送りする（policyを省略した場合はこの復習日だけ）
+func (ic *itemController) SnoozeReviewDate(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	itemID := c.Param("item_id")
+	reviewDateID := c.Param("review_date_id")
+
+	var req SnoozeReviewDateRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+	policy := req.Policy
+	if policy == "" {
+		policy = itemDomain.SnoozePolicyOnlyThis
+	}
+
+	input := itemUsecase.SnoozeReviewDateInput{
+		ReviewDateID: reviewDateID,
+		UserID:       userID,
+		ItemID:       itemID,
+		Days:         req.Days,
+		Policy:       policy,
+	}
+
+	out, err := ic.iu.SnoozeReviewDate(ctx, input)
+	if err != nil {
+		if errors.Is(err, itemDomain.ErrReviewDateNotFound) {
+			return c.JSON(http.StatusNotFound, echo.Map{"error": err.Error()})
+		}
+		if errors.Is(err, itemDomain.ErrInvalidSnoozeDays) ||
+			errors.Is(err, itemDomain.ErrInvalidSnoozePolicy) ||
+			errors.Is(err, itemDomain.ErrSnoozeCompletedReviewDate) ||
+			errors.Is(err, itemDomain.ErrSnoozedReviewDateOutOfOrder) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習日の先送りに失敗しました: " + err.Error()})
+	}
+	reviewDates := make([]ReviewDateResponse, len(out.ReviewDates))
+	for i, rd := range out.ReviewDates {
+		reviewDates[i] = ReviewDateResponse{
+			ReviewDateID:         rd.ReviewDateID,
+			UserID:               rd.UserID,
+			CategoryID:           rd.CategoryID,
+			BoxID:                rd.BoxID,
+			ItemID:               rd.ItemID,
+			StepNumber:           rd.StepNumber,
+			InitialScheduledDate: rd.InitialScheduledDate,
+			ScheduledDate:        rd.ScheduledDate,
+			IsCompleted:          rd.IsCompleted,
+		}
+	}
+	res := SnoozeReviewDateResponse{
+		ReviewDateID: out.ReviewDateID,
+		UserID:       out.UserID,
+		ItemID:       out.ItemID,
+		EditedAt:     out.EditedAt,
+		ReviewDates:  reviewDates,
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+// 今日の復習日をまとめて完了する（範囲も復習日IDも指定しない場合は今日の全ての復習日）
+func (ic *itemController) BulkCompleteReviewDates(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+
+	var req BulkCompleteReviewDatesRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+
+	input := itemUsecase.BulkCompleteReviewDatesInput{
+		UserID:        userID,
+		Today:         req.Today,
+		CategoryID:    req.CategoryID,
+		BoxID:         req.BoxID,
+		Unclassified:  req.Unclassified,
+		ReviewDateIDs: req.ReviewDateIDs,
+	}
+
+	out, err := ic.iu.BulkCompleteReviewDates(ctx, input)
+	if err != nil {
+		if errors.Is(err, itemDomain.ErrReviewDateNotFound) ||
+			errors.Is(err, itemDomain.ErrInvalidDailyReviewScope) ||
+			errors.Is(err, itemDomain.ErrTooManyBulkReviewDates) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習日の一括完了に失敗しました: " + err.Error()})
+	}
+
+	reviewDates := make([]BulkCompletedReviewDateResponse, len(out.ReviewDates))
+	for i, rd := range out.ReviewDates {
+		reviewDates[i] = BulkCompletedReviewDateResponse{
+			ReviewDateID: rd.ReviewDateID,
+			ItemID:       rd.ItemID,
+			StepNumber:   rd.StepNumber,
+			IsFinished:   rd.IsFinished,
+		}
+	}
+	return c.JSON(http.StatusOK, BulkCompleteReviewDatesResponse{ReviewDates: reviewDates})
+}
+
+// 今日の復習日をまとめて先送りする（policyを省略した場合は各復習日だけ）
+func (ic *itemController) BulkSnoozeReviewDates(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+
+	var req BulkSnoozeReviewDatesRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+	policy := req.Policy
+	if policy == "" {
+		policy = itemDomain.SnoozePolicyOnlyThis
+	}
+
+	input := itemUsecase.BulkSnoozeReviewDatesInput{
+		UserID:        userID,
+		Today:         req.Today,
+		CategoryID:    req.CategoryID,
+		BoxID:         req.BoxID,
+		Unclassified:  req.Unclassified,
+		ReviewDateIDs: req.ReviewDateIDs,
+		Days:          req.Days,
+		Policy:        policy,
+	}
+
+	out, err := ic.iu.BulkSnoozeReviewDates(ctx, input)
+	if err != nil {
+		if errors.Is(err, itemDomain.ErrReviewDateNotFound) ||
+			errors.Is(err, itemDomain.ErrInvalidDailyReviewScope) ||
+			errors.Is(err, itemDomain.ErrTooManyBulkReviewDates) ||
+			errors.Is(err, itemDomain.ErrInvalidSnoozeDays) ||
+			errors.Is(err, itemDomain.ErrInvalidSnoozePolicy) ||
+			errors.Is(err, itemDomain.ErrSnoozedReviewDateOutOfOrder) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習日の一括先送りに失敗しました: " + err.Error()})
+	}
+
+	reviewDates := make([]ReviewDateResponse, len(out.ReviewDates))
+	for i, rd := range out.ReviewDates {
+		reviewDates[i] = ReviewDateResponse{
+			ReviewDateID:         rd.ReviewDateID,
+			UserID:               rd.UserID,
+			CategoryID:           rd.CategoryID,
+			BoxID:                rd.BoxID,
+			ItemID:               rd.ItemID,
+			StepNumber:           rd.StepNumber,
+			InitialScheduledDate: rd.InitialScheduledDate,
+			ScheduledDate:        rd.ScheduledDate,
+			IsCompleted:          rd.IsCompleted,
+		}
+	}
+	return c.JSON(http.StatusOK, BulkSnoozeReviewDatesResponse{ReviewDates: reviewDates})
+}
+
 func (ic *itemController) UpdateReviewDateAsInCompleted(c echo.Context) error {
 	ctx := c.Request().Context()
 	userID, err := getUserIDFromContext(c)
@@ -316,6 +701,7 @@ func (ic *itemController) UpdateReviewDateAsInCompleted(c echo.Context) error {
 		UserID:       userID,
 		ItemID:       itemID,
 		StepNumber:   req.StepNumber,
+		Today:        req.Today,
 	}
 
 	out, err := ic.iu.UpdateReviewDateAsInCompleted(ctx, input)
@@ -386,6 +772,60 @@ func (ic *itemController) UpdateItemAsUnFinishedForce(c echo.Context) error {
 
 }
 
+func (ic *itemController) UpgradeItemPattern(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	itemID := c.Param("item_id")
+
+	var req UpgradeItemPatternRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+
+	input := itemUsecase.UpgradeItemPatternInput{
+		ItemID: itemID,
+		UserID: userID,
+		Today:  req.Today,
+	}
+
+	out, err := ic.iu.UpgradeItemPattern(ctx, input)
+	if err != nil {
+		if errors.Is(err, itemDomain.ErrItemHasNoPattern) || errors.Is(err, itemDomain.ErrItemAlreadyFinished) || errors.Is(err, itemDomain.ErrItemPatternAlreadyLatest) || errors.Is(err, itemDomain.ErrItemPatternUpgradeNotSupported) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習パターンの更新に失敗しました: " + err.Error()})
+	}
+	reviewDates := make([]ReviewDateResponse, len(out.ReviewDates))
+	for i, rd := range out.ReviewDates {
+		reviewDates[i] = ReviewDateResponse{
+			ReviewDateID:         rd.ReviewDateID,
+			UserID:               rd.UserID,
+			CategoryID:           rd.CategoryID,
+			BoxID:                rd.BoxID,
+			ItemID:               rd.ItemID,
+			StepNumber:           rd.StepNumber,
+			InitialScheduledDate: rd.InitialScheduledDate,
+			ScheduledDate:        rd.ScheduledDate,
+			IsCompleted:          rd.IsCompleted,
+		}
+	}
+
+	res := UpgradeItemPatternResponse{
+		ItemID:         out.ItemID,
+		UserID:         out.UserID,
+		PatternID:      out.PatternID,
+		PatternVersion: out.PatternVersion,
+		IsFinished:     out.IsFinished,
+		EditedAt:       out.EditedAt,
+		ReviewDates:    reviewDates,
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
 // 取得系
 func (ic *itemController) GetAllUnFinishedItemsByBoxID(c echo.Context) error {
 	ctx := c.Request().Context()
@@ -394,8 +834,10 @@ func (ic *itemController) GetAllUnFinishedItemsByBoxID(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
 	boxID := c.Param("box_id")
+	// tag_idは省略可能（指定した場合はそのタグが付いた復習物だけを返す）
+	tagID := c.QueryParam("tag_id")
 
-	out, err := ic.iu.GetAllUnFinishedItemsByBoxID(ctx, boxID, userID)
+	out, err := ic.iu.GetAllUnFinishedItemsByBoxID(ctx, boxID, userID, tagID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "ボックス内の復習物取得に失敗しました: " + err.Error()})
 	}
@@ -409,8 +851,9 @@ func (ic *itemController) GetAllUnFinishedUnclassifiedItemsByUserID(c echo.Conte
 	if err != nil {
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
+	tagID := c.QueryParam("tag_id")
 
-	out, err := ic.iu.GetAllUnFinishedUnclassifiedItemsByUserID(ctx, userID)
+	out, err := ic.iu.GetAllUnFinishedUnclassifiedItemsByUserID(ctx, userID, tagID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "未分類の復習物取得に失敗しました: " + err.Error()})
 	}
@@ -425,8 +868,9 @@ func (ic *itemController) GetAllUnFinishedUnclassifiedItemsByCategoryID(c echo.C
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
 	categoryID := c.Param("category_id")
+	tagID := c.QueryParam("tag_id")
 
-	out, err := ic.iu.GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx, userID, categoryID)
+	out, err := ic.iu.GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx, userID, categoryID, tagID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "カテゴリ内の未分類復習物取得に失敗しました: " + err.Error()})
 	}
@@ -502,8 +946,10 @@ func (ic *itemController) CountDailyDatesGroupedByBoxByUserID(c echo.Context) er
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
 	today := c.QueryParam("today")
+	// tag_idは省略可能（指定した場合はそのタグが付いた復習物の復習日だけを数える）
+	tagID := c.QueryParam("tag_id")
 
-	out, err := ic.iu.CountDailyDatesGroupedByBoxByUserID(ctx, userID, today)
+	out, err := ic.iu.CountDailyDatesGroupedByBoxByUserID(ctx, userID, today, tagID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "ボックス毎の今日の復習数取得に失敗しました: " + err.Error()})
 	}
@@ -526,8 +972,9 @@ func (ic *itemController) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(c
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
 	today := c.QueryParam("today")
+	tagID := c.QueryParam("tag_id")
 
-	out, err := ic.iu.CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx, userID, today)
+	out, err := ic.iu.CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx, userID, today, tagID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "カテゴリ毎の今日の未分類復習数取得に失敗しました: " + err.Error()})
 	}
@@ -549,8 +996,9 @@ func (ic *itemController) CountDailyDatesUnclassifiedByUserID(c echo.Context) er
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
 	today := c.QueryParam("today")
+	tagID := c.QueryParam("tag_id")
 
-	out, err := ic.iu.CountDailyDatesUnclassifiedByUserID(ctx, userID, today)
+	out, err := ic.iu.CountDailyDatesUnclassifiedByUserID(ctx, userID, today, tagID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "今日の未分類復習数取得に失敗しました: " + err.Error()})
 	}
@@ -567,8 +1015,9 @@ func (ic *itemController) CountAllDailyReviewDates(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
 	today := c.QueryParam("today")
+	tagID := c.QueryParam("tag_id")
 
-	count, err := ic.iu.CountAllDailyReviewDates(ctx, userID, today)
+	count, err := ic.iu.CountAllDailyReviewDates(ctx, userID, today, tagID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "今日の復習日数の取得に失敗しました: " + err.Error()})
 	}
@@ -585,9 +1034,22 @@ func (ic *itemController) GetAllDailyReviewDates(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
 	today := c.QueryParam("today")
+	// limitは省略可能（省略時は全件）
+	limit := 0
+	if l := c.QueryParam("limit"); l != "" {
+		limit, err = strconv.Atoi(l)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+		}
+	}
+	order := c.QueryParam("order")
+	tagID := c.QueryParam("tag_id")
 
-	result, err := ic.iu.GetAllDailyReviewDates(ctx, userID, today)
+	result, err := ic.iu.GetAllDailyReviewDates(ctx, userID, today, limit, order, tagID)
 	if err != nil {
+		if errors.Is(err, itemDomain.ErrInvalidDailyReviewOrder) || errors.Is(err, itemDomain.ErrInvalidDailyReviewLimit) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習日の取得に失敗しました: " + err.Error()})
 	}
 	res := GetDailyReviewDatesResponse{}
@@ -677,6 +1139,295 @@ func (ic *itemController) GetAllDailyReviewDates(c echo.Context) error {
 	return c.JSON(http.StatusOK, res)
 }
 
+// fromから指定日数分の日毎の復習数（負荷予測）を取得
+func (ic *itemController) GetReviewForecast(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	from := c.QueryParam("from")
+	days, err := strconv.Atoi(c.QueryParam("days"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+
+	tagID := c.QueryParam("tag_id")
+
+	result, err := ic.iu.GetReviewForecast(ctx, userID, from, days, tagID)
+	if err != nil {
+		var parseErr *time.ParseError
+		if errors.Is(err, itemDomain.ErrInvalidForecastDays) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		if errors.As(err, &parseErr) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": "fromはYYYY-MM-DD形式で指定してください"})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習数の予測の取得に失敗しました: " + err.Error()})
+	}
+
+	res := GetReviewForecastResponse{
+		From: result.From,
+		Days: make([]ReviewForecastDayResponse, len(result.Days)),
+	}
+	for i, day := range result.Days {
+		categories := make([]ReviewForecastCategoryResponse, len(day.Categories))
+		for j, cat := range day.Categories {
+			boxes := make([]ReviewForecastBoxResponse, len(cat.Boxes))
+			for k, box := range cat.Boxes {
+				boxes[k] = ReviewForecastBoxResponse{
+					BoxID:   box.BoxID,
+					BoxName: box.BoxName,
+					Count:   box.Count,
+				}
+			}
+			categories[j] = ReviewForecastCategoryResponse{
+				CategoryID:        cat.CategoryID,
+				CategoryName:      cat.CategoryName,
+				Count:             cat.Count,
+				Boxes:             boxes,
+				UnclassifiedCount: cat.UnclassifiedCount,
+			}
+		}
+		res.Days[i] = ReviewForecastDayResponse{
+			Date:              day.Date,
+			Count:             day.Count,
+			Categories:        categories,
+			UnclassifiedCount: day.UnclassifiedCount,
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// fromから指定日数分の日毎の活動量（ヒートマップ）を取得
+func (ic *itemController) GetActivityHeatmap(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	days, err := strconv.Atoi(c.QueryParam("days"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+	unclassified := false
+	if v := c.QueryParam("unclassified"); v != "" {
+		unclassified, err = strconv.ParseBool(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+		}
+	}
+
+	input := itemUsecase.GetActivityHeatmapInput{
+		UserID:       userID,
+		From:         c.QueryParam("from"),
+		Days:         days,
+		Unclassified: unclassified,
+	}
+	if categoryID := c.QueryParam("category_id"); categoryID != "" {
+		input.CategoryID = &categoryID
+	}
+	if boxID := c.QueryParam("box_id"); boxID != "" {
+		input.BoxID = &boxID
+	}
+
+	result, err := ic.iu.GetActivityHeatmap(ctx, input)
+	if err != nil {
+		if errors.Is(err, itemDomain.ErrInvalidHeatmapDays) || errors.Is(err, itemDomain.ErrInvalidHeatmapFilter) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "ヒートマップの取得に失敗しました: " + err.Error()})
+	}
+
+	res := GetActivityHeatmapResponse{
+		From: result.From,
+		Days: make([]HeatmapDayResponse, len(result.Days)),
+	}
+	for i, day := range result.Days {
+		res.Days[i] = HeatmapDayResponse{
+			Date:         day.Date,
+			ReviewCount:  day.ReviewCount,
+			LearnedCount: day.LearnedCount,
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (ic *itemController) SearchItems(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+
+	input := itemUsecase.SearchItemsInput{
+		UserID:      userID,
+		Query:       c.QueryParam("q"),
+		LearnedFrom: c.QueryParam("learned_from"),
+		LearnedTo:   c.QueryParam("learned_to"),
+	}
+	if categoryID := c.QueryParam("category_id"); categoryID != "" {
+		input.CategoryID = &categoryID
+	}
+	if boxID := c.QueryParam("box_id"); boxID != "" {
+		input.BoxID = &boxID
+	}
+	// is_finishedを省略した場合は完了済み・未完了の両方を検索する
+	if v := c.QueryParam("is_finished"); v != "" {
+		isFinished, err := strconv.ParseBool(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+		}
+		input.IsFinished = &isFinished
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		input.Limit, err = strconv.Atoi(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+		}
+	}
+
+	result, err := ic.iu.SearchItems(ctx, input)
+	if err != nil {
+		var parseErr *time.ParseError
+		switch {
+		case errors.Is(err, itemDomain.ErrEmptySearchQuery),
+			errors.Is(err, itemDomain.ErrInvalidSearchQuery),
+			errors.Is(err, itemDomain.ErrInvalidSearchLimit),
+			errors.Is(err, itemDomain.ErrInvalidSearchLearnedDateRange):
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		case errors.As(err, &parseErr):
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": "学習日はYYYY-MM-DD形式で指定してください"})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習物の検索に失敗しました: " + err.Error()})
+	}
+
+	res := SearchItemsResponse{
+		Terms: result.Terms,
+		Total: result.Total,
+		Items: make([]SearchItemResponse, len(result.Items)),
+	}
+	for i, item := range result.Items {
+		res.Items[i] = SearchItemResponse{
+			ItemID:        item.ItemID,
+			CategoryID:    item.CategoryID,
+			BoxID:         item.BoxID,
+			Name:          item.Name,
+			Detail:        item.Detail,
+			LearnedDate:   item.LearnedDate,
+			IsFinished:    item.IsFinished,
+			EditedAt:      item.EditedAt,
+			Score:         item.Score,
+			NameHighlight: toHighlightSegmentResponses(item.NameHighlight),
+			DetailSnippet: toHighlightSegmentResponses(item.DetailSnippet),
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (ic *itemController) GetReviewStats(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	today := c.QueryParam("today")
+
+	out, err := ic.iu.GetReviewStats(ctx, userID, today)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習の統計の取得に失敗しました: " + err.Error()})
+	}
+
+	res := GetReviewStatsResponse{
+		Today:            out.Today,
+		CurrentStreak:    out.CurrentStreak,
+		LongestStreak:    out.LongestStreak,
+		CompletionRates:  make([]CompletionRateResponse, len(out.CompletionRates)),
+		AverageDelayDays: out.AverageDelayDays,
+	}
+	for i, rate := range out.CompletionRates {
+		res.CompletionRates[i] = CompletionRateResponse{
+			Days:           rate.Days,
+			DueCount:       rate.DueCount,
+			CompletedCount: rate.CompletedCount,
+			Rate:           rate.Rate,
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (ic *itemController) GetItemHistory(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	itemID := c.Param("item_id")
+
+	out, err := ic.iu.GetItemHistory(ctx, itemID, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習履歴の取得に失敗しました: " + err.Error()})
+	}
+
+	res := GetItemHistoryResponse{
+		ItemID: out.ItemID,
+		Logs:   make([]ReviewLogResponse, len(out.Logs)),
+	}
+	for i, l := range out.Logs {
+		res.Logs[i] = ReviewLogResponse{
+			ReviewLogID:     l.ReviewLogID,
+			ReviewDateID:    l.ReviewDateID,
+			StepNumber:      l.StepNumber,
+			Outcome:         l.Outcome,
+			Grade:           l.Grade,
+			DurationSeconds: l.DurationSeconds,
+			ReviewedDate:    l.ReviewedDate,
+			ReviewedAt:      l.ReviewedAt,
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// 何度もずらされたり想起に失敗したりしている復習物（リーチ）の一覧を取得
+func (ic *itemController) GetLeechItems(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+
+	out, err := ic.iu.GetLeechItems(ctx, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "リーチの取得に失敗しました: " + err.Error()})
+	}
+
+	res := GetLeechItemsResponse{
+		SlipThreshold:    out.SlipThreshold,
+		FailureThreshold: out.FailureThreshold,
+		Items:            make([]LeechItemResponse, len(out.Items)),
+	}
+	for i, item := range out.Items {
+		res.Items[i] = LeechItemResponse{
+			ItemID:       item.ItemID,
+			CategoryID:   item.CategoryID,
+			BoxID:        item.BoxID,
+			Name:         item.Name,
+			SlipCount:    item.SlipCount,
+			FailureCount: item.FailureCount,
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
 func (ic *itemController) GetFinishedItemsByBoxID(c echo.Context) error {
 	ctx := c.Request().Context()
 	userID, err := getUserIDFromContext(c)
@@ -684,8 +1435,9 @@ func (ic *itemController) GetFinishedItemsByBoxID(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
 	boxID := c.Param("box_id")
+	tagID := c.QueryParam("tag_id")
 
-	out, err := ic.iu.GetFinishedItemsByBoxID(ctx, boxID, userID)
+	out, err := ic.iu.GetFinishedItemsByBoxID(ctx, boxID, userID, tagID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "ボックス内の完了した復習物取得に失敗しました: " + err.Error()})
 	}
@@ -700,8 +1452,9 @@ func (ic *itemController) GetUnclassfiedFinishedItemsByCategoryID(c echo.Context
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
 	categoryID := c.Param("category_id")
+	tagID := c.QueryParam("tag_id")
 
-	out, err := ic.iu.GetUnclassfiedFinishedItemsByCategoryID(ctx, userID, categoryID)
+	out, err := ic.iu.GetUnclassfiedFinishedItemsByCategoryID(ctx, userID, categoryID, tagID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "カテゴリ内の未分類完了復習物取得に失敗しました: " + err.Error()})
 	}
@@ -715,8 +1468,9 @@ func (ic *itemController) GetUnclassfiedFinishedItemsByUserID(c echo.Context) er
 	if err != nil {
 		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
 	}
+	tagID := c.QueryParam("tag_id")
 
-	out, err := ic.iu.GetUnclassfiedFinishedItemsByUserID(ctx, userID)
+	out, err := ic.iu.GetUnclassfiedFinishedItemsByUserID(ctx, userID, tagID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "完了した復習物取得に失敗しました: " + err.Error()})
 	}
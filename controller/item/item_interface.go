@@ -5,12 +5,20 @@ import "github.com/labstack/echo/v4"
 type IItemController interface {
 	CreateItem(c echo.Context) error
 	UpdateItem(c echo.Context) error
+	PreviewCreateItem(c echo.Context) error
+	PreviewUpdateItem(c echo.Context) error
 	UpdateReviewDates(c echo.Context) error
 	UpdateItemAsFinishedForce(c echo.Context) error
 	UpdateReviewDateAsCompleted(c echo.Context) error
+	UpdateReviewDateAsFailed(c echo.Context) error
+	SnoozeReviewDate(c echo.Context) error
+	BulkCompleteReviewDates(c echo.Context) error
+	BulkSnoozeReviewDates(c echo.Context) error
 	UpdateReviewDateAsInCompleted(c echo.Context) error
 	UpdateItemAsUnFinishedForce(c echo.Context) error
+	UpgradeItemPattern(c echo.Context) error
 	DeleteItem(c echo.Context) error
+	UndoItemOperations(c echo.Context) error
 
 	GetAllUnFinishedItemsByBoxID(c echo.Context) error
 	GetAllUnFinishedUnclassifiedItemsByUserID(c echo.Context) error
@@ -28,6 +36,18 @@ type IItemController interface {
 
 	GetAllDailyReviewDates(c echo.Context) error
 
+	GetReviewForecast(c echo.Context) error
+
+	GetActivityHeatmap(c echo.Context) error
+
+	SearchItems(c echo.Context) error
+
+	GetReviewStats(c echo.Context) error
+
+	GetItemHistory(c echo.Context) error
+
+	GetLeechItems(c echo.Context) error
+
 	GetFinishedItemsByBoxID(c echo.Context) error
 	GetUnclassfiedFinishedItemsByCategoryID(c echo.Context) error
 	GetUnclassfiedFinishedItemsByUserID(c echo.Context) error
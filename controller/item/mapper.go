@@ -20,19 +20,45 @@ func mapToItemResponse(items []*itemUsecase.GetItemOutput) []ItemResponse {
 			}
 		}
 		res[i] = ItemResponse{
-			ItemID:       item.ItemID,
-			UserID:       item.UserID,
-			CategoryID:   item.CategoryID,
-			BoxID:        item.BoxID,
-			PatternID:    item.PatternID,
-			Name:         item.Name,
-			Detail:       item.Detail,
-			LearnedDate:  item.LearnedDate,
-			IsFinished:   item.IsFinished,
-			RegisteredAt: item.RegisteredAt,
-			EditedAt:     item.EditedAt,
-			ReviewDates:  reviewDates,
+			ItemID:         item.ItemID,
+			UserID:         item.UserID,
+			CategoryID:     item.CategoryID,
+			BoxID:          item.BoxID,
+			PatternID:      item.PatternID,
+			PatternVersion: item.PatternVersion,
+			Name:           item.Name,
+			Detail:         item.Detail,
+			LearnedDate:    item.LearnedDate,
+			IsFinished:     item.IsFinished,
+			RegisteredAt:   item.RegisteredAt,
+			EditedAt:       item.EditedAt,
+			ReviewDates:    reviewDates,
 		}
 	}
 	return res
 }
+
+func mapToPreviewItemScheduleResponse(out *itemUsecase.PreviewItemScheduleOutput) PreviewItemScheduleResponse {
+	reviewDates := make([]PreviewReviewDateResponse, len(out.ReviewDates))
+	for i, rd := range out.ReviewDates {
+		reviewDates[i] = PreviewReviewDateResponse{
+			StepNumber:           rd.StepNumber,
+			InitialScheduledDate: rd.InitialScheduledDate,
+			ScheduledDate:        rd.ScheduledDate,
+			IsCompleted:          rd.IsCompleted,
+		}
+	}
+	return PreviewItemScheduleResponse{
+		ItemID:      out.ItemID,
+		IsFinished:  out.IsFinished,
+		ReviewDates: reviewDates,
+	}
+}
+
+func toHighlightSegmentResponses(segments []itemUsecase.HighlightSegmentOutput) []HighlightSegmentResponse {
+	res := make([]HighlightSegmentResponse, len(segments))
+	for i, s := range segments {
+		res[i] = HighlightSegmentResponse{Text: s.Text, Matched: s.Matched}
+	}
+	return res
+}
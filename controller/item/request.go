@@ -1,6 +1,7 @@
 package item
 
 type CreateItemRequest struct {
+	ItemID                   string  `json:"item_id"` // 省略可能。プレビューで返されたitem_idを渡すと、プレビューと同じ復習日で作成される
 	CategoryID               *string `json:"category_id"`
 	BoxID                    *string `json:"box_id"`
 	PatternID                *string `json:"pattern_id"`
@@ -44,10 +45,50 @@ type UpdateItemAsUnFinishedForceRequest struct {
 	Today       string  `json:"today"`
 }
 
+type UpgradeItemPatternRequest struct {
+	Today string `json:"today"`
+}
+
 type UpdateReviewDateAsCompletedRequest struct {
-	StepNumber int `json:"step_number"`
+	StepNumber      int    `json:"step_number"`
+	Grade           *int   `json:"grade"` // 想起度（0〜5）。省略時は想起度による再計算をしない
+	Today           string `json:"today"`
+	DurationSeconds *int   `json:"duration_seconds"` // 復習にかかった秒数（任意）
+}
+
+type UpdateReviewDateAsFailedRequest struct {
+	Today string `json:"today"`
+}
+
+type SnoozeReviewDateRequest struct {
+	Days   int    `json:"days"`
+	Policy string `json:"policy"` // only_this・shift_later。省略時はonly_this
+}
+
+// 復習日IDを指定した場合はその復習日だけ、指定しない場合はcategory_id・box_id・unclassifiedで絞り込んだ今日の復習日全てが対象
+type BulkCompleteReviewDatesRequest struct {
+	Today         string   `json:"today"`
+	CategoryID    *string  `json:"category_id"`
+	BoxID         *string  `json:"box_id"`
+	Unclassified  bool     `json:"unclassified"`
+	ReviewDateIDs []string `json:"review_date_ids"`
+}
+
+type BulkSnoozeReviewDatesRequest struct {
+	Today         string   `json:"today"`
+	CategoryID    *string  `json:"category_id"`
+	BoxID         *string  `json:"box_id"`
+	Unclassified  bool     `json:"unclassified"`
+	ReviewDateIDs []string `json:"review_date_ids"`
+	Days          int      `json:"days"`
+	Policy        string   `json:"policy"` // only_this・shift_later。省略時はonly_this
 }
 
 type UpdateReviewDateAsInCompletedRequest struct {
-	StepNumber int `json:"step_number"`
+	StepNumber int    `json:"step_number"`
+	Today      string `json:"today"`
+}
+
+type UndoItemOperationsRequest struct {
+	Count *int `json:"count"` // 取り消す操作の数。省略時は1
 }
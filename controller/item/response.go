@@ -14,19 +14,33 @@ type ReviewDateResponse struct {
 	IsCompleted          bool    `json:"is_completed"`
 }
 
+type PreviewReviewDateResponse struct {
+	StepNumber           int    `json:"step_number"`
+	InitialScheduledDate string `json:"initial_scheduled_date"`
+	ScheduledDate        string `json:"scheduled_date"`
+	IsCompleted          bool   `json:"is_completed"`
+}
+
+type PreviewItemScheduleResponse struct {
+	ItemID      string                      `json:"item_id"`
+	IsFinished  bool                        `json:"is_finished"`
+	ReviewDates []PreviewReviewDateResponse `json:"review_dates"`
+}
+
 type ItemResponse struct {
-	ItemID       string               `json:"item_id"`
-	UserID       string               `json:"user_id"`
-	CategoryID   *string              `json:"category_id"`
-	BoxID        *string              `json:"box_id"`
-	PatternID    *string              `json:"pattern_id"`
-	Name         string               `json:"name"`
-	Detail       string               `json:"detail"`
-	LearnedDate  string               `json:"learned_date"`
-	IsFinished   bool                 `json:"is_finished"`
-	RegisteredAt time.Time            `json:"registered_at"`
-	EditedAt     time.Time            `json:"edited_at"`
-	ReviewDates  []ReviewDateResponse `json:"review_dates"`
+	ItemID         string               `json:"item_id"`
+	UserID         string               `json:"user_id"`
+	CategoryID     *string              `json:"category_id"`
+	BoxID          *string              `json:"box_id"`
+	PatternID      *string              `json:"pattern_id"`
+	PatternVersion int                  `json:"pattern_version"`
+	Name           string               `json:"name"`
+	Detail         string               `json:"detail"`
+	LearnedDate    string               `json:"learned_date"`
+	IsFinished     bool                 `json:"is_finished"`
+	RegisteredAt   time.Time            `json:"registered_at"`
+	EditedAt       time.Time            `json:"edited_at"`
+	ReviewDates    []ReviewDateResponse `json:"review_dates"`
 }
 
 type UpdateItemAsFinishedForceResponse struct {
@@ -37,11 +51,47 @@ type UpdateItemAsFinishedForceResponse struct {
 }
 
 type UpdateReviewDateAsCompletedResponse struct {
-	ReviewDateID string    `json:"review_date_id"`
-	UserID       string    `json:"user_id"`
-	IsCompleted  bool      `json:"is_completed"`
-	IsFinished   bool      `json:"is_finished"`
-	EditedAt     time.Time `json:"edited_at"`
+	ReviewDateID string               `json:"review_date_id"`
+	UserID       string               `json:"user_id"`
+	IsCompleted  bool                 `json:"is_completed"`
+	IsFinished   bool                 `json:"is_finished"`
+	EditedAt     time.Time            `json:"edited_at"`
+	EaseFactor   *float64             `json:"ease_factor,omitempty"`
+	Stability    *float64             `json:"stability,omitempty"`
+	Difficulty   *float64             `json:"difficulty,omitempty"`
+	ReviewDates  []ReviewDateResponse `json:"review_dates,omitempty"`
+}
+
+type UpdateReviewDateAsFailedResponse struct {
+	ReviewDateID string               `json:"review_date_id"`
+	UserID       string               `json:"user_id"`
+	ItemID       string               `json:"item_id"`
+	EditedAt     time.Time            `json:"edited_at"`
+	ReviewDates  []ReviewDateResponse `json:"review_dates"`
+}
+
+// 先送りした復習日だけを返す
+type SnoozeReviewDateResponse struct {
+	ReviewDateID string               `json:"review_date_id"`
+	UserID       string               `json:"user_id"`
+	ItemID       string               `json:"item_id"`
+	EditedAt     time.Time            `json:"edited_at"`
+	ReviewDates  []ReviewDateResponse `json:"review_dates"`
+}
+
+type BulkCompletedReviewDateResponse struct {
+	ReviewDateID string `json:"review_date_id"`
+	ItemID       string `json:"item_id"`
+	StepNumber   int    `json:"step_number"`
+	IsFinished   bool   `json:"is_finished"`
+}
+
+type BulkCompleteReviewDatesResponse struct {
+	ReviewDates []BulkCompletedReviewDateResponse `json:"review_dates"`
+}
+
+type BulkSnoozeReviewDatesResponse struct {
+	ReviewDates []ReviewDateResponse `json:"review_dates"`
 }
 
 type UpdateReviewDateAsInCompletedResponse struct {
@@ -68,6 +118,16 @@ type UpdateItemAsUnFinishedForceResponse struct {
 	ReviewDates []ReviewDateResponse `json:"review_dates"`
 }
 
+type UpgradeItemPatternResponse struct {
+	ItemID         string               `json:"item_id"`
+	UserID         string               `json:"user_id"`
+	PatternID      string               `json:"pattern_id"`
+	PatternVersion int                  `json:"pattern_version"`
+	IsFinished     bool                 `json:"is_finished"`
+	EditedAt       time.Time            `json:"edited_at"`
+	ReviewDates    []ReviewDateResponse `json:"review_dates"`
+}
+
 type CountResponse struct {
 	Count int `json:"count"`
 }
@@ -164,3 +224,122 @@ type GetDailyReviewDatesResponse struct {
 	Categories                    []DailyReviewDatesGroupedByCategoryResponse         `json:"categories"`
 	DailyReviewDatesGroupedByUser []UnclassifiedDailyReviewDatesGroupedByUserResponse `json:"daily_review_dates_grouped_by_user"`
 }
+
+type CompletionRateResponse struct {
+	Days           int      `json:"days"`
+	DueCount       int      `json:"due_count"`
+	CompletedCount int      `json:"completed_count"`
+	Rate           *float64 `json:"rate"`
+}
+
+type GetReviewStatsResponse struct {
+	Today            string                   `json:"today"`
+	CurrentStreak    int                      `json:"current_streak"`
+	LongestStreak    int                      `json:"longest_streak"`
+	CompletionRates  []CompletionRateResponse `json:"completion_rates"`
+	AverageDelayDays *float64                 `json:"average_delay_days"`
+}
+
+type ReviewLogResponse struct {
+	ReviewLogID     string    `json:"review_log_id"`
+	ReviewDateID    *string   `json:"review_date_id"`
+	StepNumber      int       `json:"step_number"`
+	Outcome         string    `json:"outcome"`
+	Grade           *int      `json:"grade"`
+	DurationSeconds *int      `json:"duration_seconds"`
+	ReviewedDate    string    `json:"reviewed_date"`
+	ReviewedAt      time.Time `json:"reviewed_at"`
+}
+
+type GetItemHistoryResponse struct {
+	ItemID string              `json:"item_id"`
+	Logs   []ReviewLogResponse `json:"logs"`
+}
+
+type LeechItemResponse struct {
+	ItemID       string  `json:"item_id"`
+	CategoryID   *string `json:"category_id"`
+	BoxID        *string `json:"box_id"`
+	Name         string  `json:"name"`
+	SlipCount    int     `json:"slip_count"`
+	FailureCount int     `json:"failure_count"`
+}
+
+type GetLeechItemsResponse struct {
+	SlipThreshold    int                 `json:"slip_threshold"`
+	FailureThreshold int                 `json:"failure_threshold"`
+	Items            []LeechItemResponse `json:"items"`
+}
+
+type ReviewForecastBoxResponse struct {
+	BoxID   string `json:"box_id"`
+	BoxName string `json:"box_name"`
+	Count   int    `json:"count"`
+}
+
+type ReviewForecastCategoryResponse struct {
+	CategoryID        string                      `json:"category_id"`
+	CategoryName      string                      `json:"category_name"`
+	Count             int                         `json:"count"`
+	Boxes             []ReviewForecastBoxResponse `json:"boxes"`
+	UnclassifiedCount int                         `json:"unclassified_count"`
+}
+
+type ReviewForecastDayResponse struct {
+	Date              string                           `json:"date"`
+	Count             int                              `json:"count"`
+	Categories        []ReviewForecastCategoryResponse `json:"categories"`
+	UnclassifiedCount int                              `json:"unclassified_count"`
+}
+
+type GetReviewForecastResponse struct {
+	From string                      `json:"from"`
+	Days []ReviewForecastDayResponse `json:"days"`
+}
+
+type HeatmapDayResponse struct {
+	Date         string `json:"date"`
+	ReviewCount  int    `json:"review_count"`
+	LearnedCount int    `json:"learned_count"`
+}
+
+type GetActivityHeatmapResponse struct {
+	From string               `json:"from"`
+	Days []HeatmapDayResponse `json:"days"`
+}
+
+type HighlightSegmentResponse struct {
+	Text    string `json:"text"`
+	Matched bool   `json:"matched"`
+}
+
+type SearchItemResponse struct {
+	ItemID        string                     `json:"item_id"`
+	CategoryID    *string                    `json:"category_id"`
+	BoxID         *string                    `json:"box_id"`
+	Name          string                     `json:"name"`
+	Detail        string                     `json:"detail"`
+	LearnedDate   string                     `json:"learned_date"`
+	IsFinished    bool                       `json:"is_finished"`
+	EditedAt      time.Time                  `json:"edited_at"`
+	Score         int                        `json:"score"`
+	NameHighlight []HighlightSegmentResponse `json:"name_highlight"`
+	DetailSnippet []HighlightSegmentResponse `json:"detail_snippet"`
+}
+
+type SearchItemsResponse struct {
+	Terms []string             `json:"terms"`
+	Total int                  `json:"total"`
+	Items []SearchItemResponse `json:"items"`
+}
+
+type UndoneItemOperationResponse struct {
+	OperationID string    `json:"operation_id"`
+	Kind        string    `json:"kind"`
+	ItemIDs     []string  `json:"item_ids"`
+	OperatedAt  time.Time `json:"operated_at"`
+}
+
+type UndoItemOperationsResponse struct {
+	Operations []UndoneItemOperationResponse `json:"operations"`
+}
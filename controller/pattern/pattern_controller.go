@@ -1,10 +1,12 @@
 package pattern
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
+	patternDomain "github.com/minminseo/recall-setter/domain/pattern"
 	patternUsecase "github.com/minminseo/recall-setter/usecase/pattern"
 )
 
@@ -43,10 +45,16 @@ func (pc *patternController) CreatePattern(c echo.Context) error {
 		}
 	}
 	input := patternUsecase.CreatePatternInput{
-		UserID:       userID,
-		Name:         req.Name,
-		TargetWeight: req.TargetWeight,
-		Steps:        steps,
+		UserID:                 userID,
+		Name:                   req.Name,
+		TargetWeight:           req.TargetWeight,
+		SchedulerKind:          req.SchedulerKind,
+		TargetRetention:        req.TargetRetention,
+		IntervalFuzz:           req.IntervalFuzz,
+		IntervalFromCompletion: req.IntervalFromCompletion,
+		OverduePolicy:          req.OverduePolicy,
+		OverdueSpreadDays:      req.OverdueSpreadDays,
+		Steps:                  steps,
 	}
 
 	out, err := pc.pu.CreatePattern(ctx, input)
@@ -66,13 +74,20 @@ func (pc *patternController) CreatePattern(c echo.Context) error {
 	}
 
 	res := PatternResponse{
-		ID:           out.ID,
-		UserID:       out.UserID,
-		Name:         out.Name,
-		TargetWeight: out.TargetWeight,
-		RegisteredAt: out.RegisteredAt,
-		EditedAt:     out.EditedAt,
-		Steps:        resSteps,
+		ID:                     out.ID,
+		UserID:                 out.UserID,
+		Name:                   out.Name,
+		TargetWeight:           out.TargetWeight,
+		SchedulerKind:          out.SchedulerKind,
+		TargetRetention:        out.TargetRetention,
+		IntervalFuzz:           out.IntervalFuzz,
+		IntervalFromCompletion: out.IntervalFromCompletion,
+		OverduePolicy:          out.OverduePolicy,
+		OverdueSpreadDays:      out.OverdueSpreadDays,
+		Version:                out.Version,
+		RegisteredAt:           out.RegisteredAt,
+		EditedAt:               out.EditedAt,
+		Steps:                  resSteps,
 	}
 
 	return c.JSON(http.StatusCreated, res)
@@ -110,13 +125,20 @@ func (pc *patternController) GetPatterns(c echo.Context) error {
 			}
 		}
 		res = append(res, PatternResponse{
-			ID:           p.PatternID,
-			UserID:       p.UserID,
-			Name:         p.Name,
-			TargetWeight: p.TargetWeight,
-			RegisteredAt: p.RegisteredAt,
-			EditedAt:     p.EditedAt,
-			Steps:        steps,
+			ID:                     p.PatternID,
+			UserID:                 p.UserID,
+			Name:                   p.Name,
+			TargetWeight:           p.TargetWeight,
+			SchedulerKind:          p.SchedulerKind,
+			TargetRetention:        p.TargetRetention,
+			IntervalFuzz:           p.IntervalFuzz,
+			IntervalFromCompletion: p.IntervalFromCompletion,
+			OverduePolicy:          p.OverduePolicy,
+			OverdueSpreadDays:      p.OverdueSpreadDays,
+			Version:                p.Version,
+			RegisteredAt:           p.RegisteredAt,
+			EditedAt:               p.EditedAt,
+			Steps:                  steps,
 		})
 	}
 
@@ -157,15 +179,26 @@ func (pc *patternController) UpdatePattern(c echo.Context) error {
 		}
 	}
 	input := patternUsecase.UpdatePatternInput{
-		PatternID:    patternID,
-		UserID:       userID,
-		Name:         req.Name,
-		TargetWeight: req.TargetWeight,
-		Steps:        steps,
+		PatternID:              patternID,
+		UserID:                 userID,
+		Name:                   req.Name,
+		TargetWeight:           req.TargetWeight,
+		SchedulerKind:          req.SchedulerKind,
+		TargetRetention:        req.TargetRetention,
+		IntervalFuzz:           req.IntervalFuzz,
+		IntervalFromCompletion: req.IntervalFromCompletion,
+		OverduePolicy:          req.OverduePolicy,
+		OverdueSpreadDays:      req.OverdueSpreadDays,
+		Steps:                  steps,
+		StepMigration:          req.StepMigration,
+		Today:                  req.Today,
 	}
 
 	out, err := pc.pu.UpdatePattern(ctx, input)
 	if err != nil {
+		if errors.Is(err, patternDomain.ErrInvalidStepMigration) || errors.Is(err, patternDomain.ErrStepMigrationNotSupported) || errors.Is(err, patternDomain.ErrInvalidStepMigrationToday) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "パターンの更新に失敗しました: " + err.Error()})
 	}
 
@@ -180,14 +213,24 @@ func (pc *patternController) UpdatePattern(c echo.Context) error {
 		}
 	}
 
-	res := PatternResponse{
-		ID:           out.PatternID,
-		UserID:       out.UserID,
-		Name:         out.Name,
-		TargetWeight: out.TargetWeight,
-		RegisteredAt: out.RegisteredAt,
-		EditedAt:     out.EditedAt,
-		Steps:        resSteps,
+	res := UpdatePatternResponse{
+		PatternResponse: PatternResponse{
+			ID:                     out.PatternID,
+			UserID:                 out.UserID,
+			Name:                   out.Name,
+			TargetWeight:           out.TargetWeight,
+			SchedulerKind:          out.SchedulerKind,
+			TargetRetention:        out.TargetRetention,
+			IntervalFuzz:           out.IntervalFuzz,
+			IntervalFromCompletion: out.IntervalFromCompletion,
+			OverduePolicy:          out.OverduePolicy,
+			OverdueSpreadDays:      out.OverdueSpreadDays,
+			Version:                out.Version,
+			RegisteredAt:           out.RegisteredAt,
+			EditedAt:               out.EditedAt,
+			Steps:                  resSteps,
+		},
+		MigratedItemCount: out.MigratedItemCount,
 	}
 
 	return c.JSON(http.StatusOK, res)
@@ -217,3 +260,101 @@ func (pc *patternController) DeletePattern(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusNoContent)
 }
+
+func (pc *patternController) GetPatternPresets(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user := c.Get("user").(*jwt.Token)
+	claims := user.Claims.(jwt.MapClaims)
+	rawID, ok := claims["user_id"]
+	if !ok || rawID == nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	userID, ok := rawID.(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークン内のユーザーIDが無効です"})
+	}
+
+	results, err := pc.pu.GetPatternPresets(ctx, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "組み込みの復習パターンの取得に失敗しました: " + err.Error()})
+	}
+
+	res := make([]PatternPresetResponse, len(results))
+	for i, p := range results {
+		steps := make([]PatternPresetStepResponse, len(p.Steps))
+		for j, s := range p.Steps {
+			steps[j] = PatternPresetStepResponse{
+				StepNumber:   s.StepNumber,
+				IntervalDays: s.IntervalDays,
+			}
+		}
+		res[i] = PatternPresetResponse{
+			Key:          p.Key,
+			Name:         p.Name,
+			Description:  p.Description,
+			TargetWeight: p.TargetWeight,
+			Steps:        steps,
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (pc *patternController) InstallPatternPreset(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user := c.Get("user").(*jwt.Token)
+	claims := user.Claims.(jwt.MapClaims)
+	rawID, ok := claims["user_id"]
+	if !ok || rawID == nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	userID, ok := rawID.(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークン内のユーザーIDが無効です"})
+	}
+
+	input := patternUsecase.InstallPatternPresetInput{
+		UserID:    userID,
+		PresetKey: c.Param("key"),
+	}
+
+	out, err := pc.pu.InstallPatternPreset(ctx, input)
+	if err != nil {
+		if errors.Is(err, patternDomain.ErrPatternPresetNotFound) {
+			return c.JSON(http.StatusNotFound, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "組み込みの復習パターンの追加に失敗しました: " + err.Error()})
+	}
+
+	resSteps := make([]PatternStepResponse, len(out.Steps))
+	for i, s := range out.Steps {
+		resSteps[i] = PatternStepResponse{
+			PatternStepID: s.PatternStepID,
+			UserID:        s.UserID,
+			PatternID:     s.PatternID,
+			StepNumber:    s.StepNumber,
+			IntervalDays:  s.IntervalDays,
+		}
+	}
+
+	res := PatternResponse{
+		ID:                     out.ID,
+		UserID:                 out.UserID,
+		Name:                   out.Name,
+		TargetWeight:           out.TargetWeight,
+		SchedulerKind:          out.SchedulerKind,
+		TargetRetention:        out.TargetRetention,
+		IntervalFuzz:           out.IntervalFuzz,
+		IntervalFromCompletion: out.IntervalFromCompletion,
+		OverduePolicy:          out.OverduePolicy,
+		OverdueSpreadDays:      out.OverdueSpreadDays,
+		Version:                out.Version,
+		RegisteredAt:           out.RegisteredAt,
+		EditedAt:               out.EditedAt,
+		Steps:                  resSteps,
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
@@ -7,4 +7,7 @@ type IPatternController interface {
 	GetPatterns(c echo.Context) error
 	UpdatePattern(c echo.Context) error
 	DeletePattern(c echo.Context) error
+
+	GetPatternPresets(c echo.Context) error
+	InstallPatternPreset(c echo.Context) error
 }
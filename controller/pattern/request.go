@@ -1,9 +1,15 @@
 package pattern
 
 type CreatePatternRequest struct {
-	Name         string                   `json:"name"`
-	TargetWeight string                   `json:"target_weight"`
-	Steps        []CreatePatternStepField `json:"steps"`
+	Name                   string                   `json:"name"`
+	TargetWeight           string                   `json:"target_weight"`
+	SchedulerKind          string                   `json:"scheduler_kind"`
+	TargetRetention        float64                  `json:"target_retention"`
+	IntervalFuzz           bool                     `json:"interval_fuzz"`
+	IntervalFromCompletion bool                     `json:"interval_from_completion"`
+	OverduePolicy          string                   `json:"overdue_policy"`
+	OverdueSpreadDays      int                      `json:"overdue_spread_days"`
+	Steps                  []CreatePatternStepField `json:"steps"`
 }
 type CreatePatternStepField struct {
 	StepNumber   int `json:"step_number"`
@@ -11,9 +17,17 @@ type CreatePatternStepField struct {
 }
 
 type UpdatePatternRequest struct {
-	Name         string                   `json:"name"`
-	TargetWeight string                   `json:"target_weight"`
-	Steps        []UpdatePatternStepField `json:"steps"`
+	Name                   string                   `json:"name"`
+	TargetWeight           string                   `json:"target_weight"`
+	SchedulerKind          string                   `json:"scheduler_kind"`
+	TargetRetention        float64                  `json:"target_retention"`
+	IntervalFuzz           *bool                    `json:"interval_fuzz"`
+	IntervalFromCompletion *bool                    `json:"interval_from_completion"`
+	OverduePolicy          string                   `json:"overdue_policy"`
+	OverdueSpreadDays      int                      `json:"overdue_spread_days"`
+	Steps                  []UpdatePatternStepField `json:"steps"`
+	StepMigration          string                   `json:"step_migration"`
+	Today                  string                   `json:"today"`
 }
 type UpdatePatternStepField struct {
 	StepID       string `json:"step_id"`
@@ -11,11 +11,36 @@ type PatternStepResponse struct {
 }
 
 type PatternResponse struct {
-	ID           string                `json:"id"`
-	UserID       string                `json:"user_id"`
-	Name         string                `json:"name"`
-	TargetWeight string                `json:"target_weight"`
-	RegisteredAt time.Time             `json:"registered_at"`
-	EditedAt     time.Time             `json:"edited_at"`
-	Steps        []PatternStepResponse `json:"steps"`
+	ID                     string                `json:"id"`
+	UserID                 string                `json:"user_id"`
+	Name                   string                `json:"name"`
+	TargetWeight           string                `json:"target_weight"`
+	SchedulerKind          string                `json:"scheduler_kind"`
+	TargetRetention        float64               `json:"target_retention"`
+	IntervalFuzz           bool                  `json:"interval_fuzz"`
+	IntervalFromCompletion bool                  `json:"interval_from_completion"`
+	OverduePolicy          string                `json:"overdue_policy"`
+	OverdueSpreadDays      int                   `json:"overdue_spread_days"`
+	Version                int                   `json:"version"`
+	RegisteredAt           time.Time             `json:"registered_at"`
+	EditedAt               time.Time             `json:"edited_at"`
+	Steps                  []PatternStepResponse `json:"steps"`
+}
+
+type UpdatePatternResponse struct {
+	PatternResponse
+	MigratedItemCount int `json:"migrated_item_count"`
+}
+
+type PatternPresetStepResponse struct {
+	StepNumber   int `json:"step_number"`
+	IntervalDays int `json:"interval_days"`
+}
+
+type PatternPresetResponse struct {
+	Key          string                      `json:"key"`
+	Name         string                      `json:"name"`
+	Description  string                      `json:"description"`
+	TargetWeight string                      `json:"target_weight"`
+	Steps        []PatternPresetStepResponse `json:"steps"`
 }
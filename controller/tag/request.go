@@ -0,0 +1,13 @@
+package tag
+
+type CreateTagRequest struct {
+	Name string `json:"name"`
+}
+
+type UpdateTagRequest struct {
+	Name string `json:"name"`
+}
+
+type SetItemTagsRequest struct {
+	TagIDs []string `json:"tag_ids"`
+}
@@ -0,0 +1,18 @@
+package tag
+
+import "time"
+
+type TagResponse struct {
+	ID           string    `json:"id"`
+	UserID       string    `json:"user_id"`
+	Name         string    `json:"name"`
+	RegisteredAt time.Time `json:"registered_at"`
+	EditedAt     time.Time `json:"edited_at"`
+}
+
+type UpdateTagResponse struct {
+	ID       string    `json:"id"`
+	UserID   string    `json:"user_id"`
+	Name     string    `json:"name"`
+	EditedAt time.Time `json:"edited_at"`
+}
@@ -0,0 +1,210 @@
+package tag
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	tagDomain "github.com/minminseo/recall-setter/domain/tag"
+	tagUsecase "github.com/minminseo/recall-setter/usecase/tag"
+)
+
+type tagController struct {
+	tu tagUsecase.ITagUsecase
+}
+
+func NewTagController(tu tagUsecase.ITagUsecase) ITagController {
+	return &tagController{tu: tu}
+}
+
+func getUserIDFromContext(c echo.Context) (string, error) {
+	user, ok := c.Get("user").(*jwt.Token)
+	if !ok {
+		return "", errors.New("invalid token context")
+	}
+	claims, ok := user.Claims.(jwt.MapClaims)
+	if !ok {
+		return "", errors.New("invalid token claims")
+	}
+	userID, ok := claims["user_id"].(string)
+	if !ok || userID == "" {
+		return "", errors.New("user_id not found in token")
+	}
+	return userID, nil
+}
+
+func (tc *tagController) CreateTag(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+
+	var request CreateTagRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+
+	input := tagUsecase.CreateTagInput{
+		UserID: userID,
+		Name:   request.Name,
+	}
+
+	tagRes, err := tc.tu.CreateTag(ctx, input)
+	if err != nil {
+		if errors.Is(err, tagDomain.ErrDuplicateTagName) {
+			return c.JSON(http.StatusConflict, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "タグの作成に失敗しました: " + err.Error()})
+	}
+	res := TagResponse{
+		ID:           tagRes.ID,
+		UserID:       tagRes.UserID,
+		Name:         tagRes.Name,
+		RegisteredAt: tagRes.RegisteredAt,
+		EditedAt:     tagRes.EditedAt,
+	}
+	return c.JSON(http.StatusCreated, res)
+}
+
+func (tc *tagController) GetTags(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+
+	tagsRes, err := tc.tu.GetTagsByUserID(ctx, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "タグの取得に失敗しました: " + err.Error()})
+	}
+	return c.JSON(http.StatusOK, mapToTagResponse(tagsRes))
+}
+
+func (tc *tagController) UpdateTag(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+
+	tagIDParam := c.Param("id")
+	if tagIDParam == "" {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "パスにタグIDが必要です"})
+	}
+
+	var request UpdateTagRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+
+	input := tagUsecase.UpdateTagInput{
+		ID:     tagIDParam,
+		UserID: userID,
+		Name:   request.Name,
+	}
+
+	tagRes, err := tc.tu.UpdateTag(ctx, input)
+	if err != nil {
+		if errors.Is(err, tagDomain.ErrTagNotFound) {
+			return c.JSON(http.StatusNotFound, echo.Map{"error": err.Error()})
+		}
+		if errors.Is(err, tagDomain.ErrDuplicateTagName) {
+			return c.JSON(http.StatusConflict, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "タグの更新に失敗しました: " + err.Error()})
+	}
+
+	res := UpdateTagResponse{
+		ID:       tagRes.ID,
+		UserID:   tagRes.UserID,
+		Name:     tagRes.Name,
+		EditedAt: tagRes.EditedAt,
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+func (tc *tagController) DeleteTag(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+
+	tagIDParam := c.Param("id")
+	if tagIDParam == "" {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "パスにタグIDが必要です"})
+	}
+
+	err = tc.tu.DeleteTag(ctx, tagIDParam, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "タグの削除に失敗しました: " + err.Error()})
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (tc *tagController) GetItemTags(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	itemID := c.Param("item_id")
+
+	tagsRes, err := tc.tu.GetTagsByItemID(ctx, itemID, userID)
+	if err != nil {
+		if errors.Is(err, tagDomain.ErrItemNotFound) {
+			return c.JSON(http.StatusNotFound, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習物のタグの取得に失敗しました: " + err.Error()})
+	}
+	return c.JSON(http.StatusOK, mapToTagResponse(tagsRes))
+}
+
+// 復習物のタグをリクエストのタグで置き換える
+func (tc *tagController) SetItemTags(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "トークンにユーザーIDが含まれていません"})
+	}
+	itemID := c.Param("item_id")
+
+	var request SetItemTagsRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "リクエストの形式が正しくありません: " + err.Error()})
+	}
+
+	input := tagUsecase.SetItemTagsInput{
+		ItemID: itemID,
+		UserID: userID,
+		TagIDs: request.TagIDs,
+	}
+
+	tagsRes, err := tc.tu.SetItemTags(ctx, input)
+	if err != nil {
+		if errors.Is(err, tagDomain.ErrItemNotFound) || errors.Is(err, tagDomain.ErrTagNotFound) {
+			return c.JSON(http.StatusNotFound, echo.Map{"error": err.Error()})
+		}
+		if errors.Is(err, tagDomain.ErrTooManyItemTags) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "復習物のタグの更新に失敗しました: " + err.Error()})
+	}
+	return c.JSON(http.StatusOK, mapToTagResponse(tagsRes))
+}
+
+func mapToTagResponse(tags []*tagUsecase.GetTagOutput) []TagResponse {
+	res := make([]TagResponse, len(tags))
+	for i, t := range tags {
+		res[i] = TagResponse{
+			ID:           t.ID,
+			UserID:       t.UserID,
+			Name:         t.Name,
+			RegisteredAt: t.RegisteredAt,
+			EditedAt:     t.EditedAt,
+		}
+	}
+	return res
+}
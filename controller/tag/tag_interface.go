@@ -0,0 +1,13 @@
+package tag
+
+import "github.com/labstack/echo/v4"
+
+type ITagController interface {
+	CreateTag(c echo.Context) error
+	GetTags(c echo.Context) error
+	UpdateTag(c echo.Context) error
+	DeleteTag(c echo.Context) error
+
+	GetItemTags(c echo.Context) error
+	SetItemTags(c echo.Context) error
+}
@@ -29,3 +29,18 @@ type verifyEmailRequest struct {
 	Email string `json:"email"`
 	Code  string `json:"code"`
 }
+
+type updateRestDaysRequest struct {
+	Weekdays []int    `json:"weekdays"`
+	Dates    []string `json:"dates"`
+}
+
+type updateReviewLimitRequest struct {
+	MaxReviewsPerDay int `json:"max_reviews_per_day"`
+}
+
+type createVacationRequest struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Today     string `json:"today"`
+}
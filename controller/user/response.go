@@ -32,3 +32,41 @@ type UpdateUserSettingResponse struct {
 	ThemeColor string `json:"theme_color"`
 	Language   string `json:"language"`
 }
+
+type GetRestDaysResponse struct {
+	Weekdays []int    `json:"weekdays"`
+	Dates    []string `json:"dates"`
+}
+
+type UpdateRestDaysResponse struct {
+	Weekdays []int    `json:"weekdays"`
+	Dates    []string `json:"dates"`
+}
+
+type GetReviewLimitResponse struct {
+	MaxReviewsPerDay int `json:"max_reviews_per_day"`
+}
+
+type UpdateReviewLimitResponse struct {
+	MaxReviewsPerDay int `json:"max_reviews_per_day"`
+}
+
+type VacationDailyLoadResponse struct {
+	Date   string `json:"date"`
+	Before int    `json:"before"`
+	After  int    `json:"after"`
+}
+
+type PreviewVacationResponse struct {
+	StartDate  string                      `json:"start_date"`
+	EndDate    string                      `json:"end_date"`
+	ShiftDays  int                         `json:"shift_days"`
+	DailyLoads []VacationDailyLoadResponse `json:"daily_loads"`
+}
+
+type CreateVacationResponse struct {
+	VacationID string `json:"vacation_id"`
+	StartDate  string `json:"start_date"`
+	EndDate    string `json:"end_date"`
+	ShiftDays  int    `json:"shift_days"`
+}
@@ -1,12 +1,14 @@
 package user
 
 import (
+	"errors"
 	"net/http"
 	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
+	userDomain "github.com/minminseo/recall-setter/domain/user"
 	userUsecase "github.com/minminseo/recall-setter/usecase/user"
 )
 
@@ -227,3 +229,230 @@ func (uc *userController) UpdatePassword(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusOK)
 }
+
+func (uc *userController) GetRestDays(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("user").(*jwt.Token)
+	claims := user.Claims.(jwt.MapClaims)
+	rawID, ok := claims["user_id"]
+	if !ok || rawID == nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "User ID not found in token"})
+	}
+	userID, ok := rawID.(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "Invalid user ID in token"})
+	}
+
+	restDays, err := uc.uu.GetRestDays(ctx, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	res := GetRestDaysResponse{
+		Weekdays: restDays.Weekdays,
+		Dates:    restDays.Dates,
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+func (uc *userController) UpdateRestDays(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("user").(*jwt.Token)
+	claims := user.Claims.(jwt.MapClaims)
+	rawID, ok := claims["user_id"]
+	if !ok || rawID == nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "User ID not found in token"})
+	}
+	userID, ok := rawID.(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "Invalid user ID in token"})
+	}
+
+	var request updateRestDaysRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	input := userUsecase.UpdateRestDaysInput{
+		UserID:   userID,
+		Weekdays: request.Weekdays,
+		Dates:    request.Dates,
+	}
+
+	restDays, err := uc.uu.UpdateRestDays(ctx, input)
+	if err != nil {
+		if errors.Is(err, userDomain.ErrDuplicateRestDate) {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	res := UpdateRestDaysResponse{
+		Weekdays: restDays.Weekdays,
+		Dates:    restDays.Dates,
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+func (uc *userController) GetReviewLimit(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("user").(*jwt.Token)
+	claims := user.Claims.(jwt.MapClaims)
+	rawID, ok := claims["user_id"]
+	if !ok || rawID == nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "User ID not found in token"})
+	}
+	userID, ok := rawID.(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "Invalid user ID in token"})
+	}
+
+	reviewLimit, err := uc.uu.GetReviewLimit(ctx, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	res := GetReviewLimitResponse{
+		MaxReviewsPerDay: reviewLimit.MaxReviewsPerDay,
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+func (uc *userController) UpdateReviewLimit(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("user").(*jwt.Token)
+	claims := user.Claims.(jwt.MapClaims)
+	rawID, ok := claims["user_id"]
+	if !ok || rawID == nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "User ID not found in token"})
+	}
+	userID, ok := rawID.(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "Invalid user ID in token"})
+	}
+
+	var request updateReviewLimitRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	input := userUsecase.UpdateReviewLimitInput{
+		UserID:           userID,
+		MaxReviewsPerDay: request.MaxReviewsPerDay,
+	}
+
+	reviewLimit, err := uc.uu.UpdateReviewLimit(ctx, input)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	res := UpdateReviewLimitResponse{
+		MaxReviewsPerDay: reviewLimit.MaxReviewsPerDay,
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+func (uc *userController) PreviewVacation(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("user").(*jwt.Token)
+	claims := user.Claims.(jwt.MapClaims)
+	rawID, ok := claims["user_id"]
+	if !ok || rawID == nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "User ID not found in token"})
+	}
+	userID, ok := rawID.(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "Invalid user ID in token"})
+	}
+
+	var request createVacationRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	input := userUsecase.CreateVacationInput{
+		UserID:    userID,
+		StartDate: request.StartDate,
+		EndDate:   request.EndDate,
+		Today:     request.Today,
+	}
+
+	preview, err := uc.uu.PreviewVacation(ctx, input)
+	if err != nil {
+		if errors.Is(err, userDomain.ErrVacationOverlapped) {
+			return c.JSON(http.StatusConflict, err.Error())
+		}
+		if isInvalidVacationPeriod(err) {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	res := PreviewVacationResponse{
+		StartDate:  preview.StartDate,
+		EndDate:    preview.EndDate,
+		ShiftDays:  preview.ShiftDays,
+		DailyLoads: make([]VacationDailyLoadResponse, len(preview.DailyLoads)),
+	}
+	for i, l := range preview.DailyLoads {
+		res.DailyLoads[i] = VacationDailyLoadResponse{
+			Date:   l.Date,
+			Before: l.Before,
+			After:  l.After,
+		}
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+func (uc *userController) CreateVacation(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("user").(*jwt.Token)
+	claims := user.Claims.(jwt.MapClaims)
+	rawID, ok := claims["user_id"]
+	if !ok || rawID == nil {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "User ID not found in token"})
+	}
+	userID, ok := rawID.(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, echo.Map{"error": "Invalid user ID in token"})
+	}
+
+	var request createVacationRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	input := userUsecase.CreateVacationInput{
+		UserID:    userID,
+		StartDate: request.StartDate,
+		EndDate:   request.EndDate,
+		Today:     request.Today,
+	}
+
+	vacation, err := uc.uu.CreateVacation(ctx, input)
+	if err != nil {
+		if errors.Is(err, userDomain.ErrVacationOverlapped) {
+			return c.JSON(http.StatusConflict, err.Error())
+		}
+		if isInvalidVacationPeriod(err) {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	res := CreateVacationResponse{
+		VacationID: vacation.VacationID,
+		StartDate:  vacation.StartDate,
+		EndDate:    vacation.EndDate,
+		ShiftDays:  vacation.ShiftDays,
+	}
+	return c.JSON(http.StatusCreated, res)
+}
+
+// 休暇の期間の指定が不正な場合はリクエストの誤りとして扱う
+func isInvalidVacationPeriod(err error) bool {
+	return errors.Is(err, userDomain.ErrVacationPeriodRequired) ||
+		errors.Is(err, userDomain.ErrVacationStartBeforeToday) ||
+		errors.Is(err, userDomain.ErrVacationEndBeforeStart) ||
+		errors.Is(err, userDomain.ErrVacationTooLong)
+}
@@ -11,4 +11,10 @@ type IUserController interface {
 	UpdateSetting(c echo.Context) error
 	UpdatePassword(c echo.Context) error
 	VerifyEmail(c echo.Context) error
+	GetRestDays(c echo.Context) error
+	UpdateRestDays(c echo.Context) error
+	GetReviewLimit(c echo.Context) error
+	UpdateReviewLimit(c echo.Context) error
+	PreviewVacation(c echo.Context) error
+	CreateVacation(c echo.Context) error
 }
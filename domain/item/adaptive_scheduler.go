@@ -0,0 +1,108 @@
+package item
+
+import (
+	"math"
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+const (
+	// SM-2の易しさ係数
+	DefaultEaseFactor = 2.5
+	MinEaseFactor     = 1.3
+
+	// 想起度（0: 全く思い出せない 〜 5: 完璧に思い出せた）
+	MinGrade     = 0
+	MaxGrade     = 5
+	passingGrade = 3 // これ未満は想起失敗扱い
+)
+
+// 想起度に応じて残りの復習間隔を伸縮させるドメインサービス（SM-2方式）
+// 復習日の初期計算は固定ステップと同じで、復習日完了時の再計算だけが異なる
+type adaptiveScheduler struct {
+	scheduler
+}
+
+func NewAdaptiveScheduler() IScheduler {
+	return &adaptiveScheduler{}
+}
+
+func (s *adaptiveScheduler) RescheduleAfterCompletion(
+	targetPattern *PatternDomain.Pattern,
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	completedStepNumber int,
+	state MemoryState,
+	grade int,
+	parsedLastReviewedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, MemoryState, error) {
+	if grade < MinGrade || grade > MaxGrade {
+		return nil, state, ErrInvalidGrade
+	}
+	if len(targetPatternSteps) != len(reviewdates) {
+		return nil, state, ErrMismatchedIDsAndSteps
+	}
+
+	nextEaseFactor := calculateEaseFactor(state.EaseFactor, grade)
+
+	gaps := stepGaps(targetPatternSteps)
+
+	// 易しさ係数が初期値より大きければ間隔を伸ばし、小さければ縮める
+	ratio := nextEaseFactor / DefaultEaseFactor
+
+	result := make([]*Reviewdate, 0, len(reviewdates))
+	baseDate := parsedToday // 実際に完了した日を起点にする
+	for _, rd := range reviewdates {
+		if rd.StepNumber <= completedStepNumber || rd.IsCompleted {
+			continue
+		}
+
+		var days int
+		if len(result) == 0 && grade < passingGrade {
+			// 想起に失敗した場合は最初のステップの間隔からやり直す
+			days = targetPatternSteps[0].IntervalDays
+		} else {
+			days = int(math.Round(float64(gaps[rd.StepNumber]) * ratio))
+		}
+		if days < 1 {
+			days = 1
+		}
+		baseDate = baseDate.AddDate(0, 0, days)
+
+		reviewdate, err := NewReviewdate(
+			rd.ReviewdateID,
+			rd.UserID,
+			rd.CategoryID,
+			rd.BoxID,
+			rd.ItemID,
+			rd.StepNumber,
+			baseDate,
+			baseDate,
+			false,
+		)
+		if err != nil {
+			return nil, state, err
+		}
+		result = append(result, reviewdate)
+	}
+
+	nextState := state
+	nextState.EaseFactor = nextEaseFactor
+	return result, nextState, nil
+}
+
+// SM-2の易しさ係数の更新式: EF' = EF + (0.1 - (5-q) * (0.08 + (5-q) * 0.02))
+// 想起に失敗した場合（q < 3）は係数を変えずに最初からやり直す
+func calculateEaseFactor(easeFactor float64, grade int) float64 {
+	if grade < passingGrade {
+		return easeFactor
+	}
+	q := float64(MaxGrade - grade)
+	next := easeFactor + (0.1 - q*(0.08+q*0.02))
+	if next < MinEaseFactor {
+		return MinEaseFactor
+	}
+	return next
+}
@@ -0,0 +1,160 @@
+package item
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+func TestAdaptiveScheduler_RescheduleAfterCompletion(t *testing.T) {
+	scheduler := NewAdaptiveScheduler()
+
+	// 学習日(2024-01-01)から1日後、3日後、7日後の3ステップ
+	targetPatternSteps := []*PatternDomain.PatternStep{
+		{StepNumber: 1, IntervalDays: 1},
+		{StepNumber: 2, IntervalDays: 3},
+		{StepNumber: 3, IntervalDays: 7},
+	}
+	newReviewdates := func() []*Reviewdate {
+		return []*Reviewdate{
+			{ReviewdateID: "rd1", UserID: "user123", ItemID: "item123", StepNumber: 1, InitialScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+			{ReviewdateID: "rd2", UserID: "user123", ItemID: "item123", StepNumber: 2, InitialScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+			{ReviewdateID: "rd3", UserID: "user123", ItemID: "item123", StepNumber: 3, InitialScheduledDate: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+		}
+	}
+	targetPattern := &PatternDomain.Pattern{SchedulerKind: PatternDomain.SchedulerKindAdaptive}
+	parsedLearnedDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	parsedToday := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		targetPatternSteps []*PatternDomain.PatternStep
+		reviewdates        []*Reviewdate
+		easeFactor         float64
+		grade              int
+		wantEaseFactor     float64
+		wantDates          map[string]time.Time
+		wantErr            error
+	}{
+		{
+			name:               "完璧に想起できた場合は係数が上がり間隔が伸びる",
+			targetPatternSteps: targetPatternSteps,
+			reviewdates:        newReviewdates(),
+			easeFactor:         3.0,
+			grade:              5,
+			wantEaseFactor:     3.1,
+			wantDates: map[string]time.Time{
+				"rd2": time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+				"rd3": time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:               "初期値の係数で完璧に想起できた場合",
+			targetPatternSteps: targetPatternSteps,
+			reviewdates:        newReviewdates(),
+			easeFactor:         DefaultEaseFactor,
+			grade:              5,
+			wantEaseFactor:     2.6,
+			wantDates: map[string]time.Time{
+				"rd2": time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+				"rd3": time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:               "ぎりぎり想起できた場合は係数が下限で止まり間隔が縮む",
+			targetPatternSteps: targetPatternSteps,
+			reviewdates:        newReviewdates(),
+			easeFactor:         MinEaseFactor,
+			grade:              3,
+			wantEaseFactor:     MinEaseFactor,
+			wantDates: map[string]time.Time{
+				"rd2": time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				"rd3": time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:               "想起に失敗した場合は係数を変えず最初のステップの間隔からやり直す",
+			targetPatternSteps: targetPatternSteps,
+			reviewdates:        newReviewdates(),
+			easeFactor:         DefaultEaseFactor,
+			grade:              1,
+			wantEaseFactor:     DefaultEaseFactor,
+			wantDates: map[string]time.Time{
+				"rd2": time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				"rd3": time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:               "想起度が範囲外の場合はエラー",
+			targetPatternSteps: targetPatternSteps,
+			reviewdates:        newReviewdates(),
+			easeFactor:         DefaultEaseFactor,
+			grade:              6,
+			wantErr:            ErrInvalidGrade,
+		},
+		{
+			name:               "ステップ数と復習日数が一致しない場合はエラー",
+			targetPatternSteps: targetPatternSteps[:2],
+			reviewdates:        newReviewdates(),
+			easeFactor:         DefaultEaseFactor,
+			grade:              4,
+			wantErr:            ErrMismatchedIDsAndSteps,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotState, err := scheduler.RescheduleAfterCompletion(targetPattern, tt.targetPatternSteps, tt.reviewdates, 1, MemoryState{EaseFactor: tt.easeFactor}, tt.grade, parsedLearnedDate, parsedToday)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("RescheduleAfterCompletion() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RescheduleAfterCompletion() unexpected error = %v", err)
+			}
+			if math.Abs(gotState.EaseFactor-tt.wantEaseFactor) > 1e-9 {
+				t.Errorf("RescheduleAfterCompletion() easeFactor = %v, want %v", gotState.EaseFactor, tt.wantEaseFactor)
+			}
+			if len(got) != len(tt.wantDates) {
+				t.Fatalf("RescheduleAfterCompletion() len = %d, want %d", len(got), len(tt.wantDates))
+			}
+			for _, rd := range got {
+				want, ok := tt.wantDates[rd.ReviewdateID]
+				if !ok {
+					t.Errorf("予期しない復習日が再計算されました: %s", rd.ReviewdateID)
+					continue
+				}
+				if !rd.ScheduledDate.Equal(want) {
+					t.Errorf("%s の ScheduledDate = %v, want %v", rd.ReviewdateID, rd.ScheduledDate, want)
+				}
+				if rd.IsCompleted {
+					t.Errorf("%s が完了済みになっています", rd.ReviewdateID)
+				}
+			}
+		})
+	}
+}
+
+func TestScheduler_RescheduleAfterCompletion(t *testing.T) {
+	scheduler := NewScheduler()
+	targetPatternSteps := []*PatternDomain.PatternStep{{StepNumber: 1, IntervalDays: 1}}
+	reviewdates := []*Reviewdate{{ReviewdateID: "rd1", StepNumber: 1}}
+
+	state := MemoryState{EaseFactor: DefaultEaseFactor}
+
+	got, gotState, err := scheduler.RescheduleAfterCompletion(&PatternDomain.Pattern{}, targetPatternSteps, reviewdates, 1, state, 0, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("RescheduleAfterCompletion() unexpected error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("固定ステップでは復習日を再計算しないはずが %d 件返されました", len(got))
+	}
+	if gotState != state {
+		t.Errorf("RescheduleAfterCompletion() state = %+v, want %+v", gotState, state)
+	}
+}
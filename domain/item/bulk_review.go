@@ -0,0 +1,99 @@
+package item
+
+import (
+	"sort"
+)
+
+// 一度にまとめて操作できる復習日の最大数
+const MaxBulkReviewDates = 500
+
+// 今日の復習日をまとめて操作する範囲
+// 復習日IDを指定した場合はその復習日だけ、指定しない場合はカテゴリー・ボックス・未分類で絞り込んだ今日の復習日全て
+type DailyReviewScope struct {
+	CategoryID    *string
+	BoxID         *string
+	Unclassified  bool // trueの場合、CategoryIDがあればそのカテゴリーの未分類、なければユーザー直下の未分類
+	ReviewDateIDs []string
+}
+
+func NewDailyReviewScope(categoryID *string, boxID *string, unclassified bool, reviewDateIDs []string) (*DailyReviewScope, error) {
+	if unclassified && boxID != nil {
+		return nil, ErrInvalidDailyReviewScope
+	}
+	if len(reviewDateIDs) > 0 && (categoryID != nil || boxID != nil || unclassified) {
+		return nil, ErrInvalidDailyReviewScope
+	}
+	if len(reviewDateIDs) > MaxBulkReviewDates {
+		return nil, ErrTooManyBulkReviewDates
+	}
+
+	s := &DailyReviewScope{
+		CategoryID:    categoryID,
+		BoxID:         boxID,
+		Unclassified:  unclassified,
+		ReviewDateIDs: reviewDateIDs,
+	}
+	return s, nil
+}
+
+// 今日の復習日のうち範囲に含まれる未完了の復習日を、復習物毎にステップ番号順で返す
+// 指定した復習日IDが今日の未完了の復習日にない場合はエラー
+func (s *DailyReviewScope) Filter(dailyDates []*DailyReviewDate) ([]*DailyReviewDate, error) {
+	var targets []*DailyReviewDate
+	if len(s.ReviewDateIDs) > 0 {
+		incomplete := make(map[string]*DailyReviewDate, len(dailyDates))
+		for _, d := range dailyDates {
+			if !d.IsCompleted {
+				incomplete[d.ReviewdateID] = d
+			}
+		}
+		seen := make(map[string]struct{}, len(s.ReviewDateIDs))
+		for _, id := range s.ReviewDateIDs {
+			d, ok := incomplete[id]
+			if !ok {
+				return nil, ErrReviewDateNotFound
+			}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			targets = append(targets, d)
+		}
+	} else {
+		for _, d := range dailyDates {
+			if !d.IsCompleted && s.contains(d) {
+				targets = append(targets, d)
+			}
+		}
+	}
+	if len(targets) > MaxBulkReviewDates {
+		return nil, ErrTooManyBulkReviewDates
+	}
+
+	sort.SliceStable(targets, func(i, j int) bool {
+		if targets[i].ItemID != targets[j].ItemID {
+			return targets[i].ItemID < targets[j].ItemID
+		}
+		return targets[i].StepNumber < targets[j].StepNumber
+	})
+	return targets, nil
+}
+
+func (s *DailyReviewScope) contains(d *DailyReviewDate) bool {
+	if s.BoxID != nil {
+		return d.BoxID != nil && *d.BoxID == *s.BoxID
+	}
+	if s.Unclassified {
+		if d.BoxID != nil {
+			return false
+		}
+		if s.CategoryID == nil {
+			return d.CategoryID == nil
+		}
+		return d.CategoryID != nil && *d.CategoryID == *s.CategoryID
+	}
+	if s.CategoryID != nil {
+		return d.CategoryID != nil && *d.CategoryID == *s.CategoryID
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package item
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewDailyReviewScope(t *testing.T) {
+	categoryID := "category1"
+	boxID := "box1"
+	tooMany := make([]string, MaxBulkReviewDates+1)
+
+	tests := []struct {
+		name          string
+		categoryID    *string
+		boxID         *string
+		unclassified  bool
+		reviewDateIDs []string
+		wantErr       error
+	}{
+		{name: "今日の全ての復習日"},
+		{name: "ボックスで絞り込む", categoryID: &categoryID, boxID: &boxID},
+		{name: "カテゴリーの未分類で絞り込む", categoryID: &categoryID, unclassified: true},
+		{name: "復習日IDを指定する", reviewDateIDs: []string{"rd1", "rd2"}},
+		{name: "ボックスと未分類の同時指定はエラー", boxID: &boxID, unclassified: true, wantErr: ErrInvalidDailyReviewScope},
+		{name: "復習日IDと絞り込みの同時指定はエラー", categoryID: &categoryID, reviewDateIDs: []string{"rd1"}, wantErr: ErrInvalidDailyReviewScope},
+		{name: "復習日IDが多すぎる場合はエラー", reviewDateIDs: tooMany, wantErr: ErrTooManyBulkReviewDates},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDailyReviewScope(tt.categoryID, tt.boxID, tt.unclassified, tt.reviewDateIDs)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewDailyReviewScope() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDailyReviewScope_Filter(t *testing.T) {
+	category1 := "category1"
+	category2 := "category2"
+	box1 := "box1"
+
+	dailyDates := []*DailyReviewDate{
+		{ReviewdateID: "rd-box-2", ItemID: "item-box", StepNumber: 2, CategoryID: &category1, BoxID: &box1},
+		{ReviewdateID: "rd-box-1", ItemID: "item-box", StepNumber: 1, CategoryID: &category1, BoxID: &box1},
+		{ReviewdateID: "rd-box-done", ItemID: "item-done", StepNumber: 1, CategoryID: &category1, BoxID: &box1, IsCompleted: true},
+		{ReviewdateID: "rd-category", ItemID: "item-category", StepNumber: 1, CategoryID: &category1},
+		{ReviewdateID: "rd-other", ItemID: "item-other", StepNumber: 1, CategoryID: &category2},
+		{ReviewdateID: "rd-user", ItemID: "item-user", StepNumber: 1},
+	}
+
+	tests := []struct {
+		name    string
+		scope   DailyReviewScope
+		want    []string
+		wantErr error
+	}{
+		{
+			name:  "今日の未完了の復習日全てを復習物・ステップ番号順に返す",
+			scope: DailyReviewScope{},
+			want:  []string{"rd-box-1", "rd-box-2", "rd-category", "rd-other", "rd-user"},
+		},
+		{
+			name:  "カテゴリーで絞り込む",
+			scope: DailyReviewScope{CategoryID: &category1},
+			want:  []string{"rd-box-1", "rd-box-2", "rd-category"},
+		},
+		{
+			name:  "ボックスで絞り込む",
+			scope: DailyReviewScope{CategoryID: &category1, BoxID: &box1},
+			want:  []string{"rd-box-1", "rd-box-2"},
+		},
+		{
+			name:  "カテゴリーの未分類で絞り込む",
+			scope: DailyReviewScope{CategoryID: &category1, Unclassified: true},
+			want:  []string{"rd-category"},
+		},
+		{
+			name:  "ユーザー直下の未分類で絞り込む",
+			scope: DailyReviewScope{Unclassified: true},
+			want:  []string{"rd-user"},
+		},
+		{
+			name:  "復習日IDを指定する（重複は1件にまとめる）",
+			scope: DailyReviewScope{ReviewDateIDs: []string{"rd-user", "rd-box-2", "rd-user"}},
+			want:  []string{"rd-box-2", "rd-user"},
+		},
+		{
+			name:    "完了済みの復習日IDを指定した場合はエラー",
+			scope:   DailyReviewScope{ReviewDateIDs: []string{"rd-box-done"}},
+			wantErr: ErrReviewDateNotFound,
+		},
+		{
+			name:    "今日の復習日にないIDを指定した場合はエラー",
+			scope:   DailyReviewScope{ReviewDateIDs: []string{"unknown"}},
+			wantErr: ErrReviewDateNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.scope.Filter(dailyDates)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Filter() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Filter() unexpected error = %v", err)
+			}
+			gotIDs := make([]string, len(got))
+			for i, d := range got {
+				gotIDs[i] = d.ReviewdateID
+			}
+			if len(gotIDs) != len(tt.want) {
+				t.Fatalf("Filter() = %v, want %v", gotIDs, tt.want)
+			}
+			for i := range gotIDs {
+				if gotIDs[i] != tt.want[i] {
+					t.Errorf("Filter() = %v, want %v", gotIDs, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package item
+
+import (
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+// 復習日を置けない日を判定するカレンダー（ユーザーの休息日など）
+type IReviewCalendar interface {
+	// 指定日に復習できなければ、復習できる次の日を返す
+	NextAvailableDate(date time.Time) time.Time
+}
+
+// 他のスケジューラーが計算した未完了の復習日を、カレンダー上で復習できる次の日へずらすドメインサービス
+type calendarScheduler struct {
+	base     IScheduler
+	calendar IReviewCalendar
+}
+
+func NewCalendarScheduler(base IScheduler, calendar IReviewCalendar) IScheduler {
+	return &calendarScheduler{
+		base:     base,
+		calendar: calendar,
+	}
+}
+
+func (s *calendarScheduler) FormatWithOverdueMarkedCompleted(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, bool, error) {
+	result, isFinished, err := s.base.FormatWithOverdueMarkedCompleted(targetPatternSteps, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, false, err
+	}
+	return s.shiftToAvailableDates(result), isFinished, nil
+}
+
+func (s *calendarScheduler) FormatWithOverdueMarkedInCompleted(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.FormatWithOverdueMarkedInCompleted(targetPatternSteps, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	return s.shiftToAvailableDates(result), nil
+}
+
+func (s *calendarScheduler) FormatWithOverdueMarkedCompletedWithIDs(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewDateIDs []string,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, bool, error) {
+	result, isFinished, err := s.base.FormatWithOverdueMarkedCompletedWithIDs(targetPatternSteps, reviewDateIDs, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, false, err
+	}
+	return s.shiftToAvailableDates(result), isFinished, nil
+}
+
+func (s *calendarScheduler) FormatWithOverdueMarkedInCompletedWithIDs(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewDateIDs []string,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.FormatWithOverdueMarkedInCompletedWithIDs(targetPatternSteps, reviewDateIDs, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	return s.shiftToAvailableDates(result), nil
+}
+
+func (s *calendarScheduler) FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewDateIDs []string,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	diff time.Duration,
+) ([]*Reviewdate, error) {
+	result, err := s.base.FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates(targetPatternSteps, reviewDateIDs, userID, categoryID, boxID, itemID, parsedLearnedDate, diff)
+	if err != nil {
+		return nil, err
+	}
+	return s.shiftToAvailableDates(result), nil
+}
+
+func (s *calendarScheduler) RescheduleAfterCompletion(
+	targetPattern *PatternDomain.Pattern,
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	completedStepNumber int,
+	state MemoryState,
+	grade int,
+	parsedLastReviewedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, MemoryState, error) {
+	result, nextState, err := s.base.RescheduleAfterCompletion(targetPattern, targetPatternSteps, reviewdates, completedStepNumber, state, grade, parsedLastReviewedDate, parsedToday)
+	if err != nil {
+		return nil, state, err
+	}
+	return s.shiftToAvailableDates(result), nextState, nil
+}
+
+func (s *calendarScheduler) RescheduleFromCompletedDate(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	completedStepNumber int,
+	parsedCompletedDate time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.RescheduleFromCompletedDate(targetPatternSteps, reviewdates, completedStepNumber, parsedCompletedDate)
+	if err != nil {
+		return nil, err
+	}
+	return s.shiftToAvailableDates(result), nil
+}
+
+func (s *calendarScheduler) RescheduleAfterFailure(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.RescheduleAfterFailure(targetPatternSteps, reviewdates, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	return s.shiftToAvailableDates(result), nil
+}
+
+// 未完了の復習日だけをずらす（完了扱いの復習日は過去の日付なので動かさない）
+// 初回の予定日と予定日が同じ復習日は、初回の予定日も合わせてずらす
+func (s *calendarScheduler) shiftToAvailableDates(reviewdates []*Reviewdate) []*Reviewdate {
+	for _, rd := range reviewdates {
+		if rd.IsCompleted {
+			continue
+		}
+		shifted := s.calendar.NextAvailableDate(rd.ScheduledDate)
+		if shifted.Equal(rd.ScheduledDate) {
+			continue
+		}
+		if rd.InitialScheduledDate.Equal(rd.ScheduledDate) {
+			rd.InitialScheduledDate = shifted
+		}
+		rd.ScheduledDate = shifted
+	}
+	return reviewdates
+}
@@ -0,0 +1,97 @@
+package item
+
+import (
+	"testing"
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+// 土曜日と日曜日を休息日とするテスト用カレンダー
+type weekendCalendar struct{}
+
+func (weekendCalendar) NextAvailableDate(date time.Time) time.Time {
+	for date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+func TestCalendarScheduler_FormatWithOverdueMarkedInCompleted(t *testing.T) {
+	scheduler := NewCalendarScheduler(NewScheduler(), weekendCalendar{})
+
+	categoryID := "category123"
+	boxID := "box123"
+	// 学習日(2024-01-01 月曜日)から1日後、5日後、7日後の3ステップ
+	targetPatternSteps := []*PatternDomain.PatternStep{
+		{StepNumber: 1, IntervalDays: 1},
+		{StepNumber: 2, IntervalDays: 5},
+		{StepNumber: 3, IntervalDays: 7},
+	}
+	parsedLearnedDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := scheduler.FormatWithOverdueMarkedInCompleted(targetPatternSteps, "user123", &categoryID, &boxID, "item123", parsedLearnedDate, parsedLearnedDate)
+	if err != nil {
+		t.Fatalf("FormatWithOverdueMarkedInCompleted() unexpected error = %v", err)
+	}
+
+	want := []time.Time{
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), // 火曜日はそのまま
+		time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), // 土曜日は翌週の月曜日へ
+		time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), // 月曜日はそのまま
+	}
+	if len(got) != len(want) {
+		t.Fatalf("FormatWithOverdueMarkedInCompleted() len = %d, want %d", len(got), len(want))
+	}
+	for i, rd := range got {
+		if !rd.ScheduledDate.Equal(want[i]) || !rd.InitialScheduledDate.Equal(want[i]) {
+			t.Errorf("ステップ%d の ScheduledDate = %v, InitialScheduledDate = %v, want %v", rd.StepNumber, rd.ScheduledDate, rd.InitialScheduledDate, want[i])
+		}
+	}
+}
+
+func TestCalendarScheduler_ShiftToAvailableDates(t *testing.T) {
+	s := &calendarScheduler{base: NewScheduler(), calendar: weekendCalendar{}}
+
+	saturday := time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC)
+	monday := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	friday := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		reviewdate    *Reviewdate
+		wantScheduled time.Time
+		wantInitial   time.Time
+	}{
+		{
+			name:          "完了済みの復習日は休息日でも動かさない",
+			reviewdate:    &Reviewdate{InitialScheduledDate: saturday, ScheduledDate: saturday, IsCompleted: true},
+			wantScheduled: saturday,
+			wantInitial:   saturday,
+		},
+		{
+			name:          "初回の予定日と同じなら初回の予定日もずらす",
+			reviewdate:    &Reviewdate{InitialScheduledDate: saturday, ScheduledDate: saturday},
+			wantScheduled: monday,
+			wantInitial:   monday,
+		},
+		{
+			name:          "すでにずれている復習日は初回の予定日を残す",
+			reviewdate:    &Reviewdate{InitialScheduledDate: friday, ScheduledDate: saturday},
+			wantScheduled: monday,
+			wantInitial:   friday,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.shiftToAvailableDates([]*Reviewdate{tt.reviewdate})
+			if !got[0].ScheduledDate.Equal(tt.wantScheduled) {
+				t.Errorf("ScheduledDate = %v, want %v", got[0].ScheduledDate, tt.wantScheduled)
+			}
+			if !got[0].InitialScheduledDate.Equal(tt.wantInitial) {
+				t.Errorf("InitialScheduledDate = %v, want %v", got[0].InitialScheduledDate, tt.wantInitial)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package item
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+// 今日の復習日一覧の並び替えキー
+const (
+	DailyReviewOrderWeight  string = "weight"  // 復習パターンの重みが重い順
+	DailyReviewOrderOverdue string = "overdue" // 初回の予定日から遅れている日数が多い順
+)
+
+// 今日の復習日一覧で一度に返せる最大件数
+const MaxDailyReviewLimit = 1000
+
+// 重みの優先順位（小さいほど先）。復習パターンがない場合は最後
+var targetWeightPriorities = map[string]int{
+	PatternDomain.TargetWeightHeavy:  0,
+	PatternDomain.TargetWeightNormal: 1,
+	PatternDomain.TargetWeightLight:  2,
+	PatternDomain.TargetWeightUnset:  3,
+}
+
+// 初回の予定日から何日遅れているか（ずらされた分や期限切れのまま残っている分）
+func (d *DailyReviewDate) OverdueDays(parsedToday time.Time) int {
+	days := int(parsedToday.Sub(d.InitialScheduledDate).Hours() / 24)
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
+func (d *DailyReviewDate) weightPriority() int {
+	if p, ok := targetWeightPriorities[d.TargetWeight]; ok {
+		return p
+	}
+	return len(targetWeightPriorities)
+}
+
+// カンマ区切りの並び替えキー（例: "weight,overdue"）を解釈する。空の場合はnil（並び替えない）
+func ParseDailyReviewOrder(order string) ([]string, error) {
+	if order == "" {
+		return nil, nil
+	}
+	keys := strings.Split(order, ",")
+	seen := make(map[string]struct{}, len(keys))
+	for i, key := range keys {
+		key = strings.TrimSpace(key)
+		if key != DailyReviewOrderWeight && key != DailyReviewOrderOverdue {
+			return nil, ErrInvalidDailyReviewOrder
+		}
+		if _, ok := seen[key]; ok {
+			return nil, ErrInvalidDailyReviewOrder
+		}
+		seen[key] = struct{}{}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+func ValidateDailyReviewLimit(limit int) error {
+	if limit < 0 || limit > MaxDailyReviewLimit {
+		return ErrInvalidDailyReviewLimit
+	}
+	return nil
+}
+
+// 指定したキーの優先順で今日の復習日を並び替える。完了済みの復習日はキーによらず未完了の復習日の後にする。
+// 全てのキーで並びが決まらない場合は元の順番（カテゴリー・ボックス・登録日時順）を保つ
+func SortDailyReviewDates(dailyDates []*DailyReviewDate, keys []string, parsedToday time.Time) {
+	if len(keys) == 0 {
+		return
+	}
+	sort.SliceStable(dailyDates, func(i, j int) bool {
+		a, b := dailyDates[i], dailyDates[j]
+		if a.IsCompleted != b.IsCompleted {
+			return !a.IsCompleted
+		}
+		for _, key := range keys {
+			switch key {
+			case DailyReviewOrderWeight:
+				if pa, pb := a.weightPriority(), b.weightPriority(); pa != pb {
+					return pa < pb
+				}
+			case DailyReviewOrderOverdue:
+				if oa, ob := a.OverdueDays(parsedToday), b.OverdueDays(parsedToday); oa != ob {
+					return oa > ob
+				}
+			}
+		}
+		return false
+	})
+}
+
+// 未完了の復習日を先頭からlimit件に絞る。完了済みの復習日は件数に数えずに全て残す。limitが0の場合は絞らない
+func LimitDailyReviewDates(dailyDates []*DailyReviewDate, limit int) []*DailyReviewDate {
+	if limit <= 0 {
+		return dailyDates
+	}
+	limited := make([]*DailyReviewDate, 0, len(dailyDates))
+	pending := 0
+	for _, d := range dailyDates {
+		if !d.IsCompleted {
+			if pending >= limit {
+				continue
+			}
+			pending++
+		}
+		limited = append(limited, d)
+	}
+	return limited
+}
@@ -0,0 +1,147 @@
+package item
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+func TestParseDailyReviewOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   string
+		want    []string
+		wantErr error
+	}{
+		{name: "空の場合は並び替えない", order: "", want: nil},
+		{name: "重みのみ", order: "weight", want: []string{DailyReviewOrderWeight}},
+		{name: "重みと遅れ", order: "weight,overdue", want: []string{DailyReviewOrderWeight, DailyReviewOrderOverdue}},
+		{name: "前後の空白は無視する", order: "overdue, weight", want: []string{DailyReviewOrderOverdue, DailyReviewOrderWeight}},
+		{name: "不明なキーはエラー", order: "weight,name", wantErr: ErrInvalidDailyReviewOrder},
+		{name: "キーの重複はエラー", order: "weight,weight", wantErr: ErrInvalidDailyReviewOrder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDailyReviewOrder(tt.order)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseDailyReviewOrder() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDailyReviewOrder() unexpected error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseDailyReviewOrder() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSortDailyReviewDates(t *testing.T) {
+	parsedToday := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	newDailyDates := func() []*DailyReviewDate {
+		return []*DailyReviewDate{
+			{ReviewdateID: "light-3", TargetWeight: PatternDomain.TargetWeightLight, InitialScheduledDate: parsedToday.AddDate(0, 0, -3)},
+			{ReviewdateID: "none-0", TargetWeight: "", InitialScheduledDate: parsedToday},
+			{ReviewdateID: "heavy-0", TargetWeight: PatternDomain.TargetWeightHeavy, InitialScheduledDate: parsedToday},
+			{ReviewdateID: "normal-1", TargetWeight: PatternDomain.TargetWeightNormal, InitialScheduledDate: parsedToday.AddDate(0, 0, -1)},
+			{ReviewdateID: "heavy-2", TargetWeight: PatternDomain.TargetWeightHeavy, InitialScheduledDate: parsedToday.AddDate(0, 0, -2)},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		keys      []string
+		completed []string // 完了済みにする復習日のID
+		want      []string
+	}{
+		{
+			name: "キーがない場合は元の順番のまま",
+			keys: nil,
+			want: []string{"light-3", "none-0", "heavy-0", "normal-1", "heavy-2"},
+		},
+		{
+			name: "重みが同じ場合は元の順番を保つ",
+			keys: []string{DailyReviewOrderWeight},
+			want: []string{"heavy-0", "heavy-2", "normal-1", "light-3", "none-0"},
+		},
+		{
+			name: "重みが同じ場合は遅れている順",
+			keys: []string{DailyReviewOrderWeight, DailyReviewOrderOverdue},
+			want: []string{"heavy-2", "heavy-0", "normal-1", "light-3", "none-0"},
+		},
+		{
+			name: "遅れている順で、遅れが同じ場合は重い順",
+			keys: []string{DailyReviewOrderOverdue, DailyReviewOrderWeight},
+			want: []string{"light-3", "heavy-2", "normal-1", "heavy-0", "none-0"},
+		},
+		{
+			name:      "完了済みの復習日はキーによらず未完了の復習日の後",
+			keys:      []string{DailyReviewOrderWeight},
+			completed: []string{"heavy-0"},
+			want:      []string{"heavy-2", "normal-1", "light-3", "none-0", "heavy-0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dailyDates := newDailyDates()
+			for _, d := range dailyDates {
+				for _, id := range tt.completed {
+					if d.ReviewdateID == id {
+						d.IsCompleted = true
+					}
+				}
+			}
+			SortDailyReviewDates(dailyDates, tt.keys, parsedToday)
+
+			got := make([]string, len(dailyDates))
+			for i, d := range dailyDates {
+				got[i] = d.ReviewdateID
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("SortDailyReviewDates() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLimitDailyReviewDates(t *testing.T) {
+	dailyDates := []*DailyReviewDate{
+		{ReviewdateID: "pending-1"},
+		{ReviewdateID: "pending-2"},
+		{ReviewdateID: "pending-3"},
+		{ReviewdateID: "completed-1", IsCompleted: true},
+		{ReviewdateID: "completed-2", IsCompleted: true},
+	}
+
+	tests := []struct {
+		name  string
+		limit int
+		want  []string
+	}{
+		{name: "0の場合は絞らない", limit: 0, want: []string{"pending-1", "pending-2", "pending-3", "completed-1", "completed-2"}},
+		{name: "完了済みの復習日は件数に数えない", limit: 2, want: []string{"pending-1", "pending-2", "completed-1", "completed-2"}},
+		{name: "未完了の復習日がlimitより少ない場合は全て返す", limit: 5, want: []string{"pending-1", "pending-2", "pending-3", "completed-1", "completed-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limited := LimitDailyReviewDates(dailyDates, tt.limit)
+
+			got := make([]string, len(limited))
+			for i, d := range limited {
+				got[i] = d.ReviewdateID
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("LimitDailyReviewDates() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -7,4 +7,32 @@ var (
 	ErrHasCompletedReviewDate                     = errors.New("完了済みの復習物があるため、復習パターンを変更できません")
 	ErrNewScheduledDateBeforeInitialScheduledDate = errors.New("新しい復習日は初期復習日より前に設定できません")
 	ErrMismatchedIDsAndSteps                      = errors.New("復習パターンのステップ数と復習日数が一致しません")
+	ErrInvalidGrade                               = errors.New("想起度は0〜5で指定してください")
+	ErrReviewDateNotFound                         = errors.New("復習日が見つかりません")
+	ErrReviewDateAlreadyCompleted                 = errors.New("完了済みの復習日は想起失敗にできません")
+	ErrInvalidForecastDays                        = errors.New("予測する日数は1〜365で指定してください")
+	ErrItemHasNoPattern                           = errors.New("復習パターンが設定されていない復習物です")
+	ErrItemAlreadyFinished                        = errors.New("完了済みの復習物は復習パターンを更新できません")
+	ErrItemPatternAlreadyLatest                   = errors.New("復習物は既に最新の復習パターンを使用しています")
+	ErrItemPatternUpgradeNotSupported             = errors.New("間隔の揺らぎがない固定ステップ方式の復習パターンでのみ最新のバージョンに更新できます")
+	ErrInvalidDailyReviewOrder                    = errors.New("並び順はweight・overdueをカンマ区切りで重複なく指定してください")
+	ErrInvalidDailyReviewLimit                    = errors.New("取得件数は0〜1000で指定してください")
+	ErrInvalidDurationSeconds                     = errors.New("復習にかかった秒数は0〜86400で指定してください")
+	ErrInvalidHeatmapDays                         = errors.New("ヒートマップの日数は1〜371で指定してください")
+	ErrInvalidHeatmapFilter                       = errors.New("ボックスと未分類は同時に指定できません")
+	ErrInvalidUndoCount                           = errors.New("取り消す操作の数は1〜20で指定してください")
+	ErrNotEnoughItemOperationsToUndo              = errors.New("取り消せる操作が指定した数だけありません")
+	ErrItemOperationReferenceDeleted              = errors.New("復習物のカテゴリー・ボックス・復習パターンが削除されているため、操作を取り消せません")
+	ErrInvalidSnoozeDays                          = errors.New("先送りする日数は1〜365で指定してください")
+	ErrInvalidSnoozePolicy                        = errors.New("先送りの範囲はonly_this・shift_laterのいずれかで指定してください")
+	ErrSnoozeCompletedReviewDate                  = errors.New("完了済みの復習日は先送りできません")
+	ErrSnoozedReviewDateOutOfOrder                = errors.New("先送りすると次のステップの復習日と同じ日かそれより後になります")
+	ErrInvalidDailyReviewScope                    = errors.New("復習日IDとカテゴリー・ボックス・未分類、またはボックスと未分類は同時に指定できません")
+	ErrTooManyBulkReviewDates                     = errors.New("まとめて操作できる復習日は500件までです")
+	ErrEmptySearchQuery                           = errors.New("検索語を入力してください")
+	ErrInvalidSearchQuery                         = errors.New("検索語は100文字以内・5語以内で指定してください")
+	ErrInvalidSearchLimit                         = errors.New("検索結果の件数は1〜100で指定してください")
+	ErrInvalidSearchLearnedDateRange              = errors.New("学習日の範囲は開始日を終了日以前で指定してください")
+	ErrInvalidItemID                              = errors.New("復習物IDはUUID形式で指定してください")
+	ErrItemAlreadyExists                          = errors.New("同じIDの復習物が既に存在します")
 )
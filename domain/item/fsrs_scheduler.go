@@ -0,0 +1,228 @@
+package item
+
+import (
+	"math"
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+// FSRS（Free Spaced Repetition Scheduler）v4.5のデフォルトパラメータ
+var fsrsWeights = [17]float64{
+	0.4872, 1.4003, 3.7145, 13.8206,
+	5.1618, 1.2298, 0.8975, 0.031,
+	1.6474, 0.1367, 1.0461,
+	2.1072, 0.0793, 0.3246, 1.587,
+	0.2272, 2.8755,
+}
+
+const (
+	// 忘却曲線 R(t) = (1 + fsrsFactor * t / S) ^ fsrsDecay
+	fsrsDecay  = -0.5
+	fsrsFactor = 19.0 / 81.0
+
+	fsrsMinDifficulty = 1.0
+	fsrsMaxDifficulty = 10.0
+	fsrsMinStability  = 0.1
+	fsrsMaxInterval   = 36500
+	fsrsMaxTotalDays  = 32767 // pattern_steps.interval_daysの上限
+
+	// FSRSの評価
+	fsrsAgain = 1
+	fsrsHard  = 2
+	fsrsGood  = 3
+	fsrsEasy  = 4
+
+	// FSRS方式の復習パターンで自動生成する復習ステップ数
+	FSRSDefaultReviewCount = 5
+)
+
+// 記憶の安定度と難しさから、想起率が目標記憶保持率まで下がる日を次の復習日にするドメインサービス
+// 復習日の初期計算は固定ステップと同じ（ステップは目標記憶保持率から自動生成したもの）で、復習日完了時の再計算だけが異なる
+type fsrsScheduler struct {
+	scheduler
+}
+
+func NewFSRSScheduler() IScheduler {
+	return &fsrsScheduler{}
+}
+
+func (s *fsrsScheduler) RescheduleAfterCompletion(
+	targetPattern *PatternDomain.Pattern,
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	completedStepNumber int,
+	state MemoryState,
+	grade int,
+	parsedLastReviewedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, MemoryState, error) {
+	if grade < MinGrade || grade > MaxGrade {
+		return nil, state, ErrInvalidGrade
+	}
+	if len(targetPatternSteps) != len(reviewdates) {
+		return nil, state, ErrMismatchedIDsAndSteps
+	}
+
+	targetRetention := targetPattern.TargetRetention
+	if targetRetention == 0 {
+		targetRetention = PatternDomain.DefaultTargetRetention
+	}
+
+	stability := state.Stability
+	difficulty := state.Difficulty
+	// 未初期化の場合は学習日に「Good」で覚えたものとみなす
+	if stability <= 0 || difficulty <= 0 {
+		stability = fsrsInitialStability(fsrsGood)
+		difficulty = fsrsInitialDifficulty(fsrsGood)
+	}
+
+	elapsedDays := parsedToday.Sub(parsedLastReviewedDate).Hours() / 24
+	if elapsedDays < 0 {
+		elapsedDays = 0
+	}
+	retrievability := fsrsRetrievability(elapsedDays, stability)
+
+	rating := fsrsRating(grade)
+	if rating == fsrsAgain {
+		stability = fsrsNextForgetStability(difficulty, stability, retrievability)
+	} else {
+		stability = fsrsNextRecallStability(difficulty, stability, retrievability, rating)
+	}
+	difficulty = fsrsNextDifficulty(difficulty, rating)
+
+	nextState := state
+	nextState.Stability = stability
+	nextState.Difficulty = difficulty
+
+	// 残りの復習日は、以降の復習も予定日に「Good」で想起できる前提で見積もる
+	result := make([]*Reviewdate, 0, len(reviewdates))
+	baseDate := parsedToday
+	for _, rd := range reviewdates {
+		if rd.StepNumber <= completedStepNumber || rd.IsCompleted {
+			continue
+		}
+
+		baseDate = baseDate.AddDate(0, 0, fsrsNextInterval(stability, targetRetention))
+
+		reviewdate, err := NewReviewdate(
+			rd.ReviewdateID,
+			rd.UserID,
+			rd.CategoryID,
+			rd.BoxID,
+			rd.ItemID,
+			rd.StepNumber,
+			baseDate,
+			baseDate,
+			false,
+		)
+		if err != nil {
+			return nil, state, err
+		}
+		result = append(result, reviewdate)
+
+		// 予定日に復習した場合、想起率はちょうど目標記憶保持率になる
+		stability = fsrsNextRecallStability(difficulty, stability, targetRetention, fsrsGood)
+		difficulty = fsrsNextDifficulty(difficulty, fsrsGood)
+	}
+
+	return result, nextState, nil
+}
+
+// 学習日から毎回「Good」で想起できた場合の各復習日までの日数（学習日からの累計）を求める。
+// FSRS方式の復習パターンのステップを自動生成するために使う。累計が上限を超えるステップは生成しない。
+func FSRSProjectedIntervalDays(targetRetention float64, reviewCount int) []int {
+	stability := fsrsInitialStability(fsrsGood)
+	difficulty := fsrsInitialDifficulty(fsrsGood)
+
+	intervalDays := make([]int, 0, reviewCount)
+	total := 0
+	for i := 0; i < reviewCount; i++ {
+		total += fsrsNextInterval(stability, targetRetention)
+		if total > fsrsMaxTotalDays {
+			break
+		}
+		intervalDays = append(intervalDays, total)
+
+		stability = fsrsNextRecallStability(difficulty, stability, targetRetention, fsrsGood)
+		difficulty = fsrsNextDifficulty(difficulty, fsrsGood)
+	}
+	return intervalDays
+}
+
+// 想起度（0〜5）をFSRSの評価（1: Again, 2: Hard, 3: Good, 4: Easy）に読み替える
+func fsrsRating(grade int) int {
+	switch {
+	case grade < passingGrade:
+		return fsrsAgain
+	case grade == passingGrade:
+		return fsrsHard
+	case grade == MaxGrade:
+		return fsrsEasy
+	default:
+		return fsrsGood
+	}
+}
+
+// 最後の復習からelapsedDays日経過した時点での想起率
+func fsrsRetrievability(elapsedDays float64, stability float64) float64 {
+	return math.Pow(1+fsrsFactor*elapsedDays/stability, fsrsDecay)
+}
+
+// 想起率が目標記憶保持率まで下がるまでの日数
+func fsrsNextInterval(stability float64, targetRetention float64) int {
+	interval := stability / fsrsFactor * (math.Pow(targetRetention, 1/fsrsDecay) - 1)
+	days := int(math.Round(interval))
+	if days < 1 {
+		return 1
+	}
+	if days > fsrsMaxInterval {
+		return fsrsMaxInterval
+	}
+	return days
+}
+
+func fsrsInitialStability(rating int) float64 {
+	return math.Max(fsrsWeights[rating-1], fsrsMinStability)
+}
+
+func fsrsInitialDifficulty(rating int) float64 {
+	return clampDifficulty(fsrsWeights[4] - float64(rating-3)*fsrsWeights[5])
+}
+
+// 評価に応じて難しさを増減させ、初期値へ少しずつ引き戻す
+func fsrsNextDifficulty(difficulty float64, rating int) float64 {
+	next := difficulty - fsrsWeights[6]*float64(rating-3)
+	return clampDifficulty(fsrsWeights[7]*fsrsInitialDifficulty(fsrsGood) + (1-fsrsWeights[7])*next)
+}
+
+// 想起に成功した場合の次の安定度
+func fsrsNextRecallStability(difficulty float64, stability float64, retrievability float64, rating int) float64 {
+	hardPenalty := 1.0
+	if rating == fsrsHard {
+		hardPenalty = fsrsWeights[15]
+	}
+	easyBonus := 1.0
+	if rating == fsrsEasy {
+		easyBonus = fsrsWeights[16]
+	}
+	return stability * (1 + math.Exp(fsrsWeights[8])*
+		(11-difficulty)*
+		math.Pow(stability, -fsrsWeights[9])*
+		(math.Exp((1-retrievability)*fsrsWeights[10])-1)*
+		hardPenalty*
+		easyBonus)
+}
+
+// 想起に失敗した場合の次の安定度（元の安定度は超えない）
+func fsrsNextForgetStability(difficulty float64, stability float64, retrievability float64) float64 {
+	next := fsrsWeights[11] *
+		math.Pow(difficulty, -fsrsWeights[12]) *
+		(math.Pow(stability+1, fsrsWeights[13]) - 1) *
+		math.Exp((1-retrievability)*fsrsWeights[14])
+	return math.Max(math.Min(next, stability), fsrsMinStability)
+}
+
+func clampDifficulty(difficulty float64) float64 {
+	return math.Min(math.Max(difficulty, fsrsMinDifficulty), fsrsMaxDifficulty)
+}
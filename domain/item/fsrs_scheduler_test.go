@@ -0,0 +1,148 @@
+package item
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+func TestFSRSScheduler_RescheduleAfterCompletion(t *testing.T) {
+	scheduler := NewFSRSScheduler()
+
+	// 目標記憶保持率0.9で自動生成したステップ（学習日から4, 18, 65日後）
+	targetPatternSteps := []*PatternDomain.PatternStep{
+		{StepNumber: 1, IntervalDays: 4},
+		{StepNumber: 2, IntervalDays: 18},
+		{StepNumber: 3, IntervalDays: 65},
+	}
+	newReviewdates := func() []*Reviewdate {
+		return []*Reviewdate{
+			{ReviewdateID: "rd1", UserID: "user123", ItemID: "item123", StepNumber: 1, InitialScheduledDate: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+			{ReviewdateID: "rd2", UserID: "user123", ItemID: "item123", StepNumber: 2, InitialScheduledDate: time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+			{ReviewdateID: "rd3", UserID: "user123", ItemID: "item123", StepNumber: 3, InitialScheduledDate: time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+		}
+	}
+	targetPattern := &PatternDomain.Pattern{SchedulerKind: PatternDomain.SchedulerKindFSRS, TargetRetention: 0.9}
+	parsedLearnedDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	parsedToday := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	reschedule := func(t *testing.T, pattern *PatternDomain.Pattern, state MemoryState, grade int) ([]*Reviewdate, MemoryState) {
+		t.Helper()
+		got, gotState, err := scheduler.RescheduleAfterCompletion(pattern, targetPatternSteps, newReviewdates(), 1, state, grade, parsedLearnedDate, parsedToday)
+		if err != nil {
+			t.Fatalf("RescheduleAfterCompletion() unexpected error = %v", err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("RescheduleAfterCompletion() len = %d, want 2", len(got))
+		}
+		// 再計算された復習日は今日より後で、ステップ順に並んでいること
+		prev := parsedToday
+		for _, rd := range got {
+			if !rd.ScheduledDate.After(prev) {
+				t.Errorf("%s の ScheduledDate = %v が直前の日付 %v より後になっていません", rd.ReviewdateID, rd.ScheduledDate, prev)
+			}
+			if rd.IsCompleted {
+				t.Errorf("%s が完了済みになっています", rd.ReviewdateID)
+			}
+			prev = rd.ScheduledDate
+		}
+		return got, gotState
+	}
+
+	t.Run("未初期化の状態で予定通りGoodで想起できた場合は安定度が初期値より大きくなる", func(t *testing.T) {
+		_, gotState := reschedule(t, targetPattern, MemoryState{EaseFactor: DefaultEaseFactor}, 4)
+		if gotState.Stability <= fsrsInitialStability(fsrsGood) {
+			t.Errorf("Stability = %v, want > %v", gotState.Stability, fsrsInitialStability(fsrsGood))
+		}
+		if gotState.Difficulty < fsrsMinDifficulty || gotState.Difficulty > fsrsMaxDifficulty {
+			t.Errorf("Difficulty = %v, want 1〜10", gotState.Difficulty)
+		}
+		if gotState.EaseFactor != DefaultEaseFactor {
+			t.Errorf("EaseFactor = %v, FSRSでは変更しないはず", gotState.EaseFactor)
+		}
+	})
+
+	t.Run("想起に失敗した場合は安定度が下がり次の復習日が近くなる", func(t *testing.T) {
+		good, goodState := reschedule(t, targetPattern, MemoryState{}, 4)
+		again, againState := reschedule(t, targetPattern, MemoryState{}, 1)
+		if againState.Stability >= goodState.Stability {
+			t.Errorf("Again の Stability = %v, Good の Stability = %v より小さくなるはず", againState.Stability, goodState.Stability)
+		}
+		if againState.Difficulty <= goodState.Difficulty {
+			t.Errorf("Again の Difficulty = %v, Good の Difficulty = %v より大きくなるはず", againState.Difficulty, goodState.Difficulty)
+		}
+		if !again[0].ScheduledDate.Before(good[0].ScheduledDate) {
+			t.Errorf("Again の次の復習日 %v が Good の次の復習日 %v より前になっていません", again[0].ScheduledDate, good[0].ScheduledDate)
+		}
+	})
+
+	t.Run("Easyの場合はGoodより次の復習日が遠くなる", func(t *testing.T) {
+		good, _ := reschedule(t, targetPattern, MemoryState{}, 4)
+		easy, _ := reschedule(t, targetPattern, MemoryState{}, 5)
+		if !easy[0].ScheduledDate.After(good[0].ScheduledDate) {
+			t.Errorf("Easy の次の復習日 %v が Good の次の復習日 %v より後になっていません", easy[0].ScheduledDate, good[0].ScheduledDate)
+		}
+	})
+
+	t.Run("目標記憶保持率が高いほど次の復習日が近くなる", func(t *testing.T) {
+		low, _ := reschedule(t, &PatternDomain.Pattern{SchedulerKind: PatternDomain.SchedulerKindFSRS, TargetRetention: 0.8}, MemoryState{}, 4)
+		high, _ := reschedule(t, &PatternDomain.Pattern{SchedulerKind: PatternDomain.SchedulerKindFSRS, TargetRetention: 0.95}, MemoryState{}, 4)
+		if !high[0].ScheduledDate.Before(low[0].ScheduledDate) {
+			t.Errorf("保持率0.95の次の復習日 %v が保持率0.8の次の復習日 %v より前になっていません", high[0].ScheduledDate, low[0].ScheduledDate)
+		}
+	})
+
+	t.Run("想起度が範囲外の場合はエラー", func(t *testing.T) {
+		_, _, err := scheduler.RescheduleAfterCompletion(targetPattern, targetPatternSteps, newReviewdates(), 1, MemoryState{}, -1, parsedLearnedDate, parsedToday)
+		if !errors.Is(err, ErrInvalidGrade) {
+			t.Errorf("RescheduleAfterCompletion() error = %v, wantErr %v", err, ErrInvalidGrade)
+		}
+	})
+
+	t.Run("ステップ数と復習日数が一致しない場合はエラー", func(t *testing.T) {
+		_, _, err := scheduler.RescheduleAfterCompletion(targetPattern, targetPatternSteps[:2], newReviewdates(), 1, MemoryState{}, 4, parsedLearnedDate, parsedToday)
+		if !errors.Is(err, ErrMismatchedIDsAndSteps) {
+			t.Errorf("RescheduleAfterCompletion() error = %v, wantErr %v", err, ErrMismatchedIDsAndSteps)
+		}
+	})
+}
+
+func TestFSRSProjectedIntervalDays(t *testing.T) {
+	tests := []struct {
+		name            string
+		targetRetention float64
+		reviewCount     int
+		want            []int
+	}{
+		{
+			name:            "目標記憶保持率0.9",
+			targetRetention: 0.9,
+			reviewCount:     5,
+			want:            []int{4, 18, 65, 205, 582},
+		},
+		{
+			name:            "目標記憶保持率0.97では間隔が短くなる",
+			targetRetention: 0.97,
+			reviewCount:     5,
+			want:            []int{1, 3, 6, 11, 20},
+		},
+		{
+			name:            "累計日数が上限を超えるステップは生成しない",
+			targetRetention: 0.7,
+			reviewCount:     5,
+			want:            []int{16, 186, 1514, 9649},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FSRSProjectedIntervalDays(tt.targetRetention, tt.reviewCount)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FSRSProjectedIntervalDays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package item
+
+import "time"
+
+// ヒートマップで一度に指定できる最大日数（53週分）
+const MaxHeatmapDays = 371
+
+// ヒートマップの集計対象。何も指定しない場合はユーザーの全ての復習物を対象にする
+type HeatmapFilter struct {
+	CategoryID   *string
+	BoxID        *string
+	Unclassified bool // ボックス未分類の復習物のみ（CategoryIDがあればそのカテゴリーの未分類、なければユーザー直下の未分類）
+}
+
+// 日毎の件数（完了した復習日数・学習した復習物数）
+type DailyActivityCount struct {
+	Date  time.Time
+	Count int
+}
+
+func NewHeatmapFilter(categoryID *string, boxID *string, unclassified bool) (*HeatmapFilter, error) {
+	// ボックスを指定した上で未分類に絞り込むことはできない
+	if unclassified && boxID != nil {
+		return nil, ErrInvalidHeatmapFilter
+	}
+	return &HeatmapFilter{
+		CategoryID:   categoryID,
+		BoxID:        boxID,
+		Unclassified: unclassified,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package item
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewHeatmapFilter(t *testing.T) {
+	categoryID := "category1"
+	boxID := "box1"
+
+	tests := []struct {
+		name         string
+		categoryID   *string
+		boxID        *string
+		unclassified bool
+		wantErr      error
+	}{
+		{name: "絞り込みなし"},
+		{name: "カテゴリーで絞り込む", categoryID: &categoryID},
+		{name: "ボックスで絞り込む", categoryID: &categoryID, boxID: &boxID},
+		{name: "カテゴリーの未分類で絞り込む", categoryID: &categoryID, unclassified: true},
+		{name: "ユーザー直下の未分類で絞り込む", unclassified: true},
+		{name: "ボックスと未分類の同時指定はエラー", boxID: &boxID, unclassified: true, wantErr: ErrInvalidHeatmapFilter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewHeatmapFilter(tt.categoryID, tt.boxID, tt.unclassified)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("NewHeatmapFilter() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewHeatmapFilter() unexpected error = %v", err)
+			}
+			if got.CategoryID != tt.categoryID || got.BoxID != tt.boxID || got.Unclassified != tt.unclassified {
+				t.Errorf("NewHeatmapFilter() = %+v", got)
+			}
+		})
+	}
+}
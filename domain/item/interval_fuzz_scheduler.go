@@ -0,0 +1,169 @@
+package item
+
+import (
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+// 他のスケジューラーが計算した未完了の復習日に、復習物IDをシードにした揺らぎを加えるドメインサービス
+// 同じパターンで同じ日に作成した復習物の復習日が、ずっと同じ日に固まり続けないようにする
+type intervalFuzzScheduler struct {
+	base IScheduler
+}
+
+func NewIntervalFuzzScheduler(base IScheduler) IScheduler {
+	return &intervalFuzzScheduler{
+		base: base,
+	}
+}
+
+func (s *intervalFuzzScheduler) FormatWithOverdueMarkedCompleted(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, bool, error) {
+	result, isFinished, err := s.base.FormatWithOverdueMarkedCompleted(targetPatternSteps, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, false, err
+	}
+	return s.fuzz(result, parsedLearnedDate), isFinished, nil
+}
+
+func (s *intervalFuzzScheduler) FormatWithOverdueMarkedInCompleted(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.FormatWithOverdueMarkedInCompleted(targetPatternSteps, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	return s.fuzz(result, parsedLearnedDate), nil
+}
+
+func (s *intervalFuzzScheduler) FormatWithOverdueMarkedCompletedWithIDs(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewDateIDs []string,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, bool, error) {
+	result, isFinished, err := s.base.FormatWithOverdueMarkedCompletedWithIDs(targetPatternSteps, reviewDateIDs, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, false, err
+	}
+	return s.fuzz(result, parsedLearnedDate), isFinished, nil
+}
+
+func (s *intervalFuzzScheduler) FormatWithOverdueMarkedInCompletedWithIDs(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewDateIDs []string,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.FormatWithOverdueMarkedInCompletedWithIDs(targetPatternSteps, reviewDateIDs, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	return s.fuzz(result, parsedLearnedDate), nil
+}
+
+func (s *intervalFuzzScheduler) FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewDateIDs []string,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	diff time.Duration,
+) ([]*Reviewdate, error) {
+	result, err := s.base.FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates(targetPatternSteps, reviewDateIDs, userID, categoryID, boxID, itemID, parsedLearnedDate, diff)
+	if err != nil {
+		return nil, err
+	}
+	return s.fuzz(result, parsedLearnedDate), nil
+}
+
+func (s *intervalFuzzScheduler) RescheduleAfterCompletion(
+	targetPattern *PatternDomain.Pattern,
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	completedStepNumber int,
+	state MemoryState,
+	grade int,
+	parsedLastReviewedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, MemoryState, error) {
+	result, nextState, err := s.base.RescheduleAfterCompletion(targetPattern, targetPatternSteps, reviewdates, completedStepNumber, state, grade, parsedLastReviewedDate, parsedToday)
+	if err != nil {
+		return nil, state, err
+	}
+	return s.fuzz(result, parsedToday), nextState, nil
+}
+
+func (s *intervalFuzzScheduler) RescheduleFromCompletedDate(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	completedStepNumber int,
+	parsedCompletedDate time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.RescheduleFromCompletedDate(targetPatternSteps, reviewdates, completedStepNumber, parsedCompletedDate)
+	if err != nil {
+		return nil, err
+	}
+	return s.fuzz(result, parsedCompletedDate), nil
+}
+
+func (s *intervalFuzzScheduler) RescheduleAfterFailure(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.RescheduleAfterFailure(targetPatternSteps, reviewdates, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	return s.fuzz(result, parsedToday), nil
+}
+
+// baseDate からの間隔に揺らぎを加える。復習日はステップ順に並んでいる前提で、前の復習日より後になるようにする
+func (s *intervalFuzzScheduler) fuzz(reviewdates []*Reviewdate, baseDate time.Time) []*Reviewdate {
+	prev := baseDate
+	for _, rd := range reviewdates {
+		if rd.IsCompleted {
+			if rd.ScheduledDate.After(prev) {
+				prev = rd.ScheduledDate
+			}
+			continue
+		}
+
+		intervalDays := int(rd.ScheduledDate.Sub(baseDate).Hours() / 24)
+		fuzzed := baseDate.AddDate(0, 0, fuzzIntervalDays(rd.ItemID, rd.StepNumber, intervalDays))
+		if !fuzzed.After(prev) {
+			fuzzed = prev.AddDate(0, 0, 1)
+		}
+		// 揺らぎは復習日の計画そのものに含めるため、初回の予定日も同じ日数だけずらす
+		offsetDays := int(fuzzed.Sub(rd.ScheduledDate).Hours() / 24)
+		rd.InitialScheduledDate = rd.InitialScheduledDate.AddDate(0, 0, offsetDays)
+		rd.ScheduledDate = fuzzed
+		prev = fuzzed
+	}
+	return reviewdates
+}
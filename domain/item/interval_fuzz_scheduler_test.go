@@ -0,0 +1,103 @@
+package item
+
+import (
+	"testing"
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+func TestFuzzIntervalDays(t *testing.T) {
+	tests := []struct {
+		name         string
+		intervalDays int
+		wantMin      int
+		wantMax      int
+	}{
+		{
+			name:         "短い間隔には揺らぎを加えない",
+			intervalDays: 7,
+			wantMin:      7,
+			wantMax:      7,
+		},
+		{
+			name:         "間隔の5%までの揺らぎを加える",
+			intervalDays: 60,
+			wantMin:      60,
+			wantMax:      63,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, itemID := range []string{"item1", "item2", "item3", "item4", "item5"} {
+				got := fuzzIntervalDays(itemID, 3, tt.intervalDays)
+				if got < tt.wantMin || got > tt.wantMax {
+					t.Errorf("fuzzIntervalDays(%q, 3, %d) = %d, want %d〜%d", itemID, tt.intervalDays, got, tt.wantMin, tt.wantMax)
+				}
+				if again := fuzzIntervalDays(itemID, 3, tt.intervalDays); again != got {
+					t.Errorf("fuzzIntervalDays(%q) が再計算で変わりました: %d -> %d", itemID, got, again)
+				}
+			}
+		})
+	}
+}
+
+func TestIntervalFuzzScheduler_FormatWithOverdueMarkedInCompletedWithIDs(t *testing.T) {
+	scheduler := NewIntervalFuzzScheduler(NewScheduler())
+
+	categoryID := "category123"
+	boxID := "box123"
+	targetPatternSteps := []*PatternDomain.PatternStep{
+		{StepNumber: 1, IntervalDays: 1},
+		{StepNumber: 2, IntervalDays: 40},
+		{StepNumber: 3, IntervalDays: 41},
+		{StepNumber: 4, IntervalDays: 120},
+	}
+	reviewDateIDs := []string{"rd1", "rd2", "rd3", "rd4"}
+	parsedLearnedDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	format := func(itemID string) []*Reviewdate {
+		got, err := scheduler.FormatWithOverdueMarkedInCompletedWithIDs(targetPatternSteps, reviewDateIDs, "user123", &categoryID, &boxID, itemID, parsedLearnedDate, parsedLearnedDate)
+		if err != nil {
+			t.Fatalf("FormatWithOverdueMarkedInCompletedWithIDs() unexpected error = %v", err)
+		}
+		return got
+	}
+
+	t.Run("同じ復習物なら再計算しても同じ復習日になる", func(t *testing.T) {
+		first := format("item-a")
+		second := format("item-a")
+		for i := range first {
+			if !first[i].ScheduledDate.Equal(second[i].ScheduledDate) {
+				t.Errorf("ステップ%d の復習日が再計算で変わりました: %v -> %v", first[i].StepNumber, first[i].ScheduledDate, second[i].ScheduledDate)
+			}
+		}
+	})
+
+	t.Run("復習日はステップ順に前の復習日より後になり、初回の予定日と一致する", func(t *testing.T) {
+		for _, itemID := range []string{"item-a", "item-b", "item-c", "item-d", "item-e"} {
+			prev := parsedLearnedDate
+			for _, rd := range format(itemID) {
+				if !rd.ScheduledDate.After(prev) {
+					t.Errorf("%s のステップ%d の復習日 %v が前の復習日 %v より後になっていません", itemID, rd.StepNumber, rd.ScheduledDate, prev)
+				}
+				if !rd.InitialScheduledDate.Equal(rd.ScheduledDate) {
+					t.Errorf("%s のステップ%d の InitialScheduledDate = %v, want %v", itemID, rd.StepNumber, rd.InitialScheduledDate, rd.ScheduledDate)
+				}
+				prev = rd.ScheduledDate
+			}
+		}
+	})
+
+	t.Run("同じ日に作成した復習物でも復習日がばらける", func(t *testing.T) {
+		lastDates := make(map[time.Time]struct{})
+		for _, itemID := range []string{"item-a", "item-b", "item-c", "item-d", "item-e", "item-f", "item-g", "item-h"} {
+			got := format(itemID)
+			lastDates[got[len(got)-1].ScheduledDate] = struct{}{}
+		}
+		if len(lastDates) < 2 {
+			t.Errorf("最後のステップの復習日が全て同じ日になりました: %v", lastDates)
+		}
+	})
+}
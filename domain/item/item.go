@@ -8,17 +8,18 @@ import (
 )
 
 type Item struct {
-	ItemID       string
-	UserID       string
-	CategoryID   *string
-	BoxID        *string
-	PatternID    *string
-	Name         string
-	Detail       string
-	LearnedDate  time.Time
-	IsFinished   bool
-	RegisteredAt time.Time
-	EditedAt     time.Time
+	ItemID         string
+	UserID         string
+	CategoryID     *string
+	BoxID          *string
+	PatternID      *string
+	PatternVersion int // 復習日を計算した時点の復習パターンのバージョン（復習パターンがない場合は0）
+	Name           string
+	Detail         string
+	LearnedDate    time.Time
+	IsFinished     bool
+	RegisteredAt   time.Time
+	EditedAt       time.Time
 }
 
 func NewItem(
@@ -63,6 +64,7 @@ func ReconstructItem(
 	categoryID *string,
 	boxID *string,
 	patternID *string,
+	patternVersion int,
 	name string,
 	detail string,
 	learnedDate time.Time,
@@ -71,17 +73,18 @@ func ReconstructItem(
 	editedAt time.Time,
 ) (*Item, error) {
 	i := &Item{
-		ItemID:       itemID,
-		UserID:       userID,
-		CategoryID:   categoryID,
-		BoxID:        boxID,
-		PatternID:    patternID,
-		Name:         name,
-		Detail:       detail,
-		LearnedDate:  learnedDate,
-		IsFinished:   isFinished,
-		RegisteredAt: registeredAt,
-		EditedAt:     editedAt,
+		ItemID:         itemID,
+		UserID:         userID,
+		CategoryID:     categoryID,
+		BoxID:          boxID,
+		PatternID:      patternID,
+		PatternVersion: patternVersion,
+		Name:           name,
+		Detail:         detail,
+		LearnedDate:    learnedDate,
+		IsFinished:     isFinished,
+		RegisteredAt:   registeredAt,
+		EditedAt:       editedAt,
 	}
 	return i, nil
 }
@@ -139,6 +142,7 @@ type Reviewdate struct {
 	InitialScheduledDate time.Time
 	ScheduledDate        time.Time
 	IsCompleted          bool
+	CompletedDate        *time.Time // 実際に復習した日。完了扱いにしただけの復習日や未完了の復習日はnil
 }
 
 func NewReviewdate(
@@ -179,6 +183,7 @@ func ReconstructReviewdate(
 	initialScheduledDate time.Time,
 	scheduledDate time.Time,
 	isCompleted bool,
+	completedDate *time.Time,
 ) (*Reviewdate, error) {
 	rd := &Reviewdate{
 		ReviewdateID:         reviewdateID,
@@ -190,6 +195,7 @@ func ReconstructReviewdate(
 		InitialScheduledDate: initialScheduledDate,
 		ScheduledDate:        scheduledDate,
 		IsCompleted:          isCompleted,
+		CompletedDate:        completedDate,
 	}
 	return rd, nil
 }
@@ -219,6 +225,46 @@ func (s *Reviewdate) SetOnlyIDs(
 	return nil
 }
 
+// 復習日に想起失敗した記録
+type ReviewFailure struct {
+	ReviewFailureID string
+	UserID          string
+	ItemID          string
+	StepNumber      int
+	ScheduledDate   time.Time // 失敗した復習日の予定日
+	FailedDate      time.Time
+}
+
+func NewReviewFailure(
+	reviewFailureID string,
+	userID string,
+	itemID string,
+	stepNumber int,
+	scheduledDate time.Time,
+	failedDate time.Time,
+) (*ReviewFailure, error) {
+	if err := validateFailedDate(failedDate); err != nil {
+		return nil, err
+	}
+
+	f := &ReviewFailure{
+		ReviewFailureID: reviewFailureID,
+		UserID:          userID,
+		ItemID:          itemID,
+		StepNumber:      stepNumber,
+		ScheduledDate:   scheduledDate,
+		FailedDate:      failedDate,
+	}
+	return f, nil
+}
+
+func validateFailedDate(failedDate time.Time) error {
+	return validation.Validate(
+		failedDate,
+		validation.Required.Error("想起失敗日は必須です"),
+	)
+}
+
 type IScheduler interface {
 	FormatWithOverdueMarkedCompleted(
 		targetPatternSteps []*PatternDomain.PatternStep,
@@ -272,4 +318,59 @@ type IScheduler interface {
 		parsedLearnedDate time.Time,
 		diff time.Duration,
 	) ([]*Reviewdate, error)
+
+	// 復習日完了時に想起度（grade）から次の記憶の状態を求め、完了したステップより後の未完了の復習日を再計算する。
+	// 再計算の必要がない方式では空のスライスと元の記憶の状態をそのまま返す。
+	RescheduleAfterCompletion(
+		targetPattern *PatternDomain.Pattern,
+		targetPatternSteps []*PatternDomain.PatternStep,
+		reviewdates []*Reviewdate,
+		completedStepNumber int,
+		state MemoryState,
+		grade int,
+		parsedLastReviewedDate time.Time,
+		parsedToday time.Time,
+	) ([]*Reviewdate, MemoryState, error)
+
+	// 間隔の起点を完了日にする復習パターンで、完了したステップより後の未完了の復習日を実際に完了した日から再計算する。
+	RescheduleFromCompletedDate(
+		targetPatternSteps []*PatternDomain.PatternStep,
+		reviewdates []*Reviewdate,
+		completedStepNumber int,
+		parsedCompletedDate time.Time,
+	) ([]*Reviewdate, error)
+
+	// 復習日に想起失敗した時に復習日を再計算する。
+	// 再計算の必要がない方式では空のスライスを返す（失敗の記録だけを残す）。
+	RescheduleAfterFailure(
+		targetPatternSteps []*PatternDomain.PatternStep,
+		reviewdates []*Reviewdate,
+		parsedToday time.Time,
+	) ([]*Reviewdate, error)
+}
+
+// 想起度に応じたスケジューリングで使う復習物毎の記憶の状態
+type MemoryState struct {
+	EaseFactor float64 // SM-2の易しさ係数
+	Stability  float64 // FSRSの安定度（想起率が90%に下がるまでの日数）。0は未初期化
+	Difficulty float64 // FSRSの難しさ（1〜10）。0は未初期化
+}
+
+// 完了したステップの一つ前に復習した日を求める。まだ一度も復習していなければ学習日を返す。
+// 完了日が記録されていない復習日（完了扱いにしただけのもの）は予定日に復習したものとみなす。
+func LastReviewedDate(reviewdates []*Reviewdate, completedStepNumber int, parsedLearnedDate time.Time) time.Time {
+	lastReviewedDate := parsedLearnedDate
+	for _, rd := range reviewdates {
+		if rd.StepNumber >= completedStepNumber || !rd.IsCompleted {
+			continue
+		}
+		reviewedDate := rd.ScheduledDate
+		if rd.CompletedDate != nil {
+			reviewedDate = *rd.CompletedDate
+		}
+		if reviewedDate.After(lastReviewedDate) {
+			lastReviewedDate = reviewedDate
+		}
+	}
+	return lastReviewedDate
 }
@@ -0,0 +1,123 @@
+package item
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// 取り消せるように、操作の直前の復習物と復習日の状態を保存した操作
+type ItemOperation struct {
+	OperationID string
+	UserID      string
+	Kind        string
+	ItemIDs     []string // 操作で変更した復習物
+	OperatedAt  time.Time
+}
+
+// 取り消せる操作の種類
+const (
+	ItemOperationKindCreateItem           string = "create_item"
+	ItemOperationKindUpdateItem           string = "update_item"
+	ItemOperationKindDeleteItem           string = "delete_item"
+	ItemOperationKindUpdateReviewDates    string = "update_review_dates"
+	ItemOperationKindCompleteReviewDate   string = "complete_review_date"
+	ItemOperationKindIncompleteReviewDate string = "incomplete_review_date"
+	ItemOperationKindFailReviewDate       string = "fail_review_date"
+	ItemOperationKindFinishItem           string = "finish_item"
+	ItemOperationKindUnfinishItem         string = "unfinish_item"
+	ItemOperationKindUpgradeItemPattern   string = "upgrade_item_pattern"
+	ItemOperationKindSnoozeReviewDate     string = "snooze_review_date"
+)
+
+const (
+	// 操作してからこの時間が過ぎると取り消せない
+	UndoWindow = 30 * time.Minute
+	// 一度に取り消せる操作の数の上限
+	MaxUndoCount = 20
+)
+
+var allowedItemOperationKinds = map[string]struct{}{
+	ItemOperationKindCreateItem:           {},
+	ItemOperationKindUpdateItem:           {},
+	ItemOperationKindDeleteItem:           {},
+	ItemOperationKindUpdateReviewDates:    {},
+	ItemOperationKindCompleteReviewDate:   {},
+	ItemOperationKindIncompleteReviewDate: {},
+	ItemOperationKindFailReviewDate:       {},
+	ItemOperationKindFinishItem:           {},
+	ItemOperationKindUnfinishItem:         {},
+	ItemOperationKindUpgradeItemPattern:   {},
+	ItemOperationKindSnoozeReviewDate:     {},
+}
+
+func NewItemOperation(
+	operationID string,
+	userID string,
+	kind string,
+	itemIDs []string,
+	operatedAt time.Time,
+) (*ItemOperation, error) {
+	if err := validateItemOperationKind(kind); err != nil {
+		return nil, err
+	}
+	if err := validateOperatedItemIDs(itemIDs); err != nil {
+		return nil, err
+	}
+
+	o := &ItemOperation{
+		OperationID: operationID,
+		UserID:      userID,
+		Kind:        kind,
+		ItemIDs:     itemIDs,
+		OperatedAt:  operatedAt,
+	}
+	return o, nil
+}
+
+func ReconstructItemOperation(
+	operationID string,
+	userID string,
+	kind string,
+	itemIDs []string,
+	operatedAt time.Time,
+) (*ItemOperation, error) {
+	o := &ItemOperation{
+		OperationID: operationID,
+		UserID:      userID,
+		Kind:        kind,
+		ItemIDs:     itemIDs,
+		OperatedAt:  operatedAt,
+	}
+	return o, nil
+}
+
+// 一度に取り消す操作の数を検証する
+func ValidateUndoCount(count int) error {
+	if count < 1 || count > MaxUndoCount {
+		return ErrInvalidUndoCount
+	}
+	return nil
+}
+
+func validateItemOperationKind(kind string) error {
+	return validation.Validate(
+		kind,
+		validation.Required.Error("操作の種類は必須です"),
+		validation.By(func(value interface{}) error {
+			k, _ := value.(string)
+			if _, ok := allowedItemOperationKinds[k]; !ok {
+				return errors.New("操作の種類の値が不正です")
+			}
+			return nil
+		}),
+	)
+}
+
+func validateOperatedItemIDs(itemIDs []string) error {
+	return validation.Validate(
+		itemIDs,
+		validation.Required.Error("操作した復習物は1つ以上必要です"),
+	)
+}
@@ -0,0 +1,61 @@
+package item
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewItemOperation(t *testing.T) {
+	operatedAt := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		kind    string
+		itemIDs []string
+		wantErr bool
+	}{
+		{name: "正常系", kind: ItemOperationKindCompleteReviewDate, itemIDs: []string{"item1"}},
+		{name: "複数の復習物をまとめた操作", kind: ItemOperationKindUpdateItem, itemIDs: []string{"item1", "item2"}},
+		{name: "操作の種類が空", kind: "", itemIDs: []string{"item1"}, wantErr: true},
+		{name: "操作の種類が不正", kind: "unknown", itemIDs: []string{"item1"}, wantErr: true},
+		{name: "操作した復習物が空", kind: ItemOperationKindDeleteItem, itemIDs: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewItemOperation("operation1", "user1", tt.kind, tt.itemIDs, operatedAt)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewItemOperation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Kind != tt.kind || len(got.ItemIDs) != len(tt.itemIDs) || !got.OperatedAt.Equal(operatedAt) {
+				t.Errorf("NewItemOperation() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestValidateUndoCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		count   int
+		wantErr error
+	}{
+		{name: "1件", count: 1},
+		{name: "上限ちょうど", count: MaxUndoCount},
+		{name: "0件はエラー", count: 0, wantErr: ErrInvalidUndoCount},
+		{name: "上限超過はエラー", count: MaxUndoCount + 1, wantErr: ErrInvalidUndoCount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUndoCount(tt.count)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateUndoCount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -26,6 +26,17 @@ type UnclassifiedDailyDatesCountGroupedByCategory struct {
 	Count      int
 }
 
+// 負荷予測で一度に指定できる最大日数
+const MaxForecastDays = 365
+
+// 負荷予測用の日毎・カテゴリー毎・ボックス毎の未完了の復習日数
+type ReviewForecastCount struct {
+	ScheduledDate time.Time
+	CategoryID    *string
+	BoxID         *string
+	Count         int
+}
+
 type DailyReviewDate struct {
 	ReviewdateID         string
 	CategoryID           *string
@@ -42,6 +53,7 @@ type DailyReviewDate struct {
 	LearnedDate          time.Time
 	RegisteredAt         time.Time
 	EditedAt             time.Time
+	TargetWeight         string // 復習物の復習パターンの重み（復習パターンがない場合は空）
 }
 
 type IItemRepository interface {
@@ -69,11 +81,23 @@ type IItemRepository interface {
 
 	UpdateItemAsUnFinished(ctx context.Context, itemID string, userID string, editedAt time.Time) error
 
-	// 復習日を完了済みに更新
-	UpdateReviewDateAsCompleted(ctx context.Context, reviewdateID string, userID string) error
+	// 復習日を完了済みに更新し、完了した日を記録する
+	UpdateReviewDateAsCompleted(ctx context.Context, reviewdateID string, userID string, completedDate time.Time) error
 
+	// 復習日を未完了に戻し、記録した完了日を消す
 	UpdateReviewDateAsInCompleted(ctx context.Context, reviewdateID string, userID string) error
 
+	// 想起度に応じたスケジューリング（SM-2、FSRS）で使う復習物毎の記憶の状態
+	GetMemoryStateByItemID(ctx context.Context, itemID string, userID string) (*MemoryState, error)
+	UpdateMemoryState(ctx context.Context, itemID string, userID string, state MemoryState) error
+
+	// 想起失敗の記録
+	CreateReviewFailure(ctx context.Context, failure *ReviewFailure) error
+
+	// 復習日を完了・未完了にした履歴
+	CreateReviewLog(ctx context.Context, log *ReviewLog) error
+	GetReviewLogsByItemID(ctx context.Context, itemID string, userID string) ([]*ReviewLog, error)
+
 	// 復習日巻き戻し操作時の最新復習スケジュールを取得するため・復習日完了操作対象の復習日が最後の復習日かどうか判別するため
 	GetReviewDatesByItemID(ctx context.Context, itemID string, userID string) ([]*Reviewdate, error)
 
@@ -82,6 +106,12 @@ type IItemRepository interface {
 
 	DeleteReviewDates(ctx context.Context, itemID string, userID string) error
 
+	// 復習パターンのステップ変更を既存の復習物に反映する系
+	GetUnFinishedItemsByPatternID(ctx context.Context, patternID string, userID string) ([]*Item, error)
+	GetReviewDatesOfUnFinishedItemsByPatternID(ctx context.Context, patternID string, userID string) ([]*Reviewdate, error)
+	DeleteReviewDatesByIDs(ctx context.Context, reviewdateIDs []string, userID string) error
+	UpdatePatternVersionOfUnFinishedItems(ctx context.Context, patternID string, patternVersion int, userID string) error
+
 	/*-------------*/
 	// ここからしたは取得系
 
@@ -100,6 +130,9 @@ type IItemRepository interface {
 	GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx context.Context, categoryID string, userID string) ([]*Item, error)
 	GetAllUnclassifiedReviewDatesByCategoryID(ctx context.Context, categoryID string, userID string) ([]*Reviewdate, error)
 
+	// タグで一覧を絞り込むために、タグが付いた復習物のIDを取得（他のユーザーのタグの場合は空）
+	GetItemIDsByTagID(ctx context.Context, tagID string, userID string) ([]string, error)
+
 	/*--------------------------------------*/
 
 	//ここから下は概要表示用の取得メソッド
@@ -120,20 +153,52 @@ type IItemRepository interface {
 
 	// 今日の復習物（復習日）系
 	// 以下の3つのメソッドで取得した今日の復習物数を組み合わせて、ホーム画面の全体の今日の復習物数を表示
+	// 復習日数を数えるメソッドはtagIDを指定するとそのタグが付いた復習物の復習日だけを数える（nilの場合は絞り込まない）
 	// カテゴリー毎の全復習物ボックス毎の今日の復習物数（復習日）を取得
-	CountDailyDatesGroupedByBoxByUserID(ctx context.Context, userID string, targetDate time.Time) ([]*DailyCountGroupedByBox, error)
+	CountDailyDatesGroupedByBoxByUserID(ctx context.Context, userID string, targetDate time.Time, tagID *string) ([]*DailyCountGroupedByBox, error)
 
 	// カテゴリー毎の未分類復習物ボックスの今日の復習物数（復習日）を取得
-	CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, userID string, targetDate time.Time) ([]*UnclassifiedDailyDatesCountGroupedByCategory, error)
+	CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, userID string, targetDate time.Time, tagID *string) ([]*UnclassifiedDailyDatesCountGroupedByCategory, error)
 
 	// ホーム画面の未分類復習物ボックスの今日の復習物数（復習日）を取得
-	CountDailyDatesUnclassifiedByUserID(ctx context.Context, userID string, targetDate time.Time) (int, error)
+	CountDailyDatesUnclassifiedByUserID(ctx context.Context, userID string, targetDate time.Time, tagID *string) (int, error)
+
+	// 指定期間（fromDateからtoDateまで）の日毎・カテゴリー毎・ボックス毎の未完了の復習日数を取得
+	CountReviewForecastByUserID(ctx context.Context, userID string, fromDate time.Time, toDate time.Time, tagID *string) ([]*ReviewForecastCount, error)
+
+	// 統計（連続学習日数・完了率・平均の遅れ）系
+	// toDateまでに復習日を完了した日を重複なく古い順に取得
+	GetCompletedDatesByUserID(ctx context.Context, userID string, toDate time.Time) ([]time.Time, error)
+	// 指定期間（fromDateからtoDateまで）の日毎の予定されていた復習日数と完了済みの復習日数を取得（日付は復習日を作成した時点の予定日）
+	CountReviewCompletionByUserID(ctx context.Context, userID string, fromDate time.Time, toDate time.Time) ([]*ReviewCompletionCount, error)
+	// 復習日を作成した時点の予定日から完了した日までの平均の日数を取得（完了した日を記録した復習日がない場合はnil）
+	GetAverageReviewDelayByUserID(ctx context.Context, userID string) (*float64, error)
+
+	// ヒートマップ系
+	// 指定期間（fromDateからtoDateまで）の日毎に完了した復習日数を取得
+	CountCompletedReviewsByDate(ctx context.Context, userID string, fromDate time.Time, toDate time.Time, filter *HeatmapFilter) ([]*DailyActivityCount, error)
+	// 指定期間（fromDateからtoDateまで）の日毎に学習した復習物数を取得
+	CountLearnedItemsByDate(ctx context.Context, userID string, fromDate time.Time, toDate time.Time, filter *HeatmapFilter) ([]*DailyActivityCount, error)
+
+	// リーチ系
+	// ずらされた回数か想起に失敗した回数が基準以上の、完了していない復習物を取得
+	GetLeechItemsByUserID(ctx context.Context, userID string, slipThreshold int, failureThreshold int) ([]*LeechItem, error)
+	// 復習日を手動で先送りした場合も、ずらされた回数に数える
+	IncrementSlipCount(ctx context.Context, itemID string, userID string) error
+
+	// 取り消し系
+	// 操作の直前の復習物と復習日の状態を保存する
+	SaveItemOperation(ctx context.Context, operation *ItemOperation) error
+	// operatedFrom以降の操作を新しい順に最大limit件取得
+	GetItemOperationsByUserID(ctx context.Context, userID string, operatedFrom time.Time, limit int) ([]*ItemOperation, error)
+	// 保存した状態に復習物と復習日を戻し、保存した状態を削除する
+	RestoreItemOperation(ctx context.Context, operationID string, userID string) error
 
 	// EditedAtの取得専用
 	GetEditedAtByItemID(ctx context.Context, itemID string, userID string) (time.Time, error)
 
 	// 今日の全復習日数を取得する
-	CountAllDailyReviewDates(ctx context.Context, userID string, parsedToday time.Time) (int, error)
+	CountAllDailyReviewDates(ctx context.Context, userID string, parsedToday time.Time, tagID *string) (int, error)
 
 	GetAllDailyReviewDates(ctx context.Context, userID string, parsedToday time.Time) ([]*DailyReviewDate, error)
 
@@ -142,7 +207,17 @@ type IItemRepository interface {
 	GetUnclassfiedFinishedItemsByCategoryID(ctx context.Context, categoryID string, userID string) ([]*Item, error)
 	GetUnclassfiedFinishedItemsByUserID(ctx context.Context, userID string) ([]*Item, error)
 
+	// 検索系
+	// 名前か詳細に全ての検索語を含む復習物を、完了済み・未完了を問わず名前に一致したものを優先して最大limit件取得（検索語は大文字小文字を区別しない部分一致）
+	// limitに関わらず一致した全件数も返す
+	SearchItems(ctx context.Context, userID string, terms []string, filter *ItemSearchFilter, limit int) ([]*Item, int, error)
+
 	/*--------------------*/
 	// patternパッケージで使うメソッド
 	IsPatternRelatedToItemByPatternID(ctx context.Context, patternID string, userID string) (bool, error)
+
+	/*--------------------*/
+	// 1日の最大復習数を超えないように復習日を割り振るためのメソッド
+	// 日付毎の未完了の復習日数を"2006-01-02"形式の日付をキーにして取得する（excludedItemIDを指定した場合はその復習物の復習日を数えない）
+	CountIncompleteReviewDatesByUserID(ctx context.Context, userID string, excludedItemID *string) (map[string]int, error)
 }
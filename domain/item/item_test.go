@@ -523,3 +523,61 @@ func TestNewReviewdate(t *testing.T) {
 		})
 	}
 }
+
+func TestLastReviewedDate(t *testing.T) {
+	learnedDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	completedDate := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                string
+		reviewdates         []*Reviewdate
+		completedStepNumber int
+		want                time.Time
+	}{
+		{
+			name: "まだ一度も復習していない場合は学習日を返す",
+			reviewdates: []*Reviewdate{
+				{StepNumber: 1, ScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+			},
+			completedStepNumber: 1,
+			want:                learnedDate,
+		},
+		{
+			name: "予定日より遅れて復習した場合は実際に復習した日を返す",
+			reviewdates: []*Reviewdate{
+				{StepNumber: 1, ScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), IsCompleted: true, CompletedDate: &completedDate},
+				{StepNumber: 2, ScheduledDate: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+			},
+			completedStepNumber: 2,
+			want:                completedDate,
+		},
+		{
+			name: "完了日が記録されていない場合は予定日を返す",
+			reviewdates: []*Reviewdate{
+				{StepNumber: 1, ScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), IsCompleted: true},
+				{StepNumber: 2, ScheduledDate: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+			},
+			completedStepNumber: 2,
+			want:                time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "完了したステップ以降の復習日は含めない",
+			reviewdates: []*Reviewdate{
+				{StepNumber: 1, ScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), IsCompleted: true},
+				{StepNumber: 2, ScheduledDate: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), IsCompleted: true, CompletedDate: &completedDate},
+			},
+			completedStepNumber: 2,
+			want:                time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := LastReviewedDate(tc.reviewdates, tc.completedStepNumber, learnedDate)
+			if !got.Equal(tc.want) {
+				t.Errorf("LastReviewedDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
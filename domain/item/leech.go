@@ -0,0 +1,17 @@
+package item
+
+// 何度もずらされたり想起に失敗したりして、なかなか定着しない復習物（リーチ）の判定基準
+const (
+	LeechSlipThreshold    = 5 // 期限切れのバッチで復習日をずらされた回数
+	LeechFailureThreshold = 4 // 想起に失敗した回数
+)
+
+// リーチとして判定された復習物
+type LeechItem struct {
+	ItemID       string
+	CategoryID   *string
+	BoxID        *string
+	Name         string
+	SlipCount    int
+	FailureCount int
+}
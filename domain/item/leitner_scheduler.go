@@ -0,0 +1,45 @@
+package item
+
+import (
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+// 想起に失敗したら最初のステップ（1つ目の箱）に戻すドメインサービス（ライトナー方式）
+// 復習日の計算と完了時の扱いは固定ステップと同じで、想起失敗時の再計算だけが異なる
+type leitnerScheduler struct {
+	scheduler
+}
+
+func NewLeitnerScheduler() IScheduler {
+	return &leitnerScheduler{}
+}
+
+// 想起失敗した日を学習日とみなして、全ての復習日を最初のステップから未完了で作り直す
+func (s *leitnerScheduler) RescheduleAfterFailure(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	if len(targetPatternSteps) != len(reviewdates) || len(reviewdates) == 0 {
+		return nil, ErrMismatchedIDsAndSteps
+	}
+
+	reviewDateIDs := make([]string, len(reviewdates))
+	for i, rd := range reviewdates {
+		reviewDateIDs[i] = rd.ReviewdateID
+	}
+	first := reviewdates[0]
+
+	return s.FormatWithOverdueMarkedInCompletedWithIDs(
+		targetPatternSteps,
+		reviewDateIDs,
+		first.UserID,
+		first.CategoryID,
+		first.BoxID,
+		first.ItemID,
+		parsedToday,
+		parsedToday,
+	)
+}
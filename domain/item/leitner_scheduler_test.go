@@ -0,0 +1,104 @@
+package item
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+func TestLeitnerScheduler_RescheduleAfterFailure(t *testing.T) {
+	scheduler := NewLeitnerScheduler()
+
+	categoryID := "category123"
+	boxID := "box123"
+	// 学習日(2024-01-01)から1日後、3日後、7日後の3ステップ
+	targetPatternSteps := []*PatternDomain.PatternStep{
+		{StepNumber: 1, IntervalDays: 1},
+		{StepNumber: 2, IntervalDays: 3},
+		{StepNumber: 3, IntervalDays: 7},
+	}
+	// ステップ1は完了済みで、ステップ2の復習日(2024-01-04)に想起失敗した状態
+	newReviewdates := func() []*Reviewdate {
+		return []*Reviewdate{
+			{ReviewdateID: "rd1", UserID: "user123", CategoryID: &categoryID, BoxID: &boxID, ItemID: "item123", StepNumber: 1, InitialScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), IsCompleted: true},
+			{ReviewdateID: "rd2", UserID: "user123", CategoryID: &categoryID, BoxID: &boxID, ItemID: "item123", StepNumber: 2, InitialScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+			{ReviewdateID: "rd3", UserID: "user123", CategoryID: &categoryID, BoxID: &boxID, ItemID: "item123", StepNumber: 3, InitialScheduledDate: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+		}
+	}
+	parsedToday := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		targetPatternSteps []*PatternDomain.PatternStep
+		reviewdates        []*Reviewdate
+		wantDates          map[string]time.Time
+		wantErr            error
+	}{
+		{
+			name:               "想起失敗した日から最初のステップに戻して全ての復習日を作り直す",
+			targetPatternSteps: targetPatternSteps,
+			reviewdates:        newReviewdates(),
+			wantDates: map[string]time.Time{
+				"rd1": time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+				"rd2": time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+				"rd3": time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:               "ステップ数と復習日数が一致しない場合はエラー",
+			targetPatternSteps: targetPatternSteps[:2],
+			reviewdates:        newReviewdates(),
+			wantErr:            ErrMismatchedIDsAndSteps,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scheduler.RescheduleAfterFailure(tt.targetPatternSteps, tt.reviewdates, parsedToday)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("RescheduleAfterFailure() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RescheduleAfterFailure() unexpected error = %v", err)
+			}
+			if len(got) != len(tt.wantDates) {
+				t.Fatalf("RescheduleAfterFailure() len = %d, want %d", len(got), len(tt.wantDates))
+			}
+			for _, rd := range got {
+				want, ok := tt.wantDates[rd.ReviewdateID]
+				if !ok {
+					t.Errorf("予期しない復習日が作り直されました: %s", rd.ReviewdateID)
+					continue
+				}
+				if !rd.ScheduledDate.Equal(want) || !rd.InitialScheduledDate.Equal(want) {
+					t.Errorf("%s の ScheduledDate = %v, InitialScheduledDate = %v, want %v", rd.ReviewdateID, rd.ScheduledDate, rd.InitialScheduledDate, want)
+				}
+				if rd.IsCompleted {
+					t.Errorf("%s が完了済みになっています", rd.ReviewdateID)
+				}
+				if rd.CategoryID != &categoryID || rd.BoxID != &boxID {
+					t.Errorf("%s のカテゴリー・ボックスが引き継がれていません", rd.ReviewdateID)
+				}
+			}
+		})
+	}
+}
+
+func TestScheduler_RescheduleAfterFailure(t *testing.T) {
+	scheduler := NewScheduler()
+	targetPatternSteps := []*PatternDomain.PatternStep{{StepNumber: 1, IntervalDays: 1}}
+	reviewdates := []*Reviewdate{{ReviewdateID: "rd1", StepNumber: 1}}
+
+	got, err := scheduler.RescheduleAfterFailure(targetPatternSteps, reviewdates, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("RescheduleAfterFailure() unexpected error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("固定ステップでは復習日を作り直さないはずが %d 件返されました", len(got))
+	}
+}
@@ -0,0 +1,240 @@
+package item
+
+import (
+	"math"
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+// 復習日をずらしてよい幅（前の復習日からの間隔に対する割合）
+const loadBalanceTolerance = 0.1
+
+// ユーザーの日毎の復習数と1日の上限
+type ReviewLoad struct {
+	MaxPerDay int            // 0の場合は上限なし
+	Counts    map[string]int // キーは"2006-01-02"形式の日付
+}
+
+func NewReviewLoad(maxPerDay int, counts map[string]int) *ReviewLoad {
+	if counts == nil {
+		counts = make(map[string]int)
+	}
+	return &ReviewLoad{
+		MaxPerDay: maxPerDay,
+		Counts:    counts,
+	}
+}
+
+func (l *ReviewLoad) CountOn(date time.Time) int {
+	return l.Counts[date.Format("2006-01-02")]
+}
+
+func (l *ReviewLoad) IsFull(date time.Time) bool {
+	return l.MaxPerDay > 0 && l.CountOn(date) >= l.MaxPerDay
+}
+
+func (l *ReviewLoad) Add(date time.Time) {
+	l.Counts[date.Format("2006-01-02")]++
+}
+
+func (l *ReviewLoad) Remove(date time.Time) {
+	key := date.Format("2006-01-02")
+	if l.Counts[key] > 0 {
+		l.Counts[key]--
+	}
+}
+
+// 他のスケジューラーが計算した未完了の復習日のうち、上限に達している日の復習日を
+// 間隔の±10%以内で最も復習数が少ない日へずらすドメインサービス
+type loadBalancedScheduler struct {
+	base     IScheduler
+	calendar IReviewCalendar
+	load     *ReviewLoad
+}
+
+// calendar は、休息日にずらさないために使う
+func NewLoadBalancedScheduler(base IScheduler, calendar IReviewCalendar, load *ReviewLoad) IScheduler {
+	return &loadBalancedScheduler{
+		base:     base,
+		calendar: calendar,
+		load:     load,
+	}
+}
+
+func (s *loadBalancedScheduler) FormatWithOverdueMarkedCompleted(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, bool, error) {
+	result, isFinished, err := s.base.FormatWithOverdueMarkedCompleted(targetPatternSteps, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, false, err
+	}
+	return s.balance(result, parsedLearnedDate), isFinished, nil
+}
+
+func (s *loadBalancedScheduler) FormatWithOverdueMarkedInCompleted(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.FormatWithOverdueMarkedInCompleted(targetPatternSteps, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	return s.balance(result, parsedLearnedDate), nil
+}
+
+func (s *loadBalancedScheduler) FormatWithOverdueMarkedCompletedWithIDs(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewDateIDs []string,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, bool, error) {
+	result, isFinished, err := s.base.FormatWithOverdueMarkedCompletedWithIDs(targetPatternSteps, reviewDateIDs, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, false, err
+	}
+	return s.balance(result, parsedLearnedDate), isFinished, nil
+}
+
+func (s *loadBalancedScheduler) FormatWithOverdueMarkedInCompletedWithIDs(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewDateIDs []string,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.FormatWithOverdueMarkedInCompletedWithIDs(targetPatternSteps, reviewDateIDs, userID, categoryID, boxID, itemID, parsedLearnedDate, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	return s.balance(result, parsedLearnedDate), nil
+}
+
+func (s *loadBalancedScheduler) FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewDateIDs []string,
+	userID string,
+	categoryID *string,
+	boxID *string,
+	itemID string,
+	parsedLearnedDate time.Time,
+	diff time.Duration,
+) ([]*Reviewdate, error) {
+	result, err := s.base.FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates(targetPatternSteps, reviewDateIDs, userID, categoryID, boxID, itemID, parsedLearnedDate, diff)
+	if err != nil {
+		return nil, err
+	}
+	return s.balance(result, parsedLearnedDate), nil
+}
+
+func (s *loadBalancedScheduler) RescheduleAfterCompletion(
+	targetPattern *PatternDomain.Pattern,
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	completedStepNumber int,
+	state MemoryState,
+	grade int,
+	parsedLastReviewedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, MemoryState, error) {
+	result, nextState, err := s.base.RescheduleAfterCompletion(targetPattern, targetPatternSteps, reviewdates, completedStepNumber, state, grade, parsedLastReviewedDate, parsedToday)
+	if err != nil {
+		return nil, state, err
+	}
+	return s.balance(result, parsedToday), nextState, nil
+}
+
+func (s *loadBalancedScheduler) RescheduleFromCompletedDate(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	completedStepNumber int,
+	parsedCompletedDate time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.RescheduleFromCompletedDate(targetPatternSteps, reviewdates, completedStepNumber, parsedCompletedDate)
+	if err != nil {
+		return nil, err
+	}
+	return s.balance(result, parsedCompletedDate), nil
+}
+
+func (s *loadBalancedScheduler) RescheduleAfterFailure(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	result, err := s.base.RescheduleAfterFailure(targetPatternSteps, reviewdates, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	return s.balance(result, parsedToday), nil
+}
+
+// 復習日はステップ順に並んでいる前提で、前の復習日（最初は baseDate）より後の日にだけずらす
+func (s *loadBalancedScheduler) balance(reviewdates []*Reviewdate, baseDate time.Time) []*Reviewdate {
+	prev := baseDate
+	for _, rd := range reviewdates {
+		if rd.IsCompleted {
+			if rd.ScheduledDate.After(prev) {
+				prev = rd.ScheduledDate
+			}
+			continue
+		}
+
+		chosen := s.load.leastLoadedDate(rd.ScheduledDate, prev, s.calendar)
+		if !chosen.Equal(rd.ScheduledDate) {
+			if rd.InitialScheduledDate.Equal(rd.ScheduledDate) {
+				rd.InitialScheduledDate = chosen
+			}
+			rd.ScheduledDate = chosen
+		}
+		s.load.Add(chosen)
+		prev = chosen
+	}
+	return reviewdates
+}
+
+// 上限に達していなければそのまま。達していれば許容幅の中で最も復習数が少ない日を返す（同数なら元の日に近い日）
+func (l *ReviewLoad) leastLoadedDate(date time.Time, prev time.Time, calendar IReviewCalendar) time.Time {
+	if !l.IsFull(date) {
+		return date
+	}
+
+	interval := int(date.Sub(prev).Hours() / 24)
+	tolerance := int(math.Round(float64(interval) * loadBalanceTolerance))
+
+	best := date
+	bestCount := l.CountOn(date)
+	for offset := 1; offset <= tolerance; offset++ {
+		for _, candidate := range []time.Time{date.AddDate(0, 0, -offset), date.AddDate(0, 0, offset)} {
+			if !candidate.After(prev) {
+				continue
+			}
+			if !calendar.NextAvailableDate(candidate).Equal(candidate) {
+				continue
+			}
+			if count := l.CountOn(candidate); count < bestCount {
+				best = candidate
+				bestCount = count
+			}
+		}
+	}
+	return best
+}
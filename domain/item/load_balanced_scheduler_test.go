@@ -0,0 +1,132 @@
+package item
+
+import (
+	"testing"
+	"time"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+// 休息日のないテスト用カレンダー
+type openCalendar struct{}
+
+func (openCalendar) NextAvailableDate(date time.Time) time.Time {
+	return date
+}
+
+// 指定した日付を休息日とするテスト用カレンダー
+type restDateCalendar map[string]bool
+
+func (c restDateCalendar) NextAvailableDate(date time.Time) time.Time {
+	for c[date.Format("2006-01-02")] {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+func TestLoadBalancedScheduler_FormatWithOverdueMarkedInCompleted(t *testing.T) {
+	categoryID := "category123"
+	boxID := "box123"
+	// 学習日(2024-01-01 月曜日)から10日後、30日後の2ステップ（許容幅はそれぞれ±1日、±2日）
+	targetPatternSteps := []*PatternDomain.PatternStep{
+		{StepNumber: 1, IntervalDays: 10},
+		{StepNumber: 2, IntervalDays: 30},
+	}
+	parsedLearnedDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		calendar IReviewCalendar
+		load     *ReviewLoad
+		want     []time.Time
+	}{
+		{
+			name:     "上限なしの場合はずらさない",
+			calendar: openCalendar{},
+			load:     NewReviewLoad(0, map[string]int{"2024-01-11": 100}),
+			want: []time.Time{
+				time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "上限に達していない場合はずらさない",
+			calendar: openCalendar{},
+			load:     NewReviewLoad(5, map[string]int{"2024-01-11": 4}),
+			want: []time.Time{
+				time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "上限に達している日は許容幅の中で最も復習数が少ない日へずらす",
+			calendar: openCalendar{},
+			load: NewReviewLoad(5, map[string]int{
+				"2024-01-10": 4,
+				"2024-01-11": 5,
+				"2024-01-12": 2,
+				"2024-01-28": 0, // 許容幅(1/12からの19日の±10%で±2日)の外
+				"2024-01-29": 3,
+				"2024-01-30": 4,
+				"2024-01-31": 5,
+				"2024-02-01": 3,
+				"2024-02-02": 1,
+			}),
+			want: []time.Time{
+				time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "休息日にはずらさない",
+			calendar: restDateCalendar{"2024-02-02": true},
+			load: NewReviewLoad(5, map[string]int{
+				"2024-01-11": 4,
+				"2024-01-29": 3,
+				"2024-01-30": 5,
+				"2024-01-31": 5,
+				"2024-02-01": 2,
+				"2024-02-02": 0,
+			}),
+			want: []time.Time{
+				time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "許容幅の中がすべて上限の場合は元の日のまま",
+			calendar: openCalendar{},
+			load: NewReviewLoad(1, map[string]int{
+				"2024-01-10": 1,
+				"2024-01-11": 1,
+				"2024-01-12": 1,
+			}),
+			want: []time.Time{
+				time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduler := NewLoadBalancedScheduler(NewScheduler(), tt.calendar, tt.load)
+
+			got, err := scheduler.FormatWithOverdueMarkedInCompleted(targetPatternSteps, "user123", &categoryID, &boxID, "item123", parsedLearnedDate, parsedLearnedDate)
+			if err != nil {
+				t.Fatalf("FormatWithOverdueMarkedInCompleted() unexpected error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("FormatWithOverdueMarkedInCompleted() len = %d, want %d", len(got), len(tt.want))
+			}
+			for i, rd := range got {
+				if !rd.ScheduledDate.Equal(tt.want[i]) || !rd.InitialScheduledDate.Equal(tt.want[i]) {
+					t.Errorf("ステップ%d の ScheduledDate = %v, InitialScheduledDate = %v, want %v", rd.StepNumber, rd.ScheduledDate, rd.InitialScheduledDate, tt.want[i])
+				}
+				if tt.load.CountOn(tt.want[i]) == 0 {
+					t.Errorf("ステップ%d の復習日が復習数に加算されていません", rd.StepNumber)
+				}
+			}
+		})
+	}
+}
@@ -117,3 +117,49 @@ func (mr *MockISchedulerMockRecorder) FormatWithOverdueMarkedInCompletedWithIDsF
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates", reflect.TypeOf((*MockIScheduler)(nil).FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates), targetPatternSteps, reviewDateIDs, userID, categoryID, boxID, itemID, parsedLearnedDate, diff)
 }
+
+// RescheduleAfterCompletion mocks base method.
+func (m *MockIScheduler) RescheduleAfterCompletion(targetPattern *pattern.Pattern, targetPatternSteps []*pattern.PatternStep, reviewdates []*Reviewdate, completedStepNumber int, state MemoryState, grade int, parsedLastReviewedDate, parsedToday time.Time) ([]*Reviewdate, MemoryState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleAfterCompletion", targetPattern, targetPatternSteps, reviewdates, completedStepNumber, state, grade, parsedLastReviewedDate, parsedToday)
+	ret0, _ := ret[0].([]*Reviewdate)
+	ret1, _ := ret[1].(MemoryState)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RescheduleAfterCompletion indicates an expected call of RescheduleAfterCompletion.
+func (mr *MockISchedulerMockRecorder) RescheduleAfterCompletion(targetPattern, targetPatternSteps, reviewdates, completedStepNumber, state, grade, parsedLastReviewedDate, parsedToday any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleAfterCompletion", reflect.TypeOf((*MockIScheduler)(nil).RescheduleAfterCompletion), targetPattern, targetPatternSteps, reviewdates, completedStepNumber, state, grade, parsedLastReviewedDate, parsedToday)
+}
+
+// RescheduleAfterFailure mocks base method.
+func (m *MockIScheduler) RescheduleAfterFailure(targetPatternSteps []*pattern.PatternStep, reviewdates []*Reviewdate, parsedToday time.Time) ([]*Reviewdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleAfterFailure", targetPatternSteps, reviewdates, parsedToday)
+	ret0, _ := ret[0].([]*Reviewdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RescheduleAfterFailure indicates an expected call of RescheduleAfterFailure.
+func (mr *MockISchedulerMockRecorder) RescheduleAfterFailure(targetPatternSteps, reviewdates, parsedToday any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleAfterFailure", reflect.TypeOf((*MockIScheduler)(nil).RescheduleAfterFailure), targetPatternSteps, reviewdates, parsedToday)
+}
+
+// RescheduleFromCompletedDate mocks base method.
+func (m *MockIScheduler) RescheduleFromCompletedDate(targetPatternSteps []*pattern.PatternStep, reviewdates []*Reviewdate, completedStepNumber int, parsedCompletedDate time.Time) ([]*Reviewdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleFromCompletedDate", targetPatternSteps, reviewdates, completedStepNumber, parsedCompletedDate)
+	ret0, _ := ret[0].([]*Reviewdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RescheduleFromCompletedDate indicates an expected call of RescheduleFromCompletedDate.
+func (mr *MockISchedulerMockRecorder) RescheduleFromCompletedDate(targetPatternSteps, reviewdates, completedStepNumber, parsedCompletedDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleFromCompletedDate", reflect.TypeOf((*MockIScheduler)(nil).RescheduleFromCompletedDate), targetPatternSteps, reviewdates, completedStepNumber, parsedCompletedDate)
+}
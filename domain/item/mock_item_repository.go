@@ -42,63 +42,93 @@ func (m *MockIItemRepository) EXPECT() *MockIItemRepositoryMockRecorder {
 }
 
 // CountAllDailyReviewDates mocks base method.
-func (m *MockIItemRepository) CountAllDailyReviewDates(ctx context.Context, userID string, parsedToday time.Time) (int, error) {
+func (m *MockIItemRepository) CountAllDailyReviewDates(ctx context.Context, userID string, parsedToday time.Time, tagID *string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountAllDailyReviewDates", ctx, userID, parsedToday)
+	ret := m.ctrl.Call(m, "CountAllDailyReviewDates", ctx, userID, parsedToday, tagID)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountAllDailyReviewDates indicates an expected call of CountAllDailyReviewDates.
-func (mr *MockIItemRepositoryMockRecorder) CountAllDailyReviewDates(ctx, userID, parsedToday any) *gomock.Call {
+func (mr *MockIItemRepositoryMockRecorder) CountAllDailyReviewDates(ctx, userID, parsedToday, tagID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAllDailyReviewDates", reflect.TypeOf((*MockIItemRepository)(nil).CountAllDailyReviewDates), ctx, userID, parsedToday)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAllDailyReviewDates", reflect.TypeOf((*MockIItemRepository)(nil).CountAllDailyReviewDates), ctx, userID, parsedToday, tagID)
+}
+
+// CountCompletedReviewsByDate mocks base method.
+func (m *MockIItemRepository) CountCompletedReviewsByDate(ctx context.Context, userID string, fromDate, toDate time.Time, filter *HeatmapFilter) ([]*DailyActivityCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCompletedReviewsByDate", ctx, userID, fromDate, toDate, filter)
+	ret0, _ := ret[0].([]*DailyActivityCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCompletedReviewsByDate indicates an expected call of CountCompletedReviewsByDate.
+func (mr *MockIItemRepositoryMockRecorder) CountCompletedReviewsByDate(ctx, userID, fromDate, toDate, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCompletedReviewsByDate", reflect.TypeOf((*MockIItemRepository)(nil).CountCompletedReviewsByDate), ctx, userID, fromDate, toDate, filter)
 }
 
 // CountDailyDatesGroupedByBoxByUserID mocks base method.
-func (m *MockIItemRepository) CountDailyDatesGroupedByBoxByUserID(ctx context.Context, userID string, targetDate time.Time) ([]*DailyCountGroupedByBox, error) {
+func (m *MockIItemRepository) CountDailyDatesGroupedByBoxByUserID(ctx context.Context, userID string, targetDate time.Time, tagID *string) ([]*DailyCountGroupedByBox, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountDailyDatesGroupedByBoxByUserID", ctx, userID, targetDate)
+	ret := m.ctrl.Call(m, "CountDailyDatesGroupedByBoxByUserID", ctx, userID, targetDate, tagID)
 	ret0, _ := ret[0].([]*DailyCountGroupedByBox)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountDailyDatesGroupedByBoxByUserID indicates an expected call of CountDailyDatesGroupedByBoxByUserID.
-func (mr *MockIItemRepositoryMockRecorder) CountDailyDatesGroupedByBoxByUserID(ctx, userID, targetDate any) *gomock.Call {
+func (mr *MockIItemRepositoryMockRecorder) CountDailyDatesGroupedByBoxByUserID(ctx, userID, targetDate, tagID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDailyDatesGroupedByBoxByUserID", reflect.TypeOf((*MockIItemRepository)(nil).CountDailyDatesGroupedByBoxByUserID), ctx, userID, targetDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDailyDatesGroupedByBoxByUserID", reflect.TypeOf((*MockIItemRepository)(nil).CountDailyDatesGroupedByBoxByUserID), ctx, userID, targetDate, tagID)
 }
 
 // CountDailyDatesUnclassifiedByUserID mocks base method.
-func (m *MockIItemRepository) CountDailyDatesUnclassifiedByUserID(ctx context.Context, userID string, targetDate time.Time) (int, error) {
+func (m *MockIItemRepository) CountDailyDatesUnclassifiedByUserID(ctx context.Context, userID string, targetDate time.Time, tagID *string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountDailyDatesUnclassifiedByUserID", ctx, userID, targetDate)
+	ret := m.ctrl.Call(m, "CountDailyDatesUnclassifiedByUserID", ctx, userID, targetDate, tagID)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountDailyDatesUnclassifiedByUserID indicates an expected call of CountDailyDatesUnclassifiedByUserID.
-func (mr *MockIItemRepositoryMockRecorder) CountDailyDatesUnclassifiedByUserID(ctx, userID, targetDate any) *gomock.Call {
+func (mr *MockIItemRepositoryMockRecorder) CountDailyDatesUnclassifiedByUserID(ctx, userID, targetDate, tagID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDailyDatesUnclassifiedByUserID", reflect.TypeOf((*MockIItemRepository)(nil).CountDailyDatesUnclassifiedByUserID), ctx, userID, targetDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDailyDatesUnclassifiedByUserID", reflect.TypeOf((*MockIItemRepository)(nil).CountDailyDatesUnclassifiedByUserID), ctx, userID, targetDate, tagID)
 }
 
 // CountDailyDatesUnclassifiedGroupedByCategoryByUserID mocks base method.
-func (m *MockIItemRepository) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, userID string, targetDate time.Time) ([]*UnclassifiedDailyDatesCountGroupedByCategory, error) {
+func (m *MockIItemRepository) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, userID string, targetDate time.Time, tagID *string) ([]*UnclassifiedDailyDatesCountGroupedByCategory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountDailyDatesUnclassifiedGroupedByCategoryByUserID", ctx, userID, targetDate)
+	ret := m.ctrl.Call(m, "CountDailyDatesUnclassifiedGroupedByCategoryByUserID", ctx, userID, targetDate, tagID)
 	ret0, _ := ret[0].([]*UnclassifiedDailyDatesCountGroupedByCategory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountDailyDatesUnclassifiedGroupedByCategoryByUserID indicates an expected call of CountDailyDatesUnclassifiedGroupedByCategoryByUserID.
-func (mr *MockIItemRepositoryMockRecorder) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx, userID, targetDate any) *gomock.Call {
+func (mr *MockIItemRepositoryMockRecorder) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx, userID, targetDate, tagID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDailyDatesUnclassifiedGroupedByCategoryByUserID", reflect.TypeOf((*MockIItemRepository)(nil).CountDailyDatesUnclassifiedGroupedByCategoryByUserID), ctx, userID, targetDate, tagID)
+}
+
+// CountIncompleteReviewDatesByUserID mocks base method.
+func (m *MockIItemRepository) CountIncompleteReviewDatesByUserID(ctx context.Context, userID string, excludedItemID *string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountIncompleteReviewDatesByUserID", ctx, userID, excludedItemID)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountIncompleteReviewDatesByUserID indicates an expected call of CountIncompleteReviewDatesByUserID.
+func (mr *MockIItemRepositoryMockRecorder) CountIncompleteReviewDatesByUserID(ctx, userID, excludedItemID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDailyDatesUnclassifiedGroupedByCategoryByUserID", reflect.TypeOf((*MockIItemRepository)(nil).CountDailyDatesUnclassifiedGroupedByCategoryByUserID), ctx, userID, targetDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountIncompleteReviewDatesByUserID", reflect.TypeOf((*MockIItemRepository)(nil).CountIncompleteReviewDatesByUserID), ctx, userID, excludedItemID)
 }
 
 // CountItemsGroupedByBoxByUserID mocks base method.
@@ -116,6 +146,51 @@ func (mr *MockIItemRepositoryMockRecorder) CountItemsGroupedByBoxByUserID(ctx, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountItemsGroupedByBoxByUserID", reflect.TypeOf((*MockIItemRepository)(nil).CountItemsGroupedByBoxByUserID), ctx, userID)
 }
 
+// CountLearnedItemsByDate mocks base method.
+func (m *MockIItemRepository) CountLearnedItemsByDate(ctx context.Context, userID string, fromDate, toDate time.Time, filter *HeatmapFilter) ([]*DailyActivityCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountLearnedItemsByDate", ctx, userID, fromDate, toDate, filter)
+	ret0, _ := ret[0].([]*DailyActivityCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountLearnedItemsByDate indicates an expected call of CountLearnedItemsByDate.
+func (mr *MockIItemRepositoryMockRecorder) CountLearnedItemsByDate(ctx, userID, fromDate, toDate, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLearnedItemsByDate", reflect.TypeOf((*MockIItemRepository)(nil).CountLearnedItemsByDate), ctx, userID, fromDate, toDate, filter)
+}
+
+// CountReviewCompletionByUserID mocks base method.
+func (m *MockIItemRepository) CountReviewCompletionByUserID(ctx context.Context, userID string, fromDate, toDate time.Time) ([]*ReviewCompletionCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReviewCompletionByUserID", ctx, userID, fromDate, toDate)
+	ret0, _ := ret[0].([]*ReviewCompletionCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReviewCompletionByUserID indicates an expected call of CountReviewCompletionByUserID.
+func (mr *MockIItemRepositoryMockRecorder) CountReviewCompletionByUserID(ctx, userID, fromDate, toDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReviewCompletionByUserID", reflect.TypeOf((*MockIItemRepository)(nil).CountReviewCompletionByUserID), ctx, userID, fromDate, toDate)
+}
+
+// CountReviewForecastByUserID mocks base method.
+func (m *MockIItemRepository) CountReviewForecastByUserID(ctx context.Context, userID string, fromDate, toDate time.Time, tagID *string) ([]*ReviewForecastCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReviewForecastByUserID", ctx, userID, fromDate, toDate, tagID)
+	ret0, _ := ret[0].([]*ReviewForecastCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReviewForecastByUserID indicates an expected call of CountReviewForecastByUserID.
+func (mr *MockIItemRepositoryMockRecorder) CountReviewForecastByUserID(ctx, userID, fromDate, toDate, tagID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReviewForecastByUserID", reflect.TypeOf((*MockIItemRepository)(nil).CountReviewForecastByUserID), ctx, userID, fromDate, toDate, tagID)
+}
+
 // CountUnclassifiedItemsByUserID mocks base method.
 func (m *MockIItemRepository) CountUnclassifiedItemsByUserID(ctx context.Context, userID string) (int, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +235,34 @@ func (mr *MockIItemRepositoryMockRecorder) CreateItem(ctx, item any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockIItemRepository)(nil).CreateItem), ctx, item)
 }
 
+// CreateReviewFailure mocks base method.
+func (m *MockIItemRepository) CreateReviewFailure(ctx context.Context, failure *ReviewFailure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReviewFailure", ctx, failure)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReviewFailure indicates an expected call of CreateReviewFailure.
+func (mr *MockIItemRepositoryMockRecorder) CreateReviewFailure(ctx, failure any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReviewFailure", reflect.TypeOf((*MockIItemRepository)(nil).CreateReviewFailure), ctx, failure)
+}
+
+// CreateReviewLog mocks base method.
+func (m *MockIItemRepository) CreateReviewLog(ctx context.Context, log *ReviewLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReviewLog", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReviewLog indicates an expected call of CreateReviewLog.
+func (mr *MockIItemRepositoryMockRecorder) CreateReviewLog(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReviewLog", reflect.TypeOf((*MockIItemRepository)(nil).CreateReviewLog), ctx, log)
+}
+
 // CreateReviewdates mocks base method.
 func (m *MockIItemRepository) CreateReviewdates(ctx context.Context, reviewdates []*Reviewdate) (int64, error) {
 	m.ctrl.T.Helper()
@@ -203,6 +306,20 @@ func (mr *MockIItemRepositoryMockRecorder) DeleteReviewDates(ctx, itemID, userID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReviewDates", reflect.TypeOf((*MockIItemRepository)(nil).DeleteReviewDates), ctx, itemID, userID)
 }
 
+// DeleteReviewDatesByIDs mocks base method.
+func (m *MockIItemRepository) DeleteReviewDatesByIDs(ctx context.Context, reviewdateIDs []string, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReviewDatesByIDs", ctx, reviewdateIDs, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReviewDatesByIDs indicates an expected call of DeleteReviewDatesByIDs.
+func (mr *MockIItemRepositoryMockRecorder) DeleteReviewDatesByIDs(ctx, reviewdateIDs, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReviewDatesByIDs", reflect.TypeOf((*MockIItemRepository)(nil).DeleteReviewDatesByIDs), ctx, reviewdateIDs, userID)
+}
+
 // GetAllDailyReviewDates mocks base method.
 func (m *MockIItemRepository) GetAllDailyReviewDates(ctx context.Context, userID string, parsedToday time.Time) ([]*DailyReviewDate, error) {
 	m.ctrl.T.Helper()
@@ -308,6 +425,36 @@ func (mr *MockIItemRepositoryMockRecorder) GetAllUnclassifiedReviewDatesByUserID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUnclassifiedReviewDatesByUserID", reflect.TypeOf((*MockIItemRepository)(nil).GetAllUnclassifiedReviewDatesByUserID), ctx, userID)
 }
 
+// GetAverageReviewDelayByUserID mocks base method.
+func (m *MockIItemRepository) GetAverageReviewDelayByUserID(ctx context.Context, userID string) (*float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAverageReviewDelayByUserID", ctx, userID)
+	ret0, _ := ret[0].(*float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAverageReviewDelayByUserID indicates an expected call of GetAverageReviewDelayByUserID.
+func (mr *MockIItemRepositoryMockRecorder) GetAverageReviewDelayByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAverageReviewDelayByUserID", reflect.TypeOf((*MockIItemRepository)(nil).GetAverageReviewDelayByUserID), ctx, userID)
+}
+
+// GetCompletedDatesByUserID mocks base method.
+func (m *MockIItemRepository) GetCompletedDatesByUserID(ctx context.Context, userID string, toDate time.Time) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletedDatesByUserID", ctx, userID, toDate)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletedDatesByUserID indicates an expected call of GetCompletedDatesByUserID.
+func (mr *MockIItemRepositoryMockRecorder) GetCompletedDatesByUserID(ctx, userID, toDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletedDatesByUserID", reflect.TypeOf((*MockIItemRepository)(nil).GetCompletedDatesByUserID), ctx, userID, toDate)
+}
+
 // GetEditedAtByItemID mocks base method.
 func (m *MockIItemRepository) GetEditedAtByItemID(ctx context.Context, itemID, userID string) (time.Time, error) {
 	m.ctrl.T.Helper()
@@ -353,6 +500,66 @@ func (mr *MockIItemRepositoryMockRecorder) GetItemByID(ctx, itemID, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemByID", reflect.TypeOf((*MockIItemRepository)(nil).GetItemByID), ctx, itemID, userID)
 }
 
+// GetItemIDsByTagID mocks base method.
+func (m *MockIItemRepository) GetItemIDsByTagID(ctx context.Context, tagID, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemIDsByTagID", ctx, tagID, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemIDsByTagID indicates an expected call of GetItemIDsByTagID.
+func (mr *MockIItemRepositoryMockRecorder) GetItemIDsByTagID(ctx, tagID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemIDsByTagID", reflect.TypeOf((*MockIItemRepository)(nil).GetItemIDsByTagID), ctx, tagID, userID)
+}
+
+// GetItemOperationsByUserID mocks base method.
+func (m *MockIItemRepository) GetItemOperationsByUserID(ctx context.Context, userID string, operatedFrom time.Time, limit int) ([]*ItemOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemOperationsByUserID", ctx, userID, operatedFrom, limit)
+	ret0, _ := ret[0].([]*ItemOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemOperationsByUserID indicates an expected call of GetItemOperationsByUserID.
+func (mr *MockIItemRepositoryMockRecorder) GetItemOperationsByUserID(ctx, userID, operatedFrom, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemOperationsByUserID", reflect.TypeOf((*MockIItemRepository)(nil).GetItemOperationsByUserID), ctx, userID, operatedFrom, limit)
+}
+
+// GetLeechItemsByUserID mocks base method.
+func (m *MockIItemRepository) GetLeechItemsByUserID(ctx context.Context, userID string, slipThreshold, failureThreshold int) ([]*LeechItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeechItemsByUserID", ctx, userID, slipThreshold, failureThreshold)
+	ret0, _ := ret[0].([]*LeechItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeechItemsByUserID indicates an expected call of GetLeechItemsByUserID.
+func (mr *MockIItemRepositoryMockRecorder) GetLeechItemsByUserID(ctx, userID, slipThreshold, failureThreshold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeechItemsByUserID", reflect.TypeOf((*MockIItemRepository)(nil).GetLeechItemsByUserID), ctx, userID, slipThreshold, failureThreshold)
+}
+
+// GetMemoryStateByItemID mocks base method.
+func (m *MockIItemRepository) GetMemoryStateByItemID(ctx context.Context, itemID, userID string) (*MemoryState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemoryStateByItemID", ctx, itemID, userID)
+	ret0, _ := ret[0].(*MemoryState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemoryStateByItemID indicates an expected call of GetMemoryStateByItemID.
+func (mr *MockIItemRepositoryMockRecorder) GetMemoryStateByItemID(ctx, itemID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemoryStateByItemID", reflect.TypeOf((*MockIItemRepository)(nil).GetMemoryStateByItemID), ctx, itemID, userID)
+}
+
 // GetReviewDateIDsByItemID mocks base method.
 func (m *MockIItemRepository) GetReviewDateIDsByItemID(ctx context.Context, itemID, userID string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -383,6 +590,51 @@ func (mr *MockIItemRepositoryMockRecorder) GetReviewDatesByItemID(ctx, itemID, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewDatesByItemID", reflect.TypeOf((*MockIItemRepository)(nil).GetReviewDatesByItemID), ctx, itemID, userID)
 }
 
+// GetReviewDatesOfUnFinishedItemsByPatternID mocks base method.
+func (m *MockIItemRepository) GetReviewDatesOfUnFinishedItemsByPatternID(ctx context.Context, patternID, userID string) ([]*Reviewdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewDatesOfUnFinishedItemsByPatternID", ctx, patternID, userID)
+	ret0, _ := ret[0].([]*Reviewdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewDatesOfUnFinishedItemsByPatternID indicates an expected call of GetReviewDatesOfUnFinishedItemsByPatternID.
+func (mr *MockIItemRepositoryMockRecorder) GetReviewDatesOfUnFinishedItemsByPatternID(ctx, patternID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewDatesOfUnFinishedItemsByPatternID", reflect.TypeOf((*MockIItemRepository)(nil).GetReviewDatesOfUnFinishedItemsByPatternID), ctx, patternID, userID)
+}
+
+// GetReviewLogsByItemID mocks base method.
+func (m *MockIItemRepository) GetReviewLogsByItemID(ctx context.Context, itemID, userID string) ([]*ReviewLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewLogsByItemID", ctx, itemID, userID)
+	ret0, _ := ret[0].([]*ReviewLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewLogsByItemID indicates an expected call of GetReviewLogsByItemID.
+func (mr *MockIItemRepositoryMockRecorder) GetReviewLogsByItemID(ctx, itemID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewLogsByItemID", reflect.TypeOf((*MockIItemRepository)(nil).GetReviewLogsByItemID), ctx, itemID, userID)
+}
+
+// GetUnFinishedItemsByPatternID mocks base method.
+func (m *MockIItemRepository) GetUnFinishedItemsByPatternID(ctx context.Context, patternID, userID string) ([]*Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnFinishedItemsByPatternID", ctx, patternID, userID)
+	ret0, _ := ret[0].([]*Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnFinishedItemsByPatternID indicates an expected call of GetUnFinishedItemsByPatternID.
+func (mr *MockIItemRepositoryMockRecorder) GetUnFinishedItemsByPatternID(ctx, patternID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnFinishedItemsByPatternID", reflect.TypeOf((*MockIItemRepository)(nil).GetUnFinishedItemsByPatternID), ctx, patternID, userID)
+}
+
 // GetUnclassfiedFinishedItemsByCategoryID mocks base method.
 func (m *MockIItemRepository) GetUnclassfiedFinishedItemsByCategoryID(ctx context.Context, categoryID, userID string) ([]*Item, error) {
 	m.ctrl.T.Helper()
@@ -428,6 +680,20 @@ func (mr *MockIItemRepositoryMockRecorder) HasCompletedReviewDateByItemID(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasCompletedReviewDateByItemID", reflect.TypeOf((*MockIItemRepository)(nil).HasCompletedReviewDateByItemID), ctx, itemID, userID)
 }
 
+// IncrementSlipCount mocks base method.
+func (m *MockIItemRepository) IncrementSlipCount(ctx context.Context, itemID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementSlipCount", ctx, itemID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementSlipCount indicates an expected call of IncrementSlipCount.
+func (mr *MockIItemRepositoryMockRecorder) IncrementSlipCount(ctx, itemID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementSlipCount", reflect.TypeOf((*MockIItemRepository)(nil).IncrementSlipCount), ctx, itemID, userID)
+}
+
 // IsPatternRelatedToItemByPatternID mocks base method.
 func (m *MockIItemRepository) IsPatternRelatedToItemByPatternID(ctx context.Context, patternID, userID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -443,6 +709,50 @@ func (mr *MockIItemRepositoryMockRecorder) IsPatternRelatedToItemByPatternID(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPatternRelatedToItemByPatternID", reflect.TypeOf((*MockIItemRepository)(nil).IsPatternRelatedToItemByPatternID), ctx, patternID, userID)
 }
 
+// RestoreItemOperation mocks base method.
+func (m *MockIItemRepository) RestoreItemOperation(ctx context.Context, operationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreItemOperation", ctx, operationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreItemOperation indicates an expected call of RestoreItemOperation.
+func (mr *MockIItemRepositoryMockRecorder) RestoreItemOperation(ctx, operationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreItemOperation", reflect.TypeOf((*MockIItemRepository)(nil).RestoreItemOperation), ctx, operationID, userID)
+}
+
+// SaveItemOperation mocks base method.
+func (m *MockIItemRepository) SaveItemOperation(ctx context.Context, operation *ItemOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveItemOperation", ctx, operation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveItemOperation indicates an expected call of SaveItemOperation.
+func (mr *MockIItemRepositoryMockRecorder) SaveItemOperation(ctx, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveItemOperation", reflect.TypeOf((*MockIItemRepository)(nil).SaveItemOperation), ctx, operation)
+}
+
+// SearchItems mocks base method.
+func (m *MockIItemRepository) SearchItems(ctx context.Context, userID string, terms []string, filter *ItemSearchFilter, limit int) ([]*Item, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchItems", ctx, userID, terms, filter, limit)
+	ret0, _ := ret[0].([]*Item)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchItems indicates an expected call of SearchItems.
+func (mr *MockIItemRepositoryMockRecorder) SearchItems(ctx, userID, terms, filter, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchItems", reflect.TypeOf((*MockIItemRepository)(nil).SearchItems), ctx, userID, terms, filter, limit)
+}
+
 // UpdateItem mocks base method.
 func (m *MockIItemRepository) UpdateItem(ctx context.Context, item *Item) error {
 	m.ctrl.T.Helper()
@@ -485,18 +795,46 @@ func (mr *MockIItemRepositoryMockRecorder) UpdateItemAsUnFinished(ctx, itemID, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemAsUnFinished", reflect.TypeOf((*MockIItemRepository)(nil).UpdateItemAsUnFinished), ctx, itemID, userID, editedAt)
 }
 
+// UpdateMemoryState mocks base method.
+func (m *MockIItemRepository) UpdateMemoryState(ctx context.Context, itemID, userID string, state MemoryState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemoryState", ctx, itemID, userID, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMemoryState indicates an expected call of UpdateMemoryState.
+func (mr *MockIItemRepositoryMockRecorder) UpdateMemoryState(ctx, itemID, userID, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemoryState", reflect.TypeOf((*MockIItemRepository)(nil).UpdateMemoryState), ctx, itemID, userID, state)
+}
+
+// UpdatePatternVersionOfUnFinishedItems mocks base method.
+func (m *MockIItemRepository) UpdatePatternVersionOfUnFinishedItems(ctx context.Context, patternID string, patternVersion int, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePatternVersionOfUnFinishedItems", ctx, patternID, patternVersion, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePatternVersionOfUnFinishedItems indicates an expected call of UpdatePatternVersionOfUnFinishedItems.
+func (mr *MockIItemRepositoryMockRecorder) UpdatePatternVersionOfUnFinishedItems(ctx, patternID, patternVersion, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePatternVersionOfUnFinishedItems", reflect.TypeOf((*MockIItemRepository)(nil).UpdatePatternVersionOfUnFinishedItems), ctx, patternID, patternVersion, userID)
+}
+
 // UpdateReviewDateAsCompleted mocks base method.
-func (m *MockIItemRepository) UpdateReviewDateAsCompleted(ctx context.Context, reviewdateID, userID string) error {
+func (m *MockIItemRepository) UpdateReviewDateAsCompleted(ctx context.Context, reviewdateID, userID string, completedDate time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateReviewDateAsCompleted", ctx, reviewdateID, userID)
+	ret := m.ctrl.Call(m, "UpdateReviewDateAsCompleted", ctx, reviewdateID, userID, completedDate)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateReviewDateAsCompleted indicates an expected call of UpdateReviewDateAsCompleted.
-func (mr *MockIItemRepositoryMockRecorder) UpdateReviewDateAsCompleted(ctx, reviewdateID, userID any) *gomock.Call {
+func (mr *MockIItemRepositoryMockRecorder) UpdateReviewDateAsCompleted(ctx, reviewdateID, userID, completedDate any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewDateAsCompleted", reflect.TypeOf((*MockIItemRepository)(nil).UpdateReviewDateAsCompleted), ctx, reviewdateID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewDateAsCompleted", reflect.TypeOf((*MockIItemRepository)(nil).UpdateReviewDateAsCompleted), ctx, reviewdateID, userID, completedDate)
 }
 
 // UpdateReviewDateAsInCompleted mocks base method.
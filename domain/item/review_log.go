@@ -0,0 +1,119 @@
+package item
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// 復習日の完了・未完了への変更の履歴
+type ReviewLog struct {
+	ReviewLogID     string
+	UserID          string
+	ItemID          string
+	ReviewDateID    *string // 復習日を作り直して元の復習日がなくなった場合はnil
+	StepNumber      int
+	Outcome         string
+	Grade           *int      // 完了時に想起度を指定した場合のみ
+	DurationSeconds *int      // 復習にかかった秒数（指定した場合のみ）
+	ReviewedDate    time.Time // ユーザーの現地の日付
+	ReviewedAt      time.Time
+}
+
+// 復習日の変更の種類
+const (
+	ReviewOutcomeCompleted   string = "completed"
+	ReviewOutcomeUncompleted string = "uncompleted"
+
+	// 1回の復習にかかった秒数として受け付ける上限（1日）
+	MaxReviewDurationSeconds = 24 * 60 * 60
+)
+
+var allowedReviewOutcomes = map[string]struct{}{
+	ReviewOutcomeCompleted:   {},
+	ReviewOutcomeUncompleted: {},
+}
+
+func NewReviewLog(
+	reviewLogID string,
+	userID string,
+	itemID string,
+	reviewDateID *string,
+	stepNumber int,
+	outcome string,
+	grade *int,
+	durationSeconds *int,
+	reviewedDate time.Time,
+	reviewedAt time.Time,
+) (*ReviewLog, error) {
+	if err := validateReviewOutcome(outcome); err != nil {
+		return nil, err
+	}
+	if grade != nil && (*grade < MinGrade || *grade > MaxGrade) {
+		return nil, ErrInvalidGrade
+	}
+	if durationSeconds != nil && (*durationSeconds < 0 || *durationSeconds > MaxReviewDurationSeconds) {
+		return nil, ErrInvalidDurationSeconds
+	}
+
+	l := &ReviewLog{
+		ReviewLogID:     reviewLogID,
+		UserID:          userID,
+		ItemID:          itemID,
+		ReviewDateID:    reviewDateID,
+		StepNumber:      stepNumber,
+		Outcome:         outcome,
+		Grade:           grade,
+		DurationSeconds: durationSeconds,
+		ReviewedDate:    reviewedDate,
+		ReviewedAt:      reviewedAt,
+	}
+	return l, nil
+}
+
+// 合格点未満の想起度で完了した場合は、完了していても想起に失敗した（lapse）ものとして扱う
+func (l *ReviewLog) IsLapse() bool {
+	return l.Outcome == ReviewOutcomeCompleted && l.Grade != nil && *l.Grade < passingGrade
+}
+
+func ReconstructReviewLog(
+	reviewLogID string,
+	userID string,
+	itemID string,
+	reviewDateID *string,
+	stepNumber int,
+	outcome string,
+	grade *int,
+	durationSeconds *int,
+	reviewedDate time.Time,
+	reviewedAt time.Time,
+) (*ReviewLog, error) {
+	l := &ReviewLog{
+		ReviewLogID:     reviewLogID,
+		UserID:          userID,
+		ItemID:          itemID,
+		ReviewDateID:    reviewDateID,
+		StepNumber:      stepNumber,
+		Outcome:         outcome,
+		Grade:           grade,
+		DurationSeconds: durationSeconds,
+		ReviewedDate:    reviewedDate,
+		ReviewedAt:      reviewedAt,
+	}
+	return l, nil
+}
+
+func validateReviewOutcome(outcome string) error {
+	return validation.Validate(
+		outcome,
+		validation.Required.Error("復習日の変更の種類は必須です"),
+		validation.By(func(value interface{}) error {
+			o, _ := value.(string)
+			if _, ok := allowedReviewOutcomes[o]; !ok {
+				return errors.New("復習日の変更の種類の値が不正です")
+			}
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,91 @@
+package item
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewReviewLog(t *testing.T) {
+	reviewedDate := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	reviewedAt := time.Date(2024, 1, 2, 23, 30, 0, 0, time.UTC)
+	validGrade := 3
+	invalidGrade := MaxGrade + 1
+	zeroSeconds := 0
+	maxSeconds := MaxReviewDurationSeconds
+	negativeSeconds := -1
+	tooLongSeconds := MaxReviewDurationSeconds + 1
+
+	tests := []struct {
+		name            string
+		outcome         string
+		grade           *int
+		durationSeconds *int
+		wantErr         error // nilでなければこのエラーと一致することを期待する
+		wantAnyErr      bool  // ozzo-validationのエラーなど、種類を問わずエラーを期待する
+	}{
+		{name: "完了（想起度・所要時間なし）", outcome: ReviewOutcomeCompleted},
+		{name: "完了（想起度・所要時間あり）", outcome: ReviewOutcomeCompleted, grade: &validGrade, durationSeconds: &zeroSeconds},
+		{name: "未完了に戻す", outcome: ReviewOutcomeUncompleted},
+		{name: "所要時間が上限ちょうど", outcome: ReviewOutcomeCompleted, durationSeconds: &maxSeconds},
+		{name: "不明な変更の種類はエラー", outcome: "skipped", wantAnyErr: true},
+		{name: "変更の種類が空はエラー", outcome: "", wantAnyErr: true},
+		{name: "想起度が範囲外はエラー", outcome: ReviewOutcomeCompleted, grade: &invalidGrade, wantErr: ErrInvalidGrade},
+		{name: "所要時間が負はエラー", outcome: ReviewOutcomeCompleted, durationSeconds: &negativeSeconds, wantErr: ErrInvalidDurationSeconds},
+		{name: "所要時間が上限を超えるとエラー", outcome: ReviewOutcomeCompleted, durationSeconds: &tooLongSeconds, wantErr: ErrInvalidDurationSeconds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewDateID := "reviewdate1"
+			got, err := NewReviewLog("log1", "user1", "item1", &reviewDateID, 1, tt.outcome, tt.grade, tt.durationSeconds, reviewedDate, reviewedAt)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("NewReviewLog() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if tt.wantAnyErr {
+				if err == nil {
+					t.Fatal("エラーが発生することを期待しましたが、nilでした")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewReviewLog() unexpected error = %v", err)
+			}
+			if got.Outcome != tt.outcome || got.Grade != tt.grade || got.DurationSeconds != tt.durationSeconds {
+				t.Errorf("NewReviewLog() = %+v", got)
+			}
+			if !got.ReviewedDate.Equal(reviewedDate) || !got.ReviewedAt.Equal(reviewedAt) {
+				t.Errorf("NewReviewLog() の日時が一致しません: got %v / %v", got.ReviewedDate, got.ReviewedAt)
+			}
+		})
+	}
+}
+
+func TestReviewLog_IsLapse(t *testing.T) {
+	failedGrade := passingGrade - 1
+	passedGrade := passingGrade
+
+	tests := []struct {
+		name    string
+		outcome string
+		grade   *int
+		want    bool
+	}{
+		{name: "合格点未満の想起度で完了", outcome: ReviewOutcomeCompleted, grade: &failedGrade, want: true},
+		{name: "合格点の想起度で完了", outcome: ReviewOutcomeCompleted, grade: &passedGrade, want: false},
+		{name: "想起度なしで完了", outcome: ReviewOutcomeCompleted, want: false},
+		{name: "未完了に戻す", outcome: ReviewOutcomeUncompleted, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &ReviewLog{Outcome: tt.outcome, Grade: tt.grade}
+			if got := l.IsLapse(); got != tt.want {
+				t.Errorf("IsLapse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,12 +1,18 @@
 package item
 
 import (
+	"hash/fnv"
+	"math"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
 )
 
+// 復習日間隔に加える揺らぎの最大幅（間隔に対する割合）
+const intervalFuzzFactor = 0.05
+
 // 復習日の計算を担うドメインサービス
 type scheduler struct{}
 
@@ -234,3 +240,89 @@ func (s *scheduler) FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates(
 
 	return result, nil
 }
+
+// 固定ステップでは完了時に残りの復習日を動かさない
+func (s *scheduler) RescheduleAfterCompletion(
+	targetPattern *PatternDomain.Pattern,
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	completedStepNumber int,
+	state MemoryState,
+	grade int,
+	parsedLastReviewedDate time.Time,
+	parsedToday time.Time,
+) ([]*Reviewdate, MemoryState, error) {
+	return []*Reviewdate{}, state, nil
+}
+
+// 固定ステップでは想起失敗しても復習日を動かさない
+func (s *scheduler) RescheduleAfterFailure(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	parsedToday time.Time,
+) ([]*Reviewdate, error) {
+	return []*Reviewdate{}, nil
+}
+
+// 完了したステップより後の未完了の復習日を、実際に完了した日を起点にパターン上の「一つ前のステップからの間隔」を積み上げて再計算する
+func (s *scheduler) RescheduleFromCompletedDate(
+	targetPatternSteps []*PatternDomain.PatternStep,
+	reviewdates []*Reviewdate,
+	completedStepNumber int,
+	parsedCompletedDate time.Time,
+) ([]*Reviewdate, error) {
+	if len(targetPatternSteps) != len(reviewdates) {
+		return nil, ErrMismatchedIDsAndSteps
+	}
+
+	gaps := stepGaps(targetPatternSteps)
+	result := make([]*Reviewdate, 0, len(reviewdates))
+	baseDate := parsedCompletedDate
+	for _, rd := range reviewdates {
+		if rd.StepNumber <= completedStepNumber || rd.IsCompleted {
+			continue
+		}
+		baseDate = baseDate.AddDate(0, 0, gaps[rd.StepNumber])
+
+		reviewdate, err := NewReviewdate(
+			rd.ReviewdateID,
+			rd.UserID,
+			rd.CategoryID,
+			rd.BoxID,
+			rd.ItemID,
+			rd.StepNumber,
+			baseDate,
+			baseDate,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, reviewdate)
+	}
+	return result, nil
+}
+
+// パターン上の「一つ前のステップからの間隔」をステップ番号毎に求める
+func stepGaps(targetPatternSteps []*PatternDomain.PatternStep) map[int]int {
+	gaps := make(map[int]int, len(targetPatternSteps))
+	prevIntervalDays := 0
+	for _, step := range targetPatternSteps {
+		gaps[step.StepNumber] = step.IntervalDays - prevIntervalDays
+		prevIntervalDays = step.IntervalDays
+	}
+	return gaps
+}
+
+// 復習物IDとステップ番号をシードにして、間隔に0〜5%の揺らぎを加えた日数を返す
+// 同じ復習物・ステップ・間隔なら常に同じ日数になるため、再計算しても復習日は変わらない
+func fuzzIntervalDays(itemID string, stepNumber int, intervalDays int) int {
+	maxFuzz := int(math.Round(float64(intervalDays) * intervalFuzzFactor))
+	if maxFuzz <= 0 {
+		return intervalDays
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(itemID + ":" + strconv.Itoa(stepNumber)))
+	return intervalDays + int(h.Sum32()%uint32(maxFuzz+1))
+}
@@ -1,6 +1,7 @@
 package item
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -681,3 +682,111 @@ func TestFormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates(t *testing.
 func stringPtr(s string) *string {
 	return &s
 }
+
+func TestScheduler_RescheduleFromCompletedDate(t *testing.T) {
+	scheduler := NewScheduler()
+
+	// 学習日(2024-01-01)から1日後、3日後、7日後の3ステップ（一つ前のステップからの間隔は1日、2日、4日）
+	targetPatternSteps := []*PatternDomain.PatternStep{
+		{StepNumber: 1, IntervalDays: 1},
+		{StepNumber: 2, IntervalDays: 3},
+		{StepNumber: 3, IntervalDays: 7},
+	}
+	newReviewdates := func(isFirstCompleted bool) []*Reviewdate {
+		return []*Reviewdate{
+			{ReviewdateID: "rd1", UserID: "user123", ItemID: "item123", StepNumber: 1, InitialScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), IsCompleted: isFirstCompleted},
+			{ReviewdateID: "rd2", UserID: "user123", ItemID: "item123", StepNumber: 2, InitialScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+			{ReviewdateID: "rd3", UserID: "user123", ItemID: "item123", StepNumber: 3, InitialScheduledDate: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), ScheduledDate: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), IsCompleted: false},
+		}
+	}
+
+	tests := []struct {
+		name                string
+		targetPatternSteps  []*PatternDomain.PatternStep
+		reviewdates         []*Reviewdate
+		completedStepNumber int
+		parsedCompletedDate time.Time
+		wantDates           map[string]time.Time
+		wantErr             error
+	}{
+		{
+			name:                "予定日より遅れて完了した場合は残りの復習日も遅れた分だけ後ろにずれる",
+			targetPatternSteps:  targetPatternSteps,
+			reviewdates:         newReviewdates(false),
+			completedStepNumber: 1,
+			parsedCompletedDate: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			wantDates: map[string]time.Time{
+				"rd2": time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+				"rd3": time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:                "予定日通りに完了した場合は学習日起点と同じ復習日になる",
+			targetPatternSteps:  targetPatternSteps,
+			reviewdates:         newReviewdates(false),
+			completedStepNumber: 1,
+			parsedCompletedDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			wantDates: map[string]time.Time{
+				"rd2": time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+				"rd3": time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:                "途中のステップを完了した場合はそれより後の復習日だけを再計算する",
+			targetPatternSteps:  targetPatternSteps,
+			reviewdates:         newReviewdates(true),
+			completedStepNumber: 2,
+			parsedCompletedDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			wantDates: map[string]time.Time{
+				"rd3": time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:                "最後のステップを完了した場合は再計算する復習日がない",
+			targetPatternSteps:  targetPatternSteps,
+			reviewdates:         newReviewdates(false),
+			completedStepNumber: 3,
+			parsedCompletedDate: time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC),
+			wantDates:           map[string]time.Time{},
+		},
+		{
+			name:                "ステップ数と復習日数が一致しない場合はエラー",
+			targetPatternSteps:  targetPatternSteps[:2],
+			reviewdates:         newReviewdates(false),
+			completedStepNumber: 1,
+			parsedCompletedDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			wantErr:             ErrMismatchedIDsAndSteps,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scheduler.RescheduleFromCompletedDate(tt.targetPatternSteps, tt.reviewdates, tt.completedStepNumber, tt.parsedCompletedDate)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("RescheduleFromCompletedDate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RescheduleFromCompletedDate() unexpected error = %v", err)
+			}
+			if len(got) != len(tt.wantDates) {
+				t.Fatalf("RescheduleFromCompletedDate() len = %d, want %d", len(got), len(tt.wantDates))
+			}
+			for _, rd := range got {
+				want, ok := tt.wantDates[rd.ReviewdateID]
+				if !ok {
+					t.Errorf("予期しない復習日が再計算されました: %s", rd.ReviewdateID)
+					continue
+				}
+				if !rd.ScheduledDate.Equal(want) || !rd.InitialScheduledDate.Equal(want) {
+					t.Errorf("%s の復習日 = %v（初回 %v）, want %v", rd.ReviewdateID, rd.ScheduledDate, rd.InitialScheduledDate, want)
+				}
+				if rd.IsCompleted {
+					t.Errorf("%s が完了済みになっています", rd.ReviewdateID)
+				}
+			}
+		})
+	}
+}
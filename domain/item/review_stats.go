@@ -0,0 +1,81 @@
+package item
+
+import (
+	"sort"
+	"time"
+)
+
+// 完了率を求める期間（今日を含む直近の日数）
+var CompletionRateWindowDays = []int{7, 30, 90}
+
+// 完了率の計算用の、日毎の予定されていた復習日数と完了済みの復習日数
+type ReviewCompletionCount struct {
+	ScheduledDate  time.Time // 復習日を作成した時点の予定日（期限切れのずらしなどで動いた後の日ではない）
+	DueCount       int
+	CompletedCount int
+}
+
+// 直近Days日間の完了率
+type CompletionRate struct {
+	Days           int
+	DueCount       int
+	CompletedCount int
+	Rate           *float64 // 期間内に予定された復習日がない場合はnil
+}
+
+// 今日まで続いている連続学習日数と、これまでで最長の連続学習日数を求める。
+// 今日まだ復習していなくても、昨日まで続いていれば継続中として数える。
+// completedDatesは復習日を完了した日（今日より後の日付は無視する）。
+func CalcStreaks(completedDates []time.Time, parsedToday time.Time) (current int, longest int) {
+	days := make([]time.Time, 0, len(completedDates))
+	for _, d := range completedDates {
+		if !d.After(parsedToday) {
+			days = append(days, d)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	run := 0
+	var prev time.Time
+	for i, d := range days {
+		switch {
+		case i > 0 && d.Equal(prev):
+			continue
+		case i > 0 && d.Equal(prev.AddDate(0, 0, 1)):
+			run++
+		default:
+			run = 1
+		}
+		prev = d
+		if run > longest {
+			longest = run
+		}
+	}
+
+	if len(days) > 0 && !prev.Before(parsedToday.AddDate(0, 0, -1)) {
+		current = run
+	}
+	return current, longest
+}
+
+// 今日を含む直近windowDays日間毎に、予定されていた復習日のうち完了した割合を求める
+func CalcCompletionRates(counts []*ReviewCompletionCount, parsedToday time.Time, windowDays []int) []CompletionRate {
+	rates := make([]CompletionRate, len(windowDays))
+	for i, days := range windowDays {
+		fromDate := parsedToday.AddDate(0, 0, -(days - 1))
+		rate := CompletionRate{Days: days}
+		for _, c := range counts {
+			if c.ScheduledDate.Before(fromDate) || c.ScheduledDate.After(parsedToday) {
+				continue
+			}
+			rate.DueCount += c.DueCount
+			rate.CompletedCount += c.CompletedCount
+		}
+		if rate.DueCount > 0 {
+			r := float64(rate.CompletedCount) / float64(rate.DueCount)
+			rate.Rate = &r
+		}
+		rates[i] = rate
+	}
+	return rates
+}
@@ -0,0 +1,84 @@
+package item
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCalcStreaks(t *testing.T) {
+	parsedToday := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name           string
+		completedDates []time.Time
+		wantCurrent    int
+		wantLongest    int
+	}{
+		{name: "完了した日がない", completedDates: nil, wantCurrent: 0, wantLongest: 0},
+		{name: "今日まで続いている", completedDates: []time.Time{day(8), day(9), day(10)}, wantCurrent: 3, wantLongest: 3},
+		{name: "昨日まで続いていれば継続中", completedDates: []time.Time{day(8), day(9)}, wantCurrent: 2, wantLongest: 2},
+		{name: "一昨日で途切れている", completedDates: []time.Time{day(7), day(8)}, wantCurrent: 0, wantLongest: 2},
+		{name: "過去の方が長い", completedDates: []time.Time{day(1), day(2), day(3), day(4), day(9), day(10)}, wantCurrent: 2, wantLongest: 4},
+		{name: "重複と順不同を許容する", completedDates: []time.Time{day(10), day(9), day(9), day(8)}, wantCurrent: 3, wantLongest: 3},
+		{name: "今日より後の日付は無視する", completedDates: []time.Time{day(10), day(11), day(12)}, wantCurrent: 1, wantLongest: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCurrent, gotLongest := CalcStreaks(tt.completedDates, parsedToday)
+			if gotCurrent != tt.wantCurrent || gotLongest != tt.wantLongest {
+				t.Errorf("CalcStreaks() = (%d, %d), want (%d, %d)", gotCurrent, gotLongest, tt.wantCurrent, tt.wantLongest)
+			}
+		})
+	}
+}
+
+func TestCalcCompletionRates(t *testing.T) {
+	parsedToday := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	rate := func(r float64) *float64 { return &r }
+
+	counts := []*ReviewCompletionCount{
+		{ScheduledDate: parsedToday, DueCount: 2, CompletedCount: 1},
+		{ScheduledDate: parsedToday.AddDate(0, 0, -6), DueCount: 2, CompletedCount: 2},  // 7日間に含まれる最も古い日
+		{ScheduledDate: parsedToday.AddDate(0, 0, -7), DueCount: 4, CompletedCount: 1},  // 30日間から
+		{ScheduledDate: parsedToday.AddDate(0, 0, -89), DueCount: 2, CompletedCount: 0}, // 90日間に含まれる最も古い日
+		{ScheduledDate: parsedToday.AddDate(0, 0, -90), DueCount: 5, CompletedCount: 5}, // どの期間にも含まれない
+		{ScheduledDate: parsedToday.AddDate(0, 0, 1), DueCount: 3, CompletedCount: 0},   // 明日以降は含まない
+	}
+
+	tests := []struct {
+		name       string
+		counts     []*ReviewCompletionCount
+		windowDays []int
+		want       []CompletionRate
+	}{
+		{
+			name:       "期間毎に集計する",
+			counts:     counts,
+			windowDays: CompletionRateWindowDays,
+			want: []CompletionRate{
+				{Days: 7, DueCount: 4, CompletedCount: 3, Rate: rate(0.75)},
+				{Days: 30, DueCount: 8, CompletedCount: 4, Rate: rate(0.5)},
+				{Days: 90, DueCount: 10, CompletedCount: 4, Rate: rate(0.4)},
+			},
+		},
+		{
+			name:       "予定された復習日がない場合は完了率なし",
+			counts:     nil,
+			windowDays: []int{7},
+			want:       []CompletionRate{{Days: 7}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalcCompletionRates(tt.counts, parsedToday, tt.windowDays)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("CalcCompletionRates() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
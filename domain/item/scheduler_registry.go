@@ -0,0 +1,27 @@
+package item
+
+// 復習パターンのscheduler_kindごとに使うスケジューラーを引き当てるレジストリ
+// 新しいスケジューリング方式を追加する場合は、IScheduler を実装して起動時に Register するだけでよい
+type SchedulerRegistry struct {
+	defaultScheduler IScheduler
+	schedulers       map[string]IScheduler
+}
+
+// 登録されていない scheduler_kind（空文字を含む）の場合は defaultScheduler を使う
+func NewSchedulerRegistry(defaultScheduler IScheduler) *SchedulerRegistry {
+	return &SchedulerRegistry{
+		defaultScheduler: defaultScheduler,
+		schedulers:       make(map[string]IScheduler),
+	}
+}
+
+func (r *SchedulerRegistry) Register(schedulerKind string, scheduler IScheduler) {
+	r.schedulers[schedulerKind] = scheduler
+}
+
+func (r *SchedulerRegistry) Resolve(schedulerKind string) IScheduler {
+	if scheduler, ok := r.schedulers[schedulerKind]; ok {
+		return scheduler
+	}
+	return r.defaultScheduler
+}
@@ -0,0 +1,52 @@
+package item
+
+import (
+	"testing"
+
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+func TestSchedulerRegistry_Resolve(t *testing.T) {
+	defaultScheduler := NewScheduler()
+	adaptiveScheduler := NewAdaptiveScheduler()
+	fsrsScheduler := NewFSRSScheduler()
+
+	registry := NewSchedulerRegistry(defaultScheduler)
+	registry.Register(PatternDomain.SchedulerKindAdaptive, adaptiveScheduler)
+	registry.Register(PatternDomain.SchedulerKindFSRS, fsrsScheduler)
+
+	tests := []struct {
+		name          string
+		schedulerKind string
+		want          IScheduler
+	}{
+		{
+			name:          "登録済みの方式（適応型）",
+			schedulerKind: PatternDomain.SchedulerKindAdaptive,
+			want:          adaptiveScheduler,
+		},
+		{
+			name:          "登録済みの方式（FSRS）",
+			schedulerKind: PatternDomain.SchedulerKindFSRS,
+			want:          fsrsScheduler,
+		},
+		{
+			name:          "未登録の方式はデフォルトのスケジューラー",
+			schedulerKind: PatternDomain.SchedulerKindFixedSteps,
+			want:          defaultScheduler,
+		},
+		{
+			name:          "空文字はデフォルトのスケジューラー",
+			schedulerKind: "",
+			want:          defaultScheduler,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := registry.Resolve(tt.schedulerKind); got != tt.want {
+				t.Errorf("Resolve(%q) = %T(%p), want %T(%p)", tt.schedulerKind, got, got, tt.want, tt.want)
+			}
+		})
+	}
+}
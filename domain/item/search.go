@@ -0,0 +1,276 @@
+package item
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 検索語の上限（文字数と、空白で区切った語の数）
+const (
+	MaxSearchQueryLength = 100
+	MaxSearchTerms       = 5
+)
+
+// 検索結果の件数
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+// 順位付けの対象としてDBから取得する候補の最大数（DB側でも名前に一致したものを優先して選ぶ）
+const MaxSearchCandidates = 1000
+
+// 詳細の抜粋の長さと、最初に一致した箇所より前に含める文字数
+const (
+	searchSnippetLength = 80
+	searchSnippetLead   = 20
+)
+
+// 検索の絞り込み条件。nilの項目では絞り込まない
+type ItemSearchFilter struct {
+	CategoryID  *string
+	BoxID       *string
+	LearnedFrom *time.Time
+	LearnedTo   *time.Time
+	IsFinished  *bool // nilの場合は完了済み・未完了の両方
+}
+
+// ハイライト用に分割した文字列の断片。Matchedがtrueの断片が検索語に一致した箇所
+type HighlightSegment struct {
+	Text    string
+	Matched bool
+}
+
+// 検索結果の1件
+type ItemSearchHit struct {
+	Item          *Item
+	Score         int
+	NameHighlight []HighlightSegment
+	DetailSnippet []HighlightSegment // 詳細に一致した箇所の周辺の抜粋（詳細が空の場合はnil）
+}
+
+func NewItemSearchFilter(categoryID *string, boxID *string, learnedFrom *time.Time, learnedTo *time.Time, isFinished *bool) (*ItemSearchFilter, error) {
+	if learnedFrom != nil && learnedTo != nil && learnedFrom.After(*learnedTo) {
+		return nil, ErrInvalidSearchLearnedDateRange
+	}
+	return &ItemSearchFilter{
+		CategoryID:  categoryID,
+		BoxID:       boxID,
+		LearnedFrom: learnedFrom,
+		LearnedTo:   learnedTo,
+		IsFinished:  isFinished,
+	}, nil
+}
+
+// 検索語を空白（全角スペースを含む）で区切り、大文字小文字を無視して重複を除いた語を返す
+// 日本語は単語の区切りがないため、区切った語はそれぞれ部分一致で検索する
+func ParseSearchQuery(q string) ([]string, error) {
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return nil, ErrEmptySearchQuery
+	}
+	if utf8.RuneCountInString(q) > MaxSearchQueryLength {
+		return nil, ErrInvalidSearchQuery
+	}
+
+	var terms []string
+	seen := make(map[string]struct{})
+	for _, f := range strings.Fields(q) {
+		key := strings.ToLower(f)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		terms = append(terms, f)
+	}
+	if len(terms) > MaxSearchTerms {
+		return nil, ErrInvalidSearchQuery
+	}
+	return terms, nil
+}
+
+func ValidateSearchLimit(limit int) error {
+	if limit < 1 || limit > MaxSearchLimit {
+		return ErrInvalidSearchLimit
+	}
+	return nil
+}
+
+// 検索語を全て含む復習物を関連度の高い順に並べ、上位limit件を返す
+// 名前に一致したものを詳細だけに一致したものより優先し、名前の中でも完全一致・前方一致・部分一致の順に高くする
+// 同じ関連度の場合は学習日の新しい順
+func RankItemSearchResults(items []*Item, terms []string, limit int) []*ItemSearchHit {
+	lowerTerms := make([][]rune, 0, len(terms))
+	for _, t := range terms {
+		if t != "" {
+			lowerTerms = append(lowerTerms, toLowerRunes([]rune(t)))
+		}
+	}
+
+	hits := make([]*ItemSearchHit, 0, len(items))
+	for _, item := range items {
+		name := []rune(item.Name)
+		detail := []rune(item.Detail)
+		lowerName := toLowerRunes(name)
+		lowerDetail := toLowerRunes(detail)
+
+		score := 0
+		matchedAll := true
+		var nameMatches, detailMatches [][2]int
+		for _, term := range lowerTerms {
+			n := findAllRunes(lowerName, term)
+			d := findAllRunes(lowerDetail, term)
+			if len(n) == 0 && len(d) == 0 {
+				matchedAll = false
+				break
+			}
+			score += scoreTerm(lowerName, term, len(n), len(d))
+			nameMatches = append(nameMatches, n...)
+			detailMatches = append(detailMatches, d...)
+		}
+		if !matchedAll {
+			continue
+		}
+
+		hits = append(hits, &ItemSearchHit{
+			Item:          item,
+			Score:         score,
+			NameHighlight: highlightRunes(name, mergeRanges(nameMatches), 0, len(name)),
+			DetailSnippet: detailSnippet(detail, mergeRanges(detailMatches)),
+		})
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		if !hits[i].Item.LearnedDate.Equal(hits[j].Item.LearnedDate) {
+			return hits[i].Item.LearnedDate.After(hits[j].Item.LearnedDate)
+		}
+		return hits[i].Item.ItemID < hits[j].Item.ItemID
+	})
+	if limit >= 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// 1つの検索語の点数。一致した回数による加点は3回までに抑える
+func scoreTerm(lowerName []rune, term []rune, nameCount int, detailCount int) int {
+	score := 0
+	switch {
+	case string(lowerName) == string(term):
+		score += 100
+	case nameCount > 0 && hasRunePrefix(lowerName, term):
+		score += 60
+	case nameCount > 0:
+		score += 40
+	}
+	if nameCount > 1 {
+		score += 5 * (min(nameCount, 3) - 1)
+	}
+	if detailCount > 0 {
+		score += 10 + 2*(min(detailCount, 3)-1)
+	}
+	return score
+}
+
+func toLowerRunes(rs []rune) []rune {
+	lower := make([]rune, len(rs))
+	for i, r := range rs {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
+
+func hasRunePrefix(rs []rune, prefix []rune) bool {
+	return len(rs) >= len(prefix) && string(rs[:len(prefix)]) == string(prefix)
+}
+
+// textの中でtermに一致する範囲（[開始, 終了)の文字位置）を重ならないように全て返す
+func findAllRunes(text []rune, term []rune) [][2]int {
+	var ranges [][2]int
+	for i := 0; i+len(term) <= len(text); {
+		if hasRunePrefix(text[i:], term) {
+			ranges = append(ranges, [2]int{i, i + len(term)})
+			i += len(term)
+			continue
+		}
+		i++
+	}
+	return ranges
+}
+
+// 重なる・隣接する範囲をまとめ、開始位置の順に返す
+func mergeRanges(ranges [][2]int) [][2]int {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sorted := make([][2]int, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i][0] < sorted[j][0]
+	})
+
+	merged := [][2]int{sorted[0]}
+	for _, r := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if r[0] <= last[1] {
+			last[1] = max(last[1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// text[from:to]を一致した範囲とそれ以外の断片に分割する
+func highlightRunes(text []rune, ranges [][2]int, from int, to int) []HighlightSegment {
+	if from >= to {
+		return nil
+	}
+	var segments []HighlightSegment
+	pos := from
+	for _, r := range ranges {
+		start, end := max(r[0], from), min(r[1], to)
+		if start >= end {
+			continue
+		}
+		if pos < start {
+			segments = append(segments, HighlightSegment{Text: string(text[pos:start])})
+		}
+		segments = append(segments, HighlightSegment{Text: string(text[start:end]), Matched: true})
+		pos = end
+	}
+	if pos < to {
+		segments = append(segments, HighlightSegment{Text: string(text[pos:to])})
+	}
+	return segments
+}
+
+// 最初に一致した箇所を含むように詳細を切り出す。詳細に一致しない場合は先頭から切り出す
+// 切り出した前後に続きがある場合は「…」を付ける
+func detailSnippet(detail []rune, ranges [][2]int) []HighlightSegment {
+	if len(detail) == 0 {
+		return nil
+	}
+	from := 0
+	if len(ranges) > 0 {
+		from = max(ranges[0][0]-searchSnippetLead, 0)
+	}
+	to := min(from+searchSnippetLength, len(detail))
+	// 末尾付近に一致した場合も抜粋の長さを保つ
+	from = max(min(from, to-searchSnippetLength), 0)
+
+	segments := highlightRunes(detail, ranges, from, to)
+	if from > 0 {
+		segments = append([]HighlightSegment{{Text: "…"}}, segments...)
+	}
+	if to < len(detail) {
+		segments = append(segments, HighlightSegment{Text: "…"})
+	}
+	return segments
+}
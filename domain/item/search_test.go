@@ -0,0 +1,138 @@
+package item
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		q       string
+		want    []string
+		wantErr error
+	}{
+		{name: "1語", q: "英単語", want: []string{"英単語"}},
+		{name: "半角・全角スペースで区切る", q: " go　並行処理  channel ", want: []string{"go", "並行処理", "channel"}},
+		{name: "大文字小文字だけ異なる語は1語にまとめる", q: "Go go GO", want: []string{"Go"}},
+		{name: "空白だけはエラー", q: " 　 ", wantErr: ErrEmptySearchQuery},
+		{name: "文字数の上限を超える場合はエラー", q: strings.Repeat("あ", MaxSearchQueryLength+1), wantErr: ErrInvalidSearchQuery},
+		{name: "語の数の上限を超える場合はエラー", q: "a b c d e f", wantErr: ErrInvalidSearchQuery},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSearchQuery(tt.q)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseSearchQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseSearchQuery() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewItemSearchFilter(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		from    *time.Time
+		to      *time.Time
+		wantErr error
+	}{
+		{name: "期間を指定しない"},
+		{name: "開始日だけ指定する", from: &from},
+		{name: "開始日と終了日が同じ", from: &from, to: &from},
+		{name: "開始日が終了日より後の場合はエラー", from: &to, to: &from, wantErr: ErrInvalidSearchLearnedDateRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewItemSearchFilter(nil, nil, tt.from, tt.to, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewItemSearchFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRankItemSearchResults(t *testing.T) {
+	date := func(day int) time.Time {
+		return time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+	}
+	items := []*Item{
+		{ItemID: "item-detail", Name: "並行処理", Detail: "Goのchannelの使い方", LearnedDate: date(3)},
+		{ItemID: "item-contains", Name: "Goのchannel", Detail: "", LearnedDate: date(1)},
+		{ItemID: "item-exact", Name: "Channel", Detail: "", LearnedDate: date(1)},
+		{ItemID: "item-prefix", Name: "channelとselect", Detail: "", LearnedDate: date(1)},
+		{ItemID: "item-prefix-new", Name: "channelの閉じ方", Detail: "", LearnedDate: date(2)},
+		{ItemID: "item-none", Name: "mutex", Detail: "排他制御", LearnedDate: date(5)},
+	}
+
+	t.Run("名前の完全一致・前方一致・部分一致・詳細の一致の順に並べ、同じ点数は学習日の新しい順", func(t *testing.T) {
+		got := RankItemSearchResults(items, []string{"CHANNEL"}, 10)
+		gotIDs := make([]string, len(got))
+		for i, h := range got {
+			gotIDs[i] = h.Item.ItemID
+		}
+		want := []string{"item-exact", "item-prefix-new", "item-prefix", "item-contains", "item-detail"}
+		if diff := cmp.Diff(want, gotIDs); diff != "" {
+			t.Errorf("RankItemSearchResults() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("全ての検索語を含む復習物だけを上位limit件返す", func(t *testing.T) {
+		got := RankItemSearchResults(items, []string{"go", "channel"}, 1)
+		if len(got) != 1 || got[0].Item.ItemID != "item-contains" {
+			t.Fatalf("RankItemSearchResults() = %+v", got)
+		}
+	})
+
+	t.Run("一致した箇所をハイライトする", func(t *testing.T) {
+		got := RankItemSearchResults(items[:1], []string{"処理", "CHANNEL"}, 10)
+		if len(got) != 1 {
+			t.Fatalf("RankItemSearchResults() = %+v", got)
+		}
+		wantName := []HighlightSegment{
+			{Text: "並行"},
+			{Text: "処理", Matched: true},
+		}
+		if diff := cmp.Diff(wantName, got[0].NameHighlight); diff != "" {
+			t.Errorf("NameHighlight mismatch (-want +got):\n%s", diff)
+		}
+		wantDetail := []HighlightSegment{
+			{Text: "Goの"},
+			{Text: "channel", Matched: true},
+			{Text: "の使い方"},
+		}
+		if diff := cmp.Diff(wantDetail, got[0].DetailSnippet); diff != "" {
+			t.Errorf("DetailSnippet mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("長い詳細は一致した箇所の周辺だけを抜粋する", func(t *testing.T) {
+		long := &Item{
+			ItemID: "item-long",
+			Name:   "長文",
+			Detail: strings.Repeat("あ", 50) + "一致" + strings.Repeat("い", 100),
+		}
+		got := RankItemSearchResults([]*Item{long}, []string{"一致"}, 10)
+		want := []HighlightSegment{
+			{Text: "…"},
+			{Text: strings.Repeat("あ", searchSnippetLead)},
+			{Text: "一致", Matched: true},
+			{Text: strings.Repeat("い", searchSnippetLength-searchSnippetLead-2)},
+			{Text: "…"},
+		}
+		if diff := cmp.Diff(want, got[0].DetailSnippet); diff != "" {
+			t.Errorf("DetailSnippet mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
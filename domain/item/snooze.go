@@ -0,0 +1,77 @@
+package item
+
+import (
+	"sort"
+)
+
+// 復習日を先送りする範囲
+const (
+	SnoozePolicyOnlyThis   string = "only_this"   // 指定した復習日だけ先送りする
+	SnoozePolicyShiftLater string = "shift_later" // 指定した復習日と、それ以降の未完了の復習日をまとめて先送りする
+)
+
+// 一度に先送りできる最大日数
+const MaxSnoozeDays = 365
+
+// 指定した復習日をdays日先送りし、日付を変更した復習日だけを返す（引数の復習日は変更しない）
+// 先送りした日がカレンダー上で復習できない日の場合は、復習できる次の日まで更にずらす
+// 先送りした結果、復習日がステップ番号の順に並ばなくなる場合はエラー
+func SnoozeReviewdates(reviewdates []*Reviewdate, reviewdateID string, days int, policy string, calendar IReviewCalendar) ([]*Reviewdate, error) {
+	if err := ValidateSnooze(days, policy); err != nil {
+		return nil, err
+	}
+
+	sorted := make([]*Reviewdate, len(reviewdates))
+	copy(sorted, reviewdates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StepNumber < sorted[j].StepNumber
+	})
+
+	targetIndex := -1
+	for i, rd := range sorted {
+		if rd.ReviewdateID == reviewdateID {
+			targetIndex = i
+			break
+		}
+	}
+	if targetIndex < 0 {
+		return nil, ErrReviewDateNotFound
+	}
+	if sorted[targetIndex].IsCompleted {
+		return nil, ErrSnoozeCompletedReviewDate
+	}
+
+	// 先送りした復習日と、その次のステップの復習日の順序を検証する（前のステップとは逆転しないが、休息日を避けると先送りした復習日同士が同じ日になりうる）
+	snoozed := make([]*Reviewdate, 0, len(sorted)-targetIndex)
+	after := make([]*Reviewdate, len(sorted))
+	copy(after, sorted)
+	moved := make([]bool, len(sorted))
+	for i := targetIndex; i < len(sorted); i++ {
+		rd := sorted[i]
+		if i != targetIndex && (policy == SnoozePolicyOnlyThis || rd.IsCompleted) {
+			continue
+		}
+		m := *rd
+		m.ScheduledDate = calendar.NextAvailableDate(rd.ScheduledDate.AddDate(0, 0, days))
+		after[i] = &m
+		moved[i] = true
+		snoozed = append(snoozed, &m)
+	}
+	for i := targetIndex; i+1 < len(after); i++ {
+		if moved[i] && !after[i].ScheduledDate.Before(after[i+1].ScheduledDate) {
+			return nil, ErrSnoozedReviewDateOutOfOrder
+		}
+	}
+	return snoozed, nil
+}
+
+// 先送りする日数と範囲を検証する
+func ValidateSnooze(days int, policy string) error {
+	if days < 1 || days > MaxSnoozeDays {
+		return ErrInvalidSnoozeDays
+	}
+	if policy != SnoozePolicyOnlyThis && policy != SnoozePolicyShiftLater {
+		return ErrInvalidSnoozePolicy
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package item
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSnoozeReviewdates(t *testing.T) {
+	date := func(day int) time.Time {
+		return time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+	}
+	reviewdates := []*Reviewdate{
+		{ReviewdateID: "rd1", StepNumber: 1, InitialScheduledDate: date(2), ScheduledDate: date(2), IsCompleted: true},
+		{ReviewdateID: "rd2", StepNumber: 2, InitialScheduledDate: date(4), ScheduledDate: date(4)},
+		{ReviewdateID: "rd3", StepNumber: 3, InitialScheduledDate: date(8), ScheduledDate: date(8)},
+		{ReviewdateID: "rd4", StepNumber: 4, InitialScheduledDate: date(15), ScheduledDate: date(15)},
+	}
+
+	tests := []struct {
+		name         string
+		reviewdates  []*Reviewdate
+		reviewdateID string
+		days         int
+		policy       string
+		calendar     IReviewCalendar
+		want         []*Reviewdate
+		wantErr      error
+	}{
+		{
+			name:         "指定した復習日だけ先送りする",
+			reviewdates:  reviewdates,
+			reviewdateID: "rd2",
+			days:         3,
+			policy:       SnoozePolicyOnlyThis,
+			want: []*Reviewdate{
+				{ReviewdateID: "rd2", StepNumber: 2, InitialScheduledDate: date(4), ScheduledDate: date(7)},
+			},
+		},
+		{
+			name:         "以降の未完了の復習日もまとめて先送りする",
+			reviewdates:  reviewdates,
+			reviewdateID: "rd2",
+			days:         5,
+			policy:       SnoozePolicyShiftLater,
+			want: []*Reviewdate{
+				{ReviewdateID: "rd2", StepNumber: 2, InitialScheduledDate: date(4), ScheduledDate: date(9)},
+				{ReviewdateID: "rd3", StepNumber: 3, InitialScheduledDate: date(8), ScheduledDate: date(13)},
+				{ReviewdateID: "rd4", StepNumber: 4, InitialScheduledDate: date(15), ScheduledDate: date(20)},
+			},
+		},
+		{
+			name: "ステップ番号順に並んでいなくても先送りできる",
+			reviewdates: []*Reviewdate{
+				reviewdates[3], reviewdates[2], reviewdates[1], reviewdates[0],
+			},
+			reviewdateID: "rd4",
+			days:         1,
+			policy:       SnoozePolicyOnlyThis,
+			want: []*Reviewdate{
+				{ReviewdateID: "rd4", StepNumber: 4, InitialScheduledDate: date(15), ScheduledDate: date(16)},
+			},
+		},
+		{
+			// 2024-01-06(土曜日)・2024-01-07(日曜日)は休息日
+			name:         "先送りした日が休息日の場合は次の復習できる日までずらす",
+			reviewdates:  reviewdates,
+			reviewdateID: "rd2",
+			days:         2,
+			policy:       SnoozePolicyShiftLater,
+			calendar:     weekendCalendar{},
+			want: []*Reviewdate{
+				{ReviewdateID: "rd2", StepNumber: 2, InitialScheduledDate: date(4), ScheduledDate: date(8)},
+				{ReviewdateID: "rd3", StepNumber: 3, InitialScheduledDate: date(8), ScheduledDate: date(10)},
+				{ReviewdateID: "rd4", StepNumber: 4, InitialScheduledDate: date(15), ScheduledDate: date(17)},
+			},
+		},
+		{
+			name: "休息日を避けると先送りした復習日同士が同じ日になる場合はエラー",
+			reviewdates: []*Reviewdate{
+				{ReviewdateID: "rd1", StepNumber: 1, ScheduledDate: date(5)},
+				{ReviewdateID: "rd2", StepNumber: 2, ScheduledDate: date(6)},
+			},
+			reviewdateID: "rd1",
+			days:         1,
+			policy:       SnoozePolicyShiftLater,
+			calendar:     weekendCalendar{},
+			wantErr:      ErrSnoozedReviewDateOutOfOrder,
+		},
+		{
+			name:         "次のステップと同じ日になる場合はエラー",
+			reviewdates:  reviewdates,
+			reviewdateID: "rd2",
+			days:         4,
+			policy:       SnoozePolicyOnlyThis,
+			wantErr:      ErrSnoozedReviewDateOutOfOrder,
+		},
+		{
+			name: "完了済みの後のステップを追い越す場合はエラー",
+			reviewdates: []*Reviewdate{
+				{ReviewdateID: "rd1", StepNumber: 1, ScheduledDate: date(2)},
+				{ReviewdateID: "rd2", StepNumber: 2, ScheduledDate: date(4), IsCompleted: true},
+				{ReviewdateID: "rd3", StepNumber: 3, ScheduledDate: date(8)},
+			},
+			reviewdateID: "rd1",
+			days:         2,
+			policy:       SnoozePolicyShiftLater,
+			wantErr:      ErrSnoozedReviewDateOutOfOrder,
+		},
+		{
+			name:         "完了済みの復習日はエラー",
+			reviewdates:  reviewdates,
+			reviewdateID: "rd1",
+			days:         1,
+			policy:       SnoozePolicyOnlyThis,
+			wantErr:      ErrSnoozeCompletedReviewDate,
+		},
+		{
+			name:         "存在しない復習日はエラー",
+			reviewdates:  reviewdates,
+			reviewdateID: "unknown",
+			days:         1,
+			policy:       SnoozePolicyOnlyThis,
+			wantErr:      ErrReviewDateNotFound,
+		},
+		{
+			name:         "日数が0の場合はエラー",
+			reviewdates:  reviewdates,
+			reviewdateID: "rd2",
+			days:         0,
+			policy:       SnoozePolicyOnlyThis,
+			wantErr:      ErrInvalidSnoozeDays,
+		},
+		{
+			name:         "日数が上限を超える場合はエラー",
+			reviewdates:  reviewdates,
+			reviewdateID: "rd2",
+			days:         MaxSnoozeDays + 1,
+			policy:       SnoozePolicyShiftLater,
+			wantErr:      ErrInvalidSnoozeDays,
+		},
+		{
+			name:         "範囲が不正な場合はエラー",
+			reviewdates:  reviewdates,
+			reviewdateID: "rd2",
+			days:         1,
+			policy:       "all",
+			wantErr:      ErrInvalidSnoozePolicy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendar := tt.calendar
+			if calendar == nil {
+				calendar = noRestCalendar{}
+			}
+			got, err := SnoozeReviewdates(tt.reviewdates, tt.reviewdateID, tt.days, tt.policy, calendar)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("SnoozeReviewdates() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SnoozeReviewdates() unexpected error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("SnoozeReviewdates() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// 引数の復習日は変更しない
+	if !reviewdates[1].ScheduledDate.Equal(date(4)) {
+		t.Errorf("引数の復習日が変更されています: %v", reviewdates[1].ScheduledDate)
+	}
+}
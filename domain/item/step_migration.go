@@ -0,0 +1,141 @@
+package item
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+// 復習パターンのステップ変更を1つの復習物に反映した結果
+type StepMigration struct {
+	UpdatedReviewdates   []*Reviewdate
+	CreatedReviewdates   []*Reviewdate
+	DeletedReviewdateIDs []string
+	IsFinished           bool // 反映後に未完了の復習日が残らない場合true
+}
+
+// 変更があったかどうか
+func (m *StepMigration) IsChanged() bool {
+	return len(m.UpdatedReviewdates) > 0 || len(m.CreatedReviewdates) > 0 || len(m.DeletedReviewdateIDs) > 0 || m.IsFinished
+}
+
+// 復習パターンの新しいステップを、復習物の未完了かつ今日以降の復習日にだけ反映する。
+// 完了済みの復習日と期限切れの復習日はそのまま残し、残した中で最後のステップの復習日（なければ学習日）を起点に新しい間隔で計算し直す。
+// 計算し直した復習日が今日より前なら今日にし、休息日なら休息日でない次の日にする。
+// 1日の最大復習数に達している日になった場合は、新規作成時と同じく間隔の±10%以内で復習数が最も少ない日へずらす。
+// loadにはこの復習物の復習日も含めた日毎の復習数を渡す（計算し直す前の復習日の分はここで差し引く）。
+// ステップが増えた場合は復習日を追加し、減った場合は新しいステップ数を超える今日以降の未完了の復習日を削除する。
+func MigrateReviewdatesToSteps(
+	item *Item,
+	currentReviewdates []*Reviewdate,
+	newSteps []*PatternDomain.PatternStep,
+	today time.Time,
+	calendar IReviewCalendar,
+	load *ReviewLoad,
+) (*StepMigration, error) {
+	reviewdates := make([]*Reviewdate, len(currentReviewdates))
+	copy(reviewdates, currentReviewdates)
+	sort.Slice(reviewdates, func(i, j int) bool {
+		return reviewdates[i].StepNumber < reviewdates[j].StepNumber
+	})
+
+	// 残す復習日（完了済み・期限切れ）と、新しい間隔を反映する復習日（未完了かつ今日以降）に分ける
+	anchorStep := 0
+	anchorDate := item.LearnedDate
+	hasIncompleteKept := false
+	futureByStep := make(map[int]*Reviewdate)
+	for _, rd := range reviewdates {
+		if rd.IsCompleted || rd.ScheduledDate.Before(today) {
+			anchorStep = rd.StepNumber
+			anchorDate = rd.ScheduledDate
+			if !rd.IsCompleted {
+				hasIncompleteKept = true
+			}
+			continue
+		}
+		futureByStep[rd.StepNumber] = rd
+		// 計算し直す前の復習日は日毎の復習数から除き、計算し直した日で数え直す
+		load.Remove(rd.ScheduledDate)
+	}
+
+	anchorInterval := 0
+	for _, step := range newSteps {
+		if step.StepNumber == anchorStep {
+			anchorInterval = step.IntervalDays
+		}
+	}
+
+	migration := &StepMigration{}
+	hasIncompleteFuture := false
+	prev := anchorDate
+	for _, step := range newSteps {
+		// 残す復習日より前のステップは計算し直さない（手動で後ろにずらした復習日などはそのまま残す）
+		if step.StepNumber <= anchorStep {
+			if rd, ok := futureByStep[step.StepNumber]; ok {
+				hasIncompleteFuture = true
+				load.Add(rd.ScheduledDate)
+				delete(futureByStep, step.StepNumber)
+			}
+			continue
+		}
+		scheduledDate := anchorDate.AddDate(0, 0, step.IntervalDays-anchorInterval)
+		if scheduledDate.Before(today) {
+			scheduledDate = today
+		}
+		scheduledDate = load.leastLoadedDate(calendar.NextAvailableDate(scheduledDate), prev, calendar)
+		load.Add(scheduledDate)
+		prev = scheduledDate
+		hasIncompleteFuture = true
+
+		current, ok := futureByStep[step.StepNumber]
+		if !ok {
+			reviewdate, err := NewReviewdate(
+				uuid.NewString(),
+				item.UserID,
+				item.CategoryID,
+				item.BoxID,
+				item.ItemID,
+				step.StepNumber,
+				scheduledDate,
+				scheduledDate,
+				false,
+			)
+			if err != nil {
+				return nil, err
+			}
+			migration.CreatedReviewdates = append(migration.CreatedReviewdates, reviewdate)
+			continue
+		}
+		delete(futureByStep, step.StepNumber)
+		if current.ScheduledDate.Equal(scheduledDate) && current.InitialScheduledDate.Equal(scheduledDate) {
+			continue
+		}
+		reviewdate, err := NewReviewdate(
+			current.ReviewdateID,
+			current.UserID,
+			current.CategoryID,
+			current.BoxID,
+			current.ItemID,
+			current.StepNumber,
+			scheduledDate,
+			scheduledDate,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		migration.UpdatedReviewdates = append(migration.UpdatedReviewdates, reviewdate)
+	}
+
+	// 新しいステップに対応しない今日以降の未完了の復習日は削除する
+	for _, rd := range reviewdates {
+		if _, ok := futureByStep[rd.StepNumber]; ok {
+			migration.DeletedReviewdateIDs = append(migration.DeletedReviewdateIDs, rd.ReviewdateID)
+		}
+	}
+
+	migration.IsFinished = !hasIncompleteKept && !hasIncompleteFuture
+	return migration, nil
+}
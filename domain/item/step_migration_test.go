@@ -0,0 +1,211 @@
+package item
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+)
+
+// 休息日のないテスト用カレンダー
+type noRestCalendar struct{}
+
+func (noRestCalendar) NextAvailableDate(date time.Time) time.Time {
+	return date
+}
+
+func TestMigrateReviewdatesToSteps(t *testing.T) {
+	categoryID := "category123"
+	boxID := "box123"
+	day := func(d int) time.Time {
+		return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+	// 学習日は2024-01-01(月曜日)
+	item := &Item{
+		ItemID:      "item123",
+		UserID:      "user123",
+		CategoryID:  &categoryID,
+		BoxID:       &boxID,
+		LearnedDate: day(1),
+	}
+	reviewdate := func(id string, step int, scheduled time.Time, isCompleted bool) *Reviewdate {
+		return &Reviewdate{
+			ReviewdateID:         id,
+			UserID:               "user123",
+			CategoryID:           &categoryID,
+			BoxID:                &boxID,
+			ItemID:               "item123",
+			StepNumber:           step,
+			InitialScheduledDate: scheduled,
+			ScheduledDate:        scheduled,
+			IsCompleted:          isCompleted,
+		}
+	}
+	// 変更前は1日後、3日後、7日後の3ステップ
+	currentReviewdates := []*Reviewdate{
+		reviewdate("rd1", 1, day(2), true),
+		reviewdate("rd2", 2, day(4), false),
+		reviewdate("rd3", 3, day(8), false),
+	}
+
+	type want struct {
+		updated    map[int]time.Time // ステップ番号→復習日
+		created    map[int]time.Time
+		deletedIDs []string
+		isFinished bool
+	}
+
+	tests := []struct {
+		name     string
+		steps    []*PatternDomain.PatternStep
+		today    time.Time
+		calendar IReviewCalendar
+		load     *ReviewLoad // nilの場合は上限なし
+		want     want
+	}{
+		{
+			name: "完了済みの復習日を起点に今日以降の復習日を計算し直す",
+			steps: []*PatternDomain.PatternStep{
+				{StepNumber: 1, IntervalDays: 1},
+				{StepNumber: 2, IntervalDays: 2},
+				{StepNumber: 3, IntervalDays: 10},
+			},
+			today:    day(3),
+			calendar: noRestCalendar{},
+			want: want{
+				// 起点はステップ1の2024-01-02。ステップ2は+1日、ステップ3は+9日
+				updated: map[int]time.Time{2: day(3), 3: day(11)},
+			},
+		},
+		{
+			name: "計算し直した復習日が休息日なら次の日にする",
+			steps: []*PatternDomain.PatternStep{
+				{StepNumber: 1, IntervalDays: 1},
+				{StepNumber: 2, IntervalDays: 5},
+				{StepNumber: 3, IntervalDays: 7},
+			},
+			today:    day(3),
+			calendar: weekendCalendar{},
+			want: want{
+				// ステップ2の2024-01-06は土曜日なので2024-01-08(月曜日)へ
+				updated: map[int]time.Time{2: day(8)},
+			},
+		},
+		{
+			name: "計算し直した復習日が1日の上限に達していれば間隔の±10%以内で復習数が少ない日にずらす",
+			steps: []*PatternDomain.PatternStep{
+				{StepNumber: 1, IntervalDays: 1},
+				{StepNumber: 2, IntervalDays: 3},
+				{StepNumber: 3, IntervalDays: 21},
+			},
+			today:    day(3),
+			calendar: noRestCalendar{},
+			// 2024-01-22は上限に達しているが、変更前のステップ3の復習日(2024-01-08)の分は差し引かれる
+			load: NewReviewLoad(2, map[string]int{"2024-01-08": 2, "2024-01-22": 2, "2024-01-21": 1}),
+			want: want{
+				// ステップ3は起点の2024-01-02から+20日の2024-01-22。ステップ2(2024-01-04)からの間隔18日の10%で±2日以内のうち、
+				// 復習数が0で元の日に近い2024-01-23へ
+				updated: map[int]time.Time{3: day(23)},
+			},
+		},
+		{
+			name: "ステップが増えた場合は復習日を追加する",
+			steps: []*PatternDomain.PatternStep{
+				{StepNumber: 1, IntervalDays: 1},
+				{StepNumber: 2, IntervalDays: 3},
+				{StepNumber: 3, IntervalDays: 7},
+				{StepNumber: 4, IntervalDays: 14},
+			},
+			today:    day(3),
+			calendar: noRestCalendar{},
+			want: want{
+				created: map[int]time.Time{4: day(15)},
+			},
+		},
+		{
+			name: "ステップが減った場合は対応しない今日以降の未完了の復習日を削除する",
+			steps: []*PatternDomain.PatternStep{
+				{StepNumber: 1, IntervalDays: 1},
+				{StepNumber: 2, IntervalDays: 3},
+			},
+			today:    day(3),
+			calendar: noRestCalendar{},
+			want: want{
+				deletedIDs: []string{"rd3"},
+			},
+		},
+		{
+			name: "完了済みの復習日だけが残る場合は復習物を完了にする",
+			steps: []*PatternDomain.PatternStep{
+				{StepNumber: 1, IntervalDays: 1},
+			},
+			today:    day(3),
+			calendar: noRestCalendar{},
+			want: want{
+				deletedIDs: []string{"rd2", "rd3"},
+				isFinished: true,
+			},
+		},
+		{
+			name: "期限切れの未完了の復習日は残して起点にする",
+			steps: []*PatternDomain.PatternStep{
+				{StepNumber: 1, IntervalDays: 1},
+				{StepNumber: 2, IntervalDays: 3},
+				{StepNumber: 3, IntervalDays: 5},
+			},
+			today:    day(5),
+			calendar: noRestCalendar{},
+			want: want{
+				// 起点はステップ2の2024-01-04(期限切れ)。ステップ3は+2日
+				updated: map[int]time.Time{3: day(6)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			load := tc.load
+			if load == nil {
+				load = NewReviewLoad(0, nil)
+			}
+			got, err := MigrateReviewdatesToSteps(item, currentReviewdates, tc.steps, tc.today, tc.calendar, load)
+			if err != nil {
+				t.Fatalf("MigrateReviewdatesToSteps() unexpected error = %v", err)
+			}
+
+			gotUpdated := make(map[int]time.Time)
+			for _, rd := range got.UpdatedReviewdates {
+				gotUpdated[rd.StepNumber] = rd.ScheduledDate
+			}
+			gotCreated := make(map[int]time.Time)
+			for _, rd := range got.CreatedReviewdates {
+				gotCreated[rd.StepNumber] = rd.ScheduledDate
+			}
+			wantUpdated := tc.want.updated
+			if wantUpdated == nil {
+				wantUpdated = map[int]time.Time{}
+			}
+			wantCreated := tc.want.created
+			if wantCreated == nil {
+				wantCreated = map[int]time.Time{}
+			}
+
+			if diff := cmp.Diff(wantUpdated, gotUpdated); diff != "" {
+				t.Errorf("UpdatedReviewdates mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(wantCreated, gotCreated); diff != "" {
+				t.Errorf("CreatedReviewdates mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deletedIDs, got.DeletedReviewdateIDs); diff != "" {
+				t.Errorf("DeletedReviewdateIDs mismatch (-want +got):\n%s", diff)
+			}
+			if got.IsFinished != tc.want.isFinished {
+				t.Errorf("IsFinished = %v, want %v", got.IsFinished, tc.want.isFinished)
+			}
+		})
+	}
+}
@@ -6,5 +6,8 @@ var (
 	ErrNoDiff                     = errors.New("変更点がありません")
 	ErrPatternNotFound            = errors.New("復習パターンが存在しません")
 	ErrPatternRelatedToItemDelete = errors.New("この復習パターンは復習物に紐づいているため削除できません")
-	ErrPatternRelatedToItemUpdate = errors.New("この復習パターンは復習物に紐づいているため変更できません")
+	ErrInvalidStepMigration       = errors.New("既存の復習物の扱い方はapply_to_futureかkeep_existingで指定してください")
+	ErrStepMigrationNotSupported  = errors.New("apply_to_futureは間隔の揺らぎがない固定ステップ方式の復習パターンでのみ指定できます")
+	ErrInvalidStepMigrationToday  = errors.New("apply_to_futureを指定する場合は今日の日付をYYYY-MM-DD形式で指定してください")
+	ErrPatternPresetNotFound      = errors.New("指定された組み込みの復習パターンは存在しません")
 )
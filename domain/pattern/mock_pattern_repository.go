@@ -157,6 +157,21 @@ func (mr *MockIPatternRepositoryMockRecorder) GetAllPatternsByUserID(ctx, userID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPatternsByUserID", reflect.TypeOf((*MockIPatternRepository)(nil).GetAllPatternsByUserID), ctx, userID)
 }
 
+// GetPatternStepsByPatternVersion mocks base method.
+func (m *MockIPatternRepository) GetPatternStepsByPatternVersion(ctx context.Context, patternID string, version int, userID string) ([]*PatternStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPatternStepsByPatternVersion", ctx, patternID, version, userID)
+	ret0, _ := ret[0].([]*PatternStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatternStepsByPatternVersion indicates an expected call of GetPatternStepsByPatternVersion.
+func (mr *MockIPatternRepositoryMockRecorder) GetPatternStepsByPatternVersion(ctx, patternID, version, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatternStepsByPatternVersion", reflect.TypeOf((*MockIPatternRepository)(nil).GetPatternStepsByPatternVersion), ctx, patternID, version, userID)
+}
+
 // GetPatternTargetWeightsByPatternIDs mocks base method.
 func (m *MockIPatternRepository) GetPatternTargetWeightsByPatternIDs(ctx context.Context, patternIDs []string) ([]*TargetWeight, error) {
 	m.ctrl.T.Helper()
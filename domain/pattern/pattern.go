@@ -8,12 +8,19 @@ import (
 )
 
 type Pattern struct {
-	PatternID    string
-	UserID       string
-	Name         string
-	TargetWeight string
-	RegisteredAt time.Time
-	EditedAt     time.Time
+	PatternID              string
+	UserID                 string
+	Name                   string
+	TargetWeight           string
+	SchedulerKind          string
+	TargetRetention        float64 // FSRS方式でのみ使う目標記憶保持率
+	IntervalFuzz           bool    // 復習物IDをシードにした揺らぎを復習日間隔に加えるかどうか
+	IntervalFromCompletion bool    // 各ステップの間隔を直前の復習を実際に完了した日から数えるかどうか
+	OverduePolicy          string  // 期限切れの復習日をバッチでどう扱うか
+	OverdueSpreadDays      int     // OverduePolicyがspreadの場合に、期限切れの復習物を振り分ける日数
+	Version                int     // 復習物が紐づいている状態でステップを変更する度に上がるバージョン
+	RegisteredAt           time.Time
+	EditedAt               time.Time
 }
 
 func NewPattern(
@@ -21,6 +28,12 @@ func NewPattern(
 	userID string,
 	name string,
 	targetWeight string,
+	schedulerKind string,
+	targetRetention float64,
+	intervalFuzz bool,
+	intervalFromCompletion bool,
+	overduePolicy string,
+	overdueSpreadDays int,
 	registeredAt time.Time,
 	editedAt time.Time,
 ) (*Pattern, error) {
@@ -31,13 +44,32 @@ func NewPattern(
 	if err := validateTargetWeight(string(targetWeight)); err != nil {
 		return nil, err
 	}
+	if err := validateSchedulerKind(schedulerKind); err != nil {
+		return nil, err
+	}
+	if err := validateTargetRetention(targetRetention); err != nil {
+		return nil, err
+	}
+	if err := validateOverduePolicy(overduePolicy); err != nil {
+		return nil, err
+	}
+	if err := validateOverdueSpreadDays(overdueSpreadDays); err != nil {
+		return nil, err
+	}
 	p := &Pattern{
-		PatternID:    patternID,
-		UserID:       userID,
-		Name:         name,
-		TargetWeight: targetWeight,
-		RegisteredAt: registeredAt,
-		EditedAt:     editedAt,
+		PatternID:              patternID,
+		UserID:                 userID,
+		Name:                   name,
+		TargetWeight:           targetWeight,
+		SchedulerKind:          schedulerKind,
+		TargetRetention:        targetRetention,
+		IntervalFuzz:           intervalFuzz,
+		IntervalFromCompletion: intervalFromCompletion,
+		OverduePolicy:          overduePolicy,
+		OverdueSpreadDays:      overdueSpreadDays,
+		Version:                InitialPatternVersion,
+		RegisteredAt:           registeredAt,
+		EditedAt:               editedAt,
 	}
 	return p, nil
 }
@@ -47,16 +79,30 @@ func ReconstructPattern(
 	userID string,
 	name string,
 	targetWeight string,
+	schedulerKind string,
+	targetRetention float64,
+	intervalFuzz bool,
+	intervalFromCompletion bool,
+	overduePolicy string,
+	overdueSpreadDays int,
+	version int,
 	registeredAt time.Time,
 	editedAt time.Time,
 ) (*Pattern, error) {
 	p := &Pattern{
-		PatternID:    patternID,
-		UserID:       userID,
-		Name:         name,
-		TargetWeight: targetWeight,
-		RegisteredAt: registeredAt,
-		EditedAt:     editedAt,
+		PatternID:              patternID,
+		UserID:                 userID,
+		Name:                   name,
+		TargetWeight:           targetWeight,
+		SchedulerKind:          schedulerKind,
+		TargetRetention:        targetRetention,
+		IntervalFuzz:           intervalFuzz,
+		IntervalFromCompletion: intervalFromCompletion,
+		OverduePolicy:          overduePolicy,
+		OverdueSpreadDays:      overdueSpreadDays,
+		Version:                version,
+		RegisteredAt:           registeredAt,
+		EditedAt:               editedAt,
 	}
 	return p, nil
 }
@@ -66,6 +112,35 @@ const (
 	TargetWeightNormal string = "normal"
 	TargetWeightLight  string = "light"
 	TargetWeightUnset  string = "unset"
+
+	// スケジューリング方式
+	SchedulerKindFixedSteps string = "fixed_steps" // pattern_stepsの間隔通りに復習日を決める
+	SchedulerKindAdaptive   string = "adaptive"    // 想起度（SM-2）に応じて残りの復習日を伸縮させる
+	SchedulerKindFSRS       string = "fsrs"        // 記憶の安定度と難しさから目標記憶保持率を下回る日を復習日にする
+	SchedulerKindLeitner    string = "leitner"     // 想起に失敗したら失敗した日から最初のステップに戻してやり直す
+
+	// FSRS方式の目標記憶保持率
+	DefaultTargetRetention = 0.9
+	MinTargetRetention     = 0.7
+	MaxTargetRetention     = 0.97
+
+	// 期限切れの復習日の扱い方
+	OverduePolicySlideAll     string = "slide_all"     // 期限切れの復習日以降を全て今日に合わせてずらす
+	OverduePolicySlideOverdue string = "slide_overdue" // 期限切れの復習日だけを今日にずらす
+	OverduePolicyKeep         string = "keep"          // ずらさずに期限切れのまま残す
+	OverduePolicySpread       string = "spread"        // 溜まった期限切れの復習物を今日からN日間に振り分けてずらす
+
+	// spreadで振り分ける日数
+	DefaultOverdueSpreadDays = 7
+	MinOverdueSpreadDays     = 1
+	MaxOverdueSpreadDays     = 30
+
+	// 復習物が紐づいているパターンのステップを変更する時の、既存の復習物の扱い方
+	StepMigrationApplyToFuture string = "apply_to_future" // 未完了かつ今日以降の復習日に新しい間隔を反映する
+	StepMigrationKeepExisting  string = "keep_existing"   // 既存の復習物の復習日はそのまま残す
+
+	// 復習パターン作成時のバージョン
+	InitialPatternVersion = 1
 )
 
 var allowedTargetWeights = map[string]struct{}{
@@ -75,6 +150,20 @@ var allowedTargetWeights = map[string]struct{}{
 	TargetWeightUnset:  {},
 }
 
+var allowedSchedulerKinds = map[string]struct{}{
+	SchedulerKindFixedSteps: {},
+	SchedulerKindAdaptive:   {},
+	SchedulerKindFSRS:       {},
+	SchedulerKindLeitner:    {},
+}
+
+var allowedOverduePolicies = map[string]struct{}{
+	OverduePolicySlideAll:     {},
+	OverduePolicySlideOverdue: {},
+	OverduePolicyKeep:         {},
+	OverduePolicySpread:       {},
+}
+
 func validateName(name string) error {
 	return validation.Validate(
 		name,
@@ -94,10 +183,58 @@ func validateTargetWeight(targetWeight string) error {
 		}),
 	)
 }
+func validateSchedulerKind(schedulerKind string) error {
+	return validation.Validate(
+		schedulerKind,
+		validation.Required.Error("スケジューリング方式は必須です"),
+		validation.By(func(value interface{}) error {
+			kind, _ := value.(string)
+			if _, ok := allowedSchedulerKinds[kind]; !ok {
+				return errors.New("スケジューリング方式の値が不正です")
+			}
+			return nil
+		}),
+	)
+}
+func validateTargetRetention(targetRetention float64) error {
+	return validation.Validate(
+		targetRetention,
+		validation.Required.Error("目標記憶保持率は必須です"),
+		validation.Min(MinTargetRetention).Error("目標記憶保持率は0.7以上で指定してください"),
+		validation.Max(MaxTargetRetention).Error("目標記憶保持率は0.97以下で指定してください"),
+	)
+}
+func validateOverduePolicy(overduePolicy string) error {
+	return validation.Validate(
+		overduePolicy,
+		validation.Required.Error("期限切れの復習日の扱い方は必須です"),
+		validation.By(func(value interface{}) error {
+			policy, _ := value.(string)
+			if _, ok := allowedOverduePolicies[policy]; !ok {
+				return errors.New("期限切れの復習日の扱い方の値が不正です")
+			}
+			return nil
+		}),
+	)
+}
+func validateOverdueSpreadDays(overdueSpreadDays int) error {
+	return validation.Validate(
+		overdueSpreadDays,
+		validation.Required.Error("期限切れの復習物を振り分ける日数は必須です"),
+		validation.Min(MinOverdueSpreadDays).Error("期限切れの復習物を振り分ける日数は1以上で指定してください"),
+		validation.Max(MaxOverdueSpreadDays).Error("期限切れの復習物を振り分ける日数は30以下で指定してください"),
+	)
+}
 
 func (p *Pattern) Set(
 	name string,
 	targetWeight string,
+	schedulerKind string,
+	targetRetention float64,
+	intervalFuzz bool,
+	intervalFromCompletion bool,
+	overduePolicy string,
+	overdueSpreadDays int,
 	editedAt time.Time,
 ) error {
 	if err := validateName(name); err != nil {
@@ -106,20 +243,45 @@ func (p *Pattern) Set(
 	if err := validateTargetWeight(string(targetWeight)); err != nil {
 		return err
 	}
+	if err := validateSchedulerKind(schedulerKind); err != nil {
+		return err
+	}
+	if err := validateTargetRetention(targetRetention); err != nil {
+		return err
+	}
+	if err := validateOverduePolicy(overduePolicy); err != nil {
+		return err
+	}
+	if err := validateOverdueSpreadDays(overdueSpreadDays); err != nil {
+		return err
+	}
 
 	p.Name = name
 	p.TargetWeight = targetWeight
+	p.SchedulerKind = schedulerKind
+	p.TargetRetention = targetRetention
+	p.IntervalFuzz = intervalFuzz
+	p.IntervalFromCompletion = intervalFromCompletion
+	p.OverduePolicy = overduePolicy
+	p.OverdueSpreadDays = overdueSpreadDays
 	p.EditedAt = editedAt
 
 	return nil
 }
 
+// 既存の復習物が古いステップを参照し続けられるように、ステップを変更する時は新しいバージョンに上げる
+func (p *Pattern) BumpVersion(editedAt time.Time) {
+	p.Version++
+	p.EditedAt = editedAt
+}
+
 type PatternStep struct {
 	PatternStepID string
 	UserID        string
 	PatternID     string
 	StepNumber    int
 	IntervalDays  int
+	Version       int // このステップが属する復習パターンのバージョン
 }
 
 func NewPatternStep(
@@ -128,6 +290,7 @@ func NewPatternStep(
 	patternID string,
 	stepNumber int,
 	intervalDays int,
+	version int,
 ) (*PatternStep, error) {
 	if err := validateStepNumber(stepNumber); err != nil {
 		return nil, err
@@ -141,6 +304,7 @@ func NewPatternStep(
 		PatternID:     patternID,
 		StepNumber:    stepNumber,
 		IntervalDays:  intervalDays,
+		Version:       version,
 	}
 
 	return ps, nil
@@ -152,6 +316,7 @@ func ReconstructPatternStep(
 	patternID string,
 	stepNumber int,
 	intervalDays int,
+	version int,
 ) (*PatternStep, error) {
 	ps := &PatternStep{
 		PatternStepID: patternStepID,
@@ -159,6 +324,7 @@ func ReconstructPatternStep(
 		PatternID:     patternID,
 		StepNumber:    stepNumber,
 		IntervalDays:  intervalDays,
+		Version:       version,
 	}
 	return ps, nil
 }
@@ -217,3 +383,11 @@ func ValidateSteps(steps []*PatternStep) error {
 	}
 	return nil
 }
+
+// ステップが属する復習パターンのバージョンを返す（ステップがない場合は0）
+func StepsVersion(steps []*PatternStep) int {
+	if len(steps) == 0 {
+		return 0
+	}
+	return steps[0].Version
+}
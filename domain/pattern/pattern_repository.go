@@ -24,6 +24,8 @@ type IPatternRepository interface {
 	// ボックス一覧取得→ボックス毎にループ処理（Patternを取得→PatternStepたちを取得）
 	FindPatternByPatternID(ctx context.Context, patternID string, userID string) (*Pattern, error)
 	GetAllPatternStepsByPatternID(ctx context.Context, patternID string, userID string) ([]*PatternStep, error)
+	// 復習物が記録しているバージョンのステップを取得する（GetAllPatternStepsByPatternIDは最新バージョンのステップを返す）
+	GetPatternStepsByPatternVersion(ctx context.Context, patternID string, version int, userID string) ([]*PatternStep, error)
 
 	// item_usecaseで使う。パターンIDからパターン名を取得する
 	GetPatternTargetWeightsByPatternIDs(ctx context.Context, patternIDs []string) ([]*TargetWeight, error)
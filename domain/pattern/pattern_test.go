@@ -20,113 +20,382 @@ func TestNewPattern(t *testing.T) {
 	now := time.Now()
 
 	tests := []struct {
-		name         string
-		patternID    string
-		userID       string
-		patternName  string
-		targetWeight string
-		registeredAt time.Time
-		editedAt     time.Time
-		want         *Pattern
-		wantErr      bool
-		errMsg       string
+		name                   string
+		patternID              string
+		userID                 string
+		patternName            string
+		targetWeight           string
+		schedulerKind          string
+		targetRetention        float64
+		intervalFuzz           bool
+		intervalFromCompletion bool
+		overduePolicy          string
+		overdueSpreadDays      int
+		registeredAt           time.Time
+		editedAt               time.Time
+		want                   *Pattern
+		wantErr                bool
+		errMsg                 string
 	}{
 		{
-			name:         "有効なパターン（正常系）",
-			patternID:    testPatternID,
-			userID:       testUserID,
-			patternName:  "Standard Review",
-			targetWeight: TargetWeightNormal,
-			registeredAt: now,
-			editedAt:     now,
+			name:              "有効なパターン（正常系）",
+			patternID:         testPatternID,
+			userID:            testUserID,
+			patternName:       "Standard Review",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
 			want: &Pattern{
-				PatternID:    testPatternID,
-				UserID:       testUserID,
-				Name:         "Standard Review",
-				TargetWeight: TargetWeightNormal,
-				RegisteredAt: now,
-				EditedAt:     now,
+				PatternID:         testPatternID,
+				UserID:            testUserID,
+				Name:              "Standard Review",
+				TargetWeight:      TargetWeightNormal,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          now,
 			},
 			wantErr: false,
 		},
 		{
-			name:         "パターン名が空（異常系）",
-			patternID:    "pattern2",
-			userID:       testUserID,
-			patternName:  "",
-			targetWeight: TargetWeightNormal,
-			registeredAt: now,
-			editedAt:     now,
-			want:         nil,
-			wantErr:      true,
-			errMsg:       "名前は必須です",
-		},
-		{
-			name:         "重みが不正（異常系）",
-			patternID:    "pattern3",
-			userID:       testUserID,
-			patternName:  "Test Pattern",
-			targetWeight: "invalid",
-			registeredAt: now,
-			editedAt:     now,
-			want:         nil,
-			wantErr:      true,
-			errMsg:       "重みの値が不正です",
-		},
-		{
-			name:         "重みがHeavy（正常系）",
-			patternID:    "pattern4",
-			userID:       testUserID,
-			patternName:  "Heavy Pattern",
-			targetWeight: TargetWeightHeavy,
-			registeredAt: now,
-			editedAt:     now,
+			name:              "間隔の揺らぎを有効にしたパターン（正常系）",
+			patternID:         testPatternID,
+			userID:            testUserID,
+			patternName:       "Fuzzed Review",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			intervalFuzz:      true,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
 			want: &Pattern{
-				PatternID:    "pattern4",
-				UserID:       testUserID,
-				Name:         "Heavy Pattern",
-				TargetWeight: TargetWeightHeavy,
-				RegisteredAt: now,
-				EditedAt:     now,
+				PatternID:         testPatternID,
+				UserID:            testUserID,
+				Name:              "Fuzzed Review",
+				TargetWeight:      TargetWeightNormal,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				IntervalFuzz:      true,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          now,
 			},
 			wantErr: false,
 		},
 		{
-			name:         "重みがLight（正常系）",
-			patternID:    "pattern5",
-			userID:       testUserID,
-			patternName:  "Light Pattern",
-			targetWeight: TargetWeightLight,
-			registeredAt: now,
-			editedAt:     now,
+			name:                   "間隔の起点を完了日にしたパターン（正常系）",
+			patternID:              testPatternID,
+			userID:                 testUserID,
+			patternName:            "Completion Anchored Review",
+			targetWeight:           TargetWeightNormal,
+			schedulerKind:          SchedulerKindFixedSteps,
+			targetRetention:        DefaultTargetRetention,
+			intervalFromCompletion: true,
+			overduePolicy:          OverduePolicySlideAll,
+			overdueSpreadDays:      DefaultOverdueSpreadDays,
+			registeredAt:           now,
+			editedAt:               now,
 			want: &Pattern{
-				PatternID:    "pattern5",
-				UserID:       testUserID,
-				Name:         "Light Pattern",
-				TargetWeight: TargetWeightLight,
-				RegisteredAt: now,
-				EditedAt:     now,
+				PatternID:              testPatternID,
+				UserID:                 testUserID,
+				Name:                   "Completion Anchored Review",
+				TargetWeight:           TargetWeightNormal,
+				SchedulerKind:          SchedulerKindFixedSteps,
+				TargetRetention:        DefaultTargetRetention,
+				IntervalFromCompletion: true,
+				OverduePolicy:          OverduePolicySlideAll,
+				OverdueSpreadDays:      DefaultOverdueSpreadDays,
+				Version:                InitialPatternVersion,
+				RegisteredAt:           now,
+				EditedAt:               now,
 			},
 			wantErr: false,
 		},
 		{
-			name:         "重みがUnset（正常系）",
-			patternID:    "pattern6",
-			userID:       testUserID,
-			patternName:  "Unset Pattern",
-			targetWeight: TargetWeightUnset,
-			registeredAt: now,
-			editedAt:     now,
+			name:              "パターン名が空（異常系）",
+			patternID:         "pattern2",
+			userID:            testUserID,
+			patternName:       "",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want:              nil,
+			wantErr:           true,
+			errMsg:            "名前は必須です",
+		},
+		{
+			name:              "重みが不正（異常系）",
+			patternID:         "pattern3",
+			userID:            testUserID,
+			patternName:       "Test Pattern",
+			targetWeight:      "invalid",
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want:              nil,
+			wantErr:           true,
+			errMsg:            "重みの値が不正です",
+		},
+		{
+			name:              "重みがHeavy（正常系）",
+			patternID:         "pattern4",
+			userID:            testUserID,
+			patternName:       "Heavy Pattern",
+			targetWeight:      TargetWeightHeavy,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want: &Pattern{
+				PatternID:         "pattern4",
+				UserID:            testUserID,
+				Name:              "Heavy Pattern",
+				TargetWeight:      TargetWeightHeavy,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          now,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "重みがLight（正常系）",
+			patternID:         "pattern5",
+			userID:            testUserID,
+			patternName:       "Light Pattern",
+			targetWeight:      TargetWeightLight,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want: &Pattern{
+				PatternID:         "pattern5",
+				UserID:            testUserID,
+				Name:              "Light Pattern",
+				TargetWeight:      TargetWeightLight,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          now,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "重みがUnset（正常系）",
+			patternID:         "pattern6",
+			userID:            testUserID,
+			patternName:       "Unset Pattern",
+			targetWeight:      TargetWeightUnset,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want: &Pattern{
+				PatternID:         "pattern6",
+				UserID:            testUserID,
+				Name:              "Unset Pattern",
+				TargetWeight:      TargetWeightUnset,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          now,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "スケジューリング方式が適応型（正常系）",
+			patternID:         "pattern7",
+			userID:            testUserID,
+			patternName:       "Adaptive Pattern",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindAdaptive,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want: &Pattern{
+				PatternID:         "pattern7",
+				UserID:            testUserID,
+				Name:              "Adaptive Pattern",
+				TargetWeight:      TargetWeightNormal,
+				SchedulerKind:     SchedulerKindAdaptive,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          now,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "スケジューリング方式が不正（異常系）",
+			patternID:         "pattern8",
+			userID:            testUserID,
+			patternName:       "Test Pattern",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     "invalid",
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want:              nil,
+			wantErr:           true,
+			errMsg:            "スケジューリング方式の値が不正です",
+		},
+		{
+			name:              "スケジューリング方式がFSRS（正常系）",
+			patternID:         "pattern9",
+			userID:            testUserID,
+			patternName:       "FSRS Pattern",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFSRS,
+			targetRetention:   0.85,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want: &Pattern{
+				PatternID:         "pattern9",
+				UserID:            testUserID,
+				Name:              "FSRS Pattern",
+				TargetWeight:      TargetWeightNormal,
+				SchedulerKind:     SchedulerKindFSRS,
+				TargetRetention:   0.85,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          now,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "目標記憶保持率が下限未満（異常系）",
+			patternID:         "pattern10",
+			userID:            testUserID,
+			patternName:       "FSRS Pattern",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFSRS,
+			targetRetention:   0.5,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want:              nil,
+			wantErr:           true,
+			errMsg:            "目標記憶保持率は0.7以上で指定してください",
+		},
+		{
+			name:              "目標記憶保持率が上限超過（異常系）",
+			patternID:         "pattern11",
+			userID:            testUserID,
+			patternName:       "FSRS Pattern",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFSRS,
+			targetRetention:   0.99,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want:              nil,
+			wantErr:           true,
+			errMsg:            "目標記憶保持率は0.97以下で指定してください",
+		},
+		{
+			name:              "期限切れの復習物を振り分けるパターン（正常系）",
+			patternID:         testPatternID,
+			userID:            testUserID,
+			patternName:       "Spread Review",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySpread,
+			overdueSpreadDays: 14,
+			registeredAt:      now,
+			editedAt:          now,
 			want: &Pattern{
-				PatternID:    "pattern6",
-				UserID:       testUserID,
-				Name:         "Unset Pattern",
-				TargetWeight: TargetWeightUnset,
-				RegisteredAt: now,
-				EditedAt:     now,
+				PatternID:         testPatternID,
+				UserID:            testUserID,
+				Name:              "Spread Review",
+				TargetWeight:      TargetWeightNormal,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicySpread,
+				OverdueSpreadDays: 14,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          now,
 			},
 			wantErr: false,
 		},
+		{
+			name:              "期限切れの復習日の扱い方が不正（異常系）",
+			patternID:         testPatternID,
+			userID:            testUserID,
+			patternName:       "Test Pattern",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     "invalid",
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			registeredAt:      now,
+			editedAt:          now,
+			want:              nil,
+			wantErr:           true,
+			errMsg:            "期限切れの復習日の扱い方の値が不正です",
+		},
+		{
+			name:              "振り分ける日数が上限超過（異常系）",
+			patternID:         testPatternID,
+			userID:            testUserID,
+			patternName:       "Test Pattern",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySpread,
+			overdueSpreadDays: 31,
+			registeredAt:      now,
+			editedAt:          now,
+			want:              nil,
+			wantErr:           true,
+			errMsg:            "期限切れの復習物を振り分ける日数は30以下で指定してください",
+		},
 	}
 
 	for _, tc := range tests {
@@ -134,7 +403,7 @@ func TestNewPattern(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			pattern, err := NewPattern(tc.patternID, tc.userID, tc.patternName, tc.targetWeight, tc.registeredAt, tc.editedAt)
+			pattern, err := NewPattern(tc.patternID, tc.userID, tc.patternName, tc.targetWeight, tc.schedulerKind, tc.targetRetention, tc.intervalFuzz, tc.intervalFromCompletion, tc.overduePolicy, tc.overdueSpreadDays, tc.registeredAt, tc.editedAt)
 
 			if tc.wantErr {
 				if err == nil {
@@ -159,7 +428,7 @@ func TestNewPattern(t *testing.T) {
 
 func TestPattern_Set(t *testing.T) {
 	now := time.Now()
-	pattern, err := NewPattern(testPatternID, testUserID, "Original", TargetWeightNormal, now, now)
+	pattern, err := NewPattern(testPatternID, testUserID, "Original", TargetWeightNormal, SchedulerKindFixedSteps, DefaultTargetRetention, false, false, OverduePolicySlideAll, DefaultOverdueSpreadDays, now, now)
 	if err != nil {
 		t.Fatalf("failed to create pattern: %v", err)
 	}
@@ -167,61 +436,194 @@ func TestPattern_Set(t *testing.T) {
 	newTime := now.Add(time.Hour)
 
 	tests := []struct {
-		name         string
-		newName      string
-		targetWeight string
-		editedAt     time.Time
-		wantPattern  *Pattern
-		wantErr      bool
-		errMsg       string
+		name                   string
+		newName                string
+		targetWeight           string
+		schedulerKind          string
+		targetRetention        float64
+		intervalFuzz           bool
+		intervalFromCompletion bool
+		overduePolicy          string
+		overdueSpreadDays      int
+		editedAt               time.Time
+		wantPattern            *Pattern
+		wantErr                bool
+		errMsg                 string
 	}{
 		{
-			name:         "全項目を更新（正常系）",
-			newName:      "Updated Pattern",
-			targetWeight: TargetWeightHeavy,
-			editedAt:     newTime,
+			name:              "全項目を更新（正常系）",
+			newName:           "Updated Pattern",
+			targetWeight:      TargetWeightHeavy,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			editedAt:          newTime,
 			wantPattern: &Pattern{
-				PatternID:    testPatternID,
-				UserID:       testUserID,
-				Name:         "Updated Pattern",
-				TargetWeight: TargetWeightHeavy,
-				RegisteredAt: now,
-				EditedAt:     newTime,
+				PatternID:         testPatternID,
+				UserID:            testUserID,
+				Name:              "Updated Pattern",
+				TargetWeight:      TargetWeightHeavy,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          newTime,
 			},
 			wantErr: false,
 		},
 		{
-			name:         "パターン名が空（異常系）",
-			newName:      "",
-			targetWeight: TargetWeightNormal,
-			editedAt:     newTime,
+			name:              "パターン名が空（異常系）",
+			newName:           "",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			editedAt:          newTime,
 			wantPattern: &Pattern{
-				PatternID:    testPatternID,
-				UserID:       testUserID,
-				Name:         "Original",
-				TargetWeight: TargetWeightNormal,
-				RegisteredAt: now,
-				EditedAt:     now,
+				PatternID:         testPatternID,
+				UserID:            testUserID,
+				Name:              "Original",
+				TargetWeight:      TargetWeightNormal,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          now,
 			},
 			wantErr: true,
 			errMsg:  "名前は必須です",
 		},
 		{
-			name:         "重みが不正（異常系）",
-			newName:      "Valid Name",
-			targetWeight: "invalid",
-			editedAt:     newTime,
+			name:              "重みが不正（異常系）",
+			newName:           "Valid Name",
+			targetWeight:      "invalid",
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			editedAt:          newTime,
 			wantPattern: &Pattern{
-				PatternID:    testPatternID,
-				UserID:       testUserID,
-				Name:         "Original",
-				TargetWeight: TargetWeightNormal,
-				RegisteredAt: now,
-				EditedAt:     now,
+				PatternID:         testPatternID,
+				UserID:            testUserID,
+				Name:              "Original",
+				TargetWeight:      TargetWeightNormal,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          now,
 			},
 			wantErr: true,
 			errMsg:  "重みの値が不正です",
 		},
+		{
+			name:              "スケジューリング方式を適応型に更新（正常系）",
+			newName:           "Original",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindAdaptive,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			editedAt:          newTime,
+			wantPattern: &Pattern{
+				PatternID:         testPatternID,
+				UserID:            testUserID,
+				Name:              "Original",
+				TargetWeight:      TargetWeightNormal,
+				SchedulerKind:     SchedulerKindAdaptive,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          newTime,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "間隔の揺らぎを有効に更新（正常系）",
+			newName:           "Original",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			intervalFuzz:      true,
+			overduePolicy:     OverduePolicySlideAll,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			editedAt:          newTime,
+			wantPattern: &Pattern{
+				PatternID:         testPatternID,
+				UserID:            testUserID,
+				Name:              "Original",
+				TargetWeight:      TargetWeightNormal,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				IntervalFuzz:      true,
+				OverduePolicy:     OverduePolicySlideAll,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          newTime,
+			},
+			wantErr: false,
+		},
+		{
+			name:                   "間隔の起点を完了日に更新（正常系）",
+			newName:                "Original",
+			targetWeight:           TargetWeightNormal,
+			schedulerKind:          SchedulerKindFixedSteps,
+			targetRetention:        DefaultTargetRetention,
+			intervalFromCompletion: true,
+			overduePolicy:          OverduePolicySlideAll,
+			overdueSpreadDays:      DefaultOverdueSpreadDays,
+			editedAt:               newTime,
+			wantPattern: &Pattern{
+				PatternID:              testPatternID,
+				UserID:                 testUserID,
+				Name:                   "Original",
+				TargetWeight:           TargetWeightNormal,
+				SchedulerKind:          SchedulerKindFixedSteps,
+				TargetRetention:        DefaultTargetRetention,
+				IntervalFromCompletion: true,
+				OverduePolicy:          OverduePolicySlideAll,
+				OverdueSpreadDays:      DefaultOverdueSpreadDays,
+				Version:                InitialPatternVersion,
+				RegisteredAt:           now,
+				EditedAt:               newTime,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "期限切れの復習日をそのまま残すよう更新（正常系）",
+			newName:           "Original",
+			targetWeight:      TargetWeightNormal,
+			schedulerKind:     SchedulerKindFixedSteps,
+			targetRetention:   DefaultTargetRetention,
+			overduePolicy:     OverduePolicyKeep,
+			overdueSpreadDays: DefaultOverdueSpreadDays,
+			editedAt:          newTime,
+			wantPattern: &Pattern{
+				PatternID:         testPatternID,
+				UserID:            testUserID,
+				Name:              "Original",
+				TargetWeight:      TargetWeightNormal,
+				SchedulerKind:     SchedulerKindFixedSteps,
+				TargetRetention:   DefaultTargetRetention,
+				OverduePolicy:     OverduePolicyKeep,
+				OverdueSpreadDays: DefaultOverdueSpreadDays,
+				Version:           InitialPatternVersion,
+				RegisteredAt:      now,
+				EditedAt:          newTime,
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -230,7 +632,7 @@ func TestPattern_Set(t *testing.T) {
 			// パターンをコピー
 			testPattern := *pattern
 
-			err := testPattern.Set(tc.newName, tc.targetWeight, tc.editedAt)
+			err := testPattern.Set(tc.newName, tc.targetWeight, tc.schedulerKind, tc.targetRetention, tc.intervalFuzz, tc.intervalFromCompletion, tc.overduePolicy, tc.overdueSpreadDays, tc.editedAt)
 
 			if tc.wantErr {
 				if err == nil {
@@ -256,6 +658,53 @@ func TestPattern_Set(t *testing.T) {
 	}
 }
 
+func TestPattern_BumpVersion(t *testing.T) {
+	now := time.Now()
+	pattern, err := NewPattern(testPatternID, testUserID, "Original", TargetWeightNormal, SchedulerKindFixedSteps, DefaultTargetRetention, false, false, OverduePolicySlideAll, DefaultOverdueSpreadDays, now, now)
+	if err != nil {
+		t.Fatalf("failed to create pattern: %v", err)
+	}
+
+	newTime := now.Add(time.Hour)
+	pattern.BumpVersion(newTime)
+
+	if pattern.Version != InitialPatternVersion+1 {
+		t.Errorf("Version = %d, want %d", pattern.Version, InitialPatternVersion+1)
+	}
+	if !pattern.EditedAt.Equal(newTime) {
+		t.Errorf("EditedAt = %v, want %v", pattern.EditedAt, newTime)
+	}
+}
+
+func TestStepsVersion(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []*PatternStep
+		want  int
+	}{
+		{
+			name:  "ステップが属するバージョンを返す（正常系）",
+			steps: []*PatternStep{{StepNumber: 1, IntervalDays: 1, Version: 3}, {StepNumber: 2, IntervalDays: 3, Version: 3}},
+			want:  3,
+		},
+		{
+			name:  "ステップがない場合は0（正常系）",
+			steps: nil,
+			want:  0,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := StepsVersion(tc.steps); got != tc.want {
+				t.Errorf("StepsVersion() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestNewPatternStep(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -281,6 +730,7 @@ func TestNewPatternStep(t *testing.T) {
 				PatternID:     testPatternID,
 				StepNumber:    1,
 				IntervalDays:  1,
+				Version:       InitialPatternVersion,
 			},
 			wantErr: false,
 		},
@@ -335,7 +785,7 @@ func TestNewPatternStep(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			step, err := NewPatternStep(tc.patternStepID, tc.userID, tc.patternID, tc.stepNumber, tc.intervalDays)
+			step, err := NewPatternStep(tc.patternStepID, tc.userID, tc.patternID, tc.stepNumber, tc.intervalDays, InitialPatternVersion)
 
 			if tc.wantErr {
 				if err == nil {
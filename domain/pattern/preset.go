@@ -0,0 +1,111 @@
+package pattern
+
+import (
+	userDomain "github.com/minminseo/recall-setter/domain/user"
+)
+
+// ユーザーがそのまま追加できる組み込みの復習パターン
+type PatternPreset struct {
+	Key          string
+	TargetWeight string
+	IntervalDays []int             // ステップ番号順の学習日からの間隔
+	names        map[string]string // 言語ごとの名前
+	descriptions map[string]string // 言語ごとの説明
+}
+
+// 組み込みの復習パターンのキー
+const (
+	PresetKeyEbbinghaus    string = "ebbinghaus"
+	PresetKeyExamCram      string = "exam_cram"
+	PresetKeyLanguageVocab string = "language_vocab"
+	PresetKeyLongTerm      string = "long_term"
+)
+
+// 表示順に並べた組み込みの復習パターン
+var patternPresets = []*PatternPreset{
+	{
+		Key:          PresetKeyEbbinghaus,
+		TargetWeight: TargetWeightNormal,
+		IntervalDays: []int{1, 3, 7, 14, 30},
+		names: map[string]string{
+			userDomain.LanguageJa: "エビングハウスの忘却曲線",
+			userDomain.LanguageEn: "Ebbinghaus forgetting curve",
+		},
+		descriptions: map[string]string{
+			userDomain.LanguageJa: "1日後・3日後・1週間後・2週間後・1ヶ月後に復習する定番のパターン",
+			userDomain.LanguageEn: "The classic schedule: review after 1 day, 3 days, 1 week, 2 weeks and 1 month",
+		},
+	},
+	{
+		Key:          PresetKeyExamCram,
+		TargetWeight: TargetWeightHeavy,
+		IntervalDays: []int{1, 2, 4, 7},
+		names: map[string]string{
+			userDomain.LanguageJa: "試験直前の詰め込み",
+			userDomain.LanguageEn: "Exam cram",
+		},
+		descriptions: map[string]string{
+			userDomain.LanguageJa: "1週間で集中的に復習する、試験直前向けの短いパターン",
+			userDomain.LanguageEn: "A short, intensive schedule that fits into the week before an exam",
+		},
+	},
+	{
+		Key:          PresetKeyLanguageVocab,
+		TargetWeight: TargetWeightNormal,
+		IntervalDays: []int{1, 2, 4, 8, 16, 32, 64},
+		names: map[string]string{
+			userDomain.LanguageJa: "語学の単語暗記",
+			userDomain.LanguageEn: "Language vocabulary",
+		},
+		descriptions: map[string]string{
+			userDomain.LanguageJa: "間隔を倍々に伸ばして、単語を少しずつ長期記憶に定着させるパターン",
+			userDomain.LanguageEn: "Doubles the interval every time so that words gradually move into long-term memory",
+		},
+	},
+	{
+		Key:          PresetKeyLongTerm,
+		TargetWeight: TargetWeightLight,
+		IntervalDays: []int{7, 30, 90, 180},
+		names: map[string]string{
+			userDomain.LanguageJa: "長期記憶の維持",
+			userDomain.LanguageEn: "Long-term maintenance",
+		},
+		descriptions: map[string]string{
+			userDomain.LanguageJa: "既に身についている内容を忘れないように、数ヶ月おきに見直すパターン",
+			userDomain.LanguageEn: "Revisits material you already know every few months so that it is not forgotten",
+		},
+	},
+}
+
+// 組み込みの復習パターンを表示順に全て返す
+func PatternPresets() []*PatternPreset {
+	presets := make([]*PatternPreset, len(patternPresets))
+	copy(presets, patternPresets)
+	return presets
+}
+
+// キーに一致する組み込みの復習パターンを返す
+func FindPatternPreset(key string) (*PatternPreset, error) {
+	for _, preset := range patternPresets {
+		if preset.Key == key {
+			return preset, nil
+		}
+	}
+	return nil, ErrPatternPresetNotFound
+}
+
+// 指定した言語の名前を返す（対応していない言語の場合は日本語）
+func (p *PatternPreset) Name(language string) string {
+	if name, ok := p.names[language]; ok {
+		return name
+	}
+	return p.names[userDomain.LanguageJa]
+}
+
+// 指定した言語の説明を返す（対応していない言語の場合は日本語）
+func (p *PatternPreset) Description(language string) string {
+	if description, ok := p.descriptions[language]; ok {
+		return description
+	}
+	return p.descriptions[userDomain.LanguageJa]
+}
@@ -0,0 +1,83 @@
+package pattern
+
+import (
+	"errors"
+	"testing"
+
+	userDomain "github.com/minminseo/recall-setter/domain/user"
+)
+
+func TestFindPatternPreset(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr error
+	}{
+		{name: "存在するキー", key: PresetKeyLanguageVocab, wantErr: nil},
+		{name: "存在しないキー", key: "unknown", wantErr: ErrPatternPresetNotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := FindPatternPreset(tc.key)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("FindPatternPreset() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr == nil && got.Key != tc.key {
+				t.Errorf("FindPatternPreset() Key = %s, want %s", got.Key, tc.key)
+			}
+		})
+	}
+}
+
+func TestPatternPreset_Name(t *testing.T) {
+	preset, err := FindPatternPreset(PresetKeyEbbinghaus)
+	if err != nil {
+		t.Fatalf("FindPatternPreset() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		language string
+		want     string
+	}{
+		{name: "日本語", language: userDomain.LanguageJa, want: "エビングハウスの忘却曲線"},
+		{name: "英語", language: userDomain.LanguageEn, want: "Ebbinghaus forgetting curve"},
+		{name: "対応していない言語は日本語", language: "fr", want: "エビングハウスの忘却曲線"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := preset.Name(tc.language); got != tc.want {
+				t.Errorf("Name() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+// 組み込みの復習パターンはそのまま復習パターンとして作成できること
+func TestPatternPresets_AreValidPatterns(t *testing.T) {
+	for _, preset := range PatternPresets() {
+		t.Run(preset.Key, func(t *testing.T) {
+			for _, language := range []string{userDomain.LanguageJa, userDomain.LanguageEn} {
+				if preset.Name(language) == "" || preset.Description(language) == "" {
+					t.Errorf("言語%sの名前または説明がありません", language)
+				}
+			}
+			if err := validateTargetWeight(preset.TargetWeight); err != nil {
+				t.Errorf("validateTargetWeight() error = %v", err)
+			}
+			steps := make([]*PatternStep, len(preset.IntervalDays))
+			for i, days := range preset.IntervalDays {
+				step, err := NewPatternStep("step", testUserID, testPatternID, i+1, days, InitialPatternVersion)
+				if err != nil {
+					t.Fatalf("NewPatternStep() error = %v", err)
+				}
+				steps[i] = step
+			}
+			if err := ValidateSteps(steps); err != nil {
+				t.Errorf("ValidateSteps() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package tag
+
+import "errors"
+
+var (
+	ErrTagNotFound      = errors.New("タグが存在しません")
+	ErrDuplicateTagName = errors.New("同じ名前のタグが既に存在します")
+	ErrTooManyItemTags  = errors.New("1つの復習物に付けられるタグは20個までです")
+	ErrItemNotFound     = errors.New("復習物が存在しません")
+)
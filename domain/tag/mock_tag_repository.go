@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/tag/tag_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/tag/tag_repository.go -destination=domain/tag/mock_tag_repository.go -package tag
+//
+
+// Package tag is a generated GoMock package.
+package tag
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITagRepository is a mock of ITagRepository interface.
+type MockITagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITagRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITagRepositoryMockRecorder is the mock recorder for MockITagRepository.
+type MockITagRepositoryMockRecorder struct {
+	mock *MockITagRepository
+}
+
+// NewMockITagRepository creates a new mock instance.
+func NewMockITagRepository(ctrl *gomock.Controller) *MockITagRepository {
+	mock := &MockITagRepository{ctrl: ctrl}
+	mock.recorder = &MockITagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITagRepository) EXPECT() *MockITagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITagRepository) Create(ctx context.Context, tag *Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITagRepositoryMockRecorder) Create(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITagRepository)(nil).Create), ctx, tag)
+}
+
+// Delete mocks base method.
+func (m *MockITagRepository) Delete(ctx context.Context, tagID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tagID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITagRepositoryMockRecorder) Delete(ctx, tagID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITagRepository)(nil).Delete), ctx, tagID, userID)
+}
+
+// ExistsItemByID mocks base method.
+func (m *MockITagRepository) ExistsItemByID(ctx context.Context, itemID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsItemByID", ctx, itemID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsItemByID indicates an expected call of ExistsItemByID.
+func (mr *MockITagRepositoryMockRecorder) ExistsItemByID(ctx, itemID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsItemByID", reflect.TypeOf((*MockITagRepository)(nil).ExistsItemByID), ctx, itemID, userID)
+}
+
+// GetAllByUserID mocks base method.
+func (m *MockITagRepository) GetAllByUserID(ctx context.Context, userID string) ([]*Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUserID indicates an expected call of GetAllByUserID.
+func (mr *MockITagRepositoryMockRecorder) GetAllByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserID", reflect.TypeOf((*MockITagRepository)(nil).GetAllByUserID), ctx, userID)
+}
+
+// GetTagsByItemID mocks base method.
+func (m *MockITagRepository) GetTagsByItemID(ctx context.Context, itemID, userID string) ([]*Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagsByItemID", ctx, itemID, userID)
+	ret0, _ := ret[0].([]*Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagsByItemID indicates an expected call of GetTagsByItemID.
+func (mr *MockITagRepositoryMockRecorder) GetTagsByItemID(ctx, itemID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByItemID", reflect.TypeOf((*MockITagRepository)(nil).GetTagsByItemID), ctx, itemID, userID)
+}
+
+// ReplaceItemTags mocks base method.
+func (m *MockITagRepository) ReplaceItemTags(ctx context.Context, itemID, userID string, tagIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceItemTags", ctx, itemID, userID, tagIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceItemTags indicates an expected call of ReplaceItemTags.
+func (mr *MockITagRepositoryMockRecorder) ReplaceItemTags(ctx, itemID, userID, tagIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceItemTags", reflect.TypeOf((*MockITagRepository)(nil).ReplaceItemTags), ctx, itemID, userID, tagIDs)
+}
+
+// Update mocks base method.
+func (m *MockITagRepository) Update(ctx context.Context, tag *Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITagRepositoryMockRecorder) Update(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITagRepository)(nil).Update), ctx, tag)
+}
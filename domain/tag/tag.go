@@ -0,0 +1,111 @@
+package tag
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// 1つの復習物に付けられるタグの最大数
+const MaxTagsPerItem = 20
+
+type Tag struct {
+	ID           string
+	UserID       string
+	Name         string
+	RegisteredAt time.Time
+	EditedAt     time.Time
+}
+
+func NewTag(
+	id string,
+	userID string,
+	name string,
+	registeredAt time.Time,
+	editedAt time.Time,
+) (*Tag, error) {
+
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	t := &Tag{
+		ID:           id,
+		UserID:       userID,
+		Name:         name,
+		RegisteredAt: registeredAt,
+		EditedAt:     editedAt,
+	}
+
+	return t, nil
+}
+
+func ReconstructTag(
+	id string,
+	userID string,
+	name string,
+	registeredAt time.Time,
+	editedAt time.Time,
+) (*Tag, error) {
+	t := &Tag{
+		ID:           id,
+		UserID:       userID,
+		Name:         name,
+		RegisteredAt: registeredAt,
+		EditedAt:     editedAt,
+	}
+	return t, nil
+}
+
+func validateName(name string) error {
+	return validation.Validate(
+		name,
+		validation.Required.Error("タグ名は必須です"),
+		validation.RuneLength(1, 50).Error("タグ名は50文字以内で入力してください"),
+	)
+}
+
+func (t *Tag) Set(name string, editedAt time.Time) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+
+	t.Name = name
+	t.EditedAt = editedAt
+	return nil
+}
+
+// 同じユーザーのタグに同名のものがあればエラー（excludeIDのタグは比較しない）
+func ValidateUniqueName(tags []*Tag, name string, excludeID string) error {
+	for _, t := range tags {
+		if t.ID != excludeID && t.Name == name {
+			return ErrDuplicateTagName
+		}
+	}
+	return nil
+}
+
+// 復習物に付けるタグIDを重複を除いて検証する。ユーザーのタグにないIDが含まれていればエラー
+func ValidateItemTagIDs(tags []*Tag, tagIDs []string) ([]string, error) {
+	owned := make(map[string]struct{}, len(tags))
+	for _, t := range tags {
+		owned[t.ID] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(tagIDs))
+	unique := make([]string, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		if _, ok := owned[id]; !ok {
+			return nil, ErrTagNotFound
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	if len(unique) > MaxTagsPerItem {
+		return nil, ErrTooManyItemTags
+	}
+	return unique, nil
+}
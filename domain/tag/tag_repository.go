@@ -0,0 +1,16 @@
+package tag
+
+import "context"
+
+type ITagRepository interface {
+	Create(ctx context.Context, tag *Tag) error
+	GetAllByUserID(ctx context.Context, userID string) ([]*Tag, error)
+	Update(ctx context.Context, tag *Tag) error
+	Delete(ctx context.Context, tagID string, userID string) error
+
+	// 復習物とタグの紐付け
+	ExistsItemByID(ctx context.Context, itemID string, userID string) (bool, error)
+	GetTagsByItemID(ctx context.Context, itemID string, userID string) ([]*Tag, error)
+	// 復習物に付いているタグを全て外してからtagIDsのタグを付け直す
+	ReplaceItemTags(ctx context.Context, itemID string, userID string, tagIDs []string) error
+}
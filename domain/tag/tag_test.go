@@ -0,0 +1,201 @@
+package tag
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testUserID = "user1"
+	testTagID  = "tag1"
+)
+
+func TestNewTag(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		tagName string
+		want    *Tag
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "有効なタグ（正常系）",
+			tagName: "重要",
+			want: &Tag{
+				ID:           testTagID,
+				UserID:       testUserID,
+				Name:         "重要",
+				RegisteredAt: now,
+				EditedAt:     now,
+			},
+		},
+		{
+			name:    "タグ名が空（異常系）",
+			tagName: "",
+			wantErr: true,
+			errMsg:  "タグ名は必須です",
+		},
+		{
+			name:    "タグ名が長すぎる（異常系）",
+			tagName: strings.Repeat("あ", 51),
+			wantErr: true,
+			errMsg:  "タグ名は50文字以内で入力してください",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tag, err := NewTag(testTagID, testUserID, tc.tagName, now, now)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("エラーが発生することを期待しましたが、nilでした")
+				}
+				if err.Error() != tc.errMsg {
+					t.Errorf("エラーメッセージが一致しません: got %q, want %q", err.Error(), tc.errMsg)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want, tag); diff != "" {
+				t.Errorf("Tag mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTag_Set(t *testing.T) {
+	now := time.Now()
+	newTime := now.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		newName string
+		want    *Tag
+		wantErr bool
+	}{
+		{
+			name:    "タグ名を更新（正常系）",
+			newName: "苦手",
+			want: &Tag{
+				ID:           testTagID,
+				UserID:       testUserID,
+				Name:         "苦手",
+				RegisteredAt: now,
+				EditedAt:     newTime,
+			},
+		},
+		{
+			name:    "タグ名が空で更新（異常系）",
+			newName: "",
+			want: &Tag{
+				ID:           testTagID,
+				UserID:       testUserID,
+				Name:         "重要",
+				RegisteredAt: now,
+				EditedAt:     now,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tag, err := NewTag(testTagID, testUserID, "重要", now, now)
+			if err != nil {
+				t.Fatalf("タグの生成に失敗しました: %v", err)
+			}
+
+			err = tag.Set(tc.newName, newTime)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Set() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, tag); diff != "" {
+				t.Errorf("Tag mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateUniqueName(t *testing.T) {
+	tags := []*Tag{
+		{ID: "tag1", UserID: testUserID, Name: "重要"},
+		{ID: "tag2", UserID: testUserID, Name: "苦手"},
+	}
+
+	tests := []struct {
+		name      string
+		tagName   string
+		excludeID string
+		wantErr   error
+	}{
+		{name: "同名のタグがない", tagName: "暗記"},
+		{name: "同名のタグがある", tagName: "重要", wantErr: ErrDuplicateTagName},
+		{name: "更新するタグ自身の名前は重複とみなさない", tagName: "重要", excludeID: "tag1"},
+		{name: "他のタグと同名に更新する", tagName: "苦手", excludeID: "tag1", wantErr: ErrDuplicateTagName},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateUniqueName(tags, tc.tagName, tc.excludeID)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("ValidateUniqueName() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateItemTagIDs(t *testing.T) {
+	tags := make([]*Tag, MaxTagsPerItem+1)
+	allIDs := make([]string, MaxTagsPerItem+1)
+	for i := range tags {
+		id := "tag" + string(rune('a'+i))
+		tags[i] = &Tag{ID: id, UserID: testUserID}
+		allIDs[i] = id
+	}
+
+	tests := []struct {
+		name    string
+		tagIDs  []string
+		want    []string
+		wantErr error
+	}{
+		{name: "タグを全て外す", tagIDs: nil, want: []string{}},
+		{name: "重複したIDは1件にまとめる", tagIDs: []string{"taga", "tagb", "taga"}, want: []string{"taga", "tagb"}},
+		{name: "上限ちょうど", tagIDs: allIDs[:MaxTagsPerItem], want: allIDs[:MaxTagsPerItem]},
+		{name: "ユーザーのタグにないIDはエラー", tagIDs: []string{"taga", "unknown"}, wantErr: ErrTagNotFound},
+		{name: "上限を超える場合はエラー", tagIDs: allIDs, wantErr: ErrTooManyItemTags},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ValidateItemTagIDs(tags, tc.tagIDs)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("ValidateItemTagIDs() error = %v, wantErr %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ValidateItemTagIDs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
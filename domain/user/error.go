@@ -0,0 +1,12 @@
+package user
+
+import "errors"
+
+var (
+	ErrVacationOverlapped       = errors.New("登録済みの休暇と期間が重複しています")
+	ErrDuplicateRestDate        = errors.New("休息日の日付が重複しています")
+	ErrVacationPeriodRequired   = errors.New("休暇の開始日と終了日は必須です")
+	ErrVacationStartBeforeToday = errors.New("休暇の開始日は今日以降で指定してください")
+	ErrVacationEndBeforeStart   = errors.New("休暇の終了日は開始日以降で指定してください")
+	ErrVacationTooLong          = errors.New("休暇は365日以内で指定してください")
+)
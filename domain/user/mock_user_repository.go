@@ -41,6 +41,21 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountIncompleteReviewDatesFromDate mocks base method.
+func (m *MockUserRepository) CountIncompleteReviewDatesFromDate(ctx context.Context, userID string, fromDate time.Time) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountIncompleteReviewDatesFromDate", ctx, userID, fromDate)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountIncompleteReviewDatesFromDate indicates an expected call of CountIncompleteReviewDatesFromDate.
+func (mr *MockUserRepositoryMockRecorder) CountIncompleteReviewDatesFromDate(ctx, userID, fromDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountIncompleteReviewDatesFromDate", reflect.TypeOf((*MockUserRepository)(nil).CountIncompleteReviewDatesFromDate), ctx, userID, fromDate)
+}
+
 // Create mocks base method.
 func (m *MockUserRepository) Create(ctx context.Context, user *User) error {
 	m.ctrl.T.Helper()
@@ -55,6 +70,20 @@ func (mr *MockUserRepositoryMockRecorder) Create(ctx, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepository)(nil).Create), ctx, user)
 }
 
+// CreateVacation mocks base method.
+func (m *MockUserRepository) CreateVacation(ctx context.Context, vacation *Vacation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVacation", ctx, vacation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVacation indicates an expected call of CreateVacation.
+func (mr *MockUserRepositoryMockRecorder) CreateVacation(ctx, vacation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVacation", reflect.TypeOf((*MockUserRepository)(nil).CreateVacation), ctx, vacation)
+}
+
 // FindByEmailSearchKey mocks base method.
 func (m *MockUserRepository) FindByEmailSearchKey(ctx context.Context, searchKey string) (*User, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +99,36 @@ func (mr *MockUserRepositoryMockRecorder) FindByEmailSearchKey(ctx, searchKey an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmailSearchKey", reflect.TypeOf((*MockUserRepository)(nil).FindByEmailSearchKey), ctx, searchKey)
 }
 
+// GetRestDaysByUserID mocks base method.
+func (m *MockUserRepository) GetRestDaysByUserID(ctx context.Context, userID string) (*RestDays, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRestDaysByUserID", ctx, userID)
+	ret0, _ := ret[0].(*RestDays)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRestDaysByUserID indicates an expected call of GetRestDaysByUserID.
+func (mr *MockUserRepositoryMockRecorder) GetRestDaysByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRestDaysByUserID", reflect.TypeOf((*MockUserRepository)(nil).GetRestDaysByUserID), ctx, userID)
+}
+
+// GetReviewLimitByUserID mocks base method.
+func (m *MockUserRepository) GetReviewLimitByUserID(ctx context.Context, userID string) (*ReviewLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewLimitByUserID", ctx, userID)
+	ret0, _ := ret[0].(*ReviewLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewLimitByUserID indicates an expected call of GetReviewLimitByUserID.
+func (mr *MockUserRepositoryMockRecorder) GetReviewLimitByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewLimitByUserID", reflect.TypeOf((*MockUserRepository)(nil).GetReviewLimitByUserID), ctx, userID)
+}
+
 // GetSettingByID mocks base method.
 func (m *MockUserRepository) GetSettingByID(ctx context.Context, userID string) (*User, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +144,35 @@ func (mr *MockUserRepositoryMockRecorder) GetSettingByID(ctx, userID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettingByID", reflect.TypeOf((*MockUserRepository)(nil).GetSettingByID), ctx, userID)
 }
 
+// HasOverlappingVacation mocks base method.
+func (m *MockUserRepository) HasOverlappingVacation(ctx context.Context, userID string, startDate, endDate time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasOverlappingVacation", ctx, userID, startDate, endDate)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasOverlappingVacation indicates an expected call of HasOverlappingVacation.
+func (mr *MockUserRepositoryMockRecorder) HasOverlappingVacation(ctx, userID, startDate, endDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasOverlappingVacation", reflect.TypeOf((*MockUserRepository)(nil).HasOverlappingVacation), ctx, userID, startDate, endDate)
+}
+
+// ShiftIncompleteReviewDatesFromDate mocks base method.
+func (m *MockUserRepository) ShiftIncompleteReviewDatesFromDate(ctx context.Context, userID string, fromDate time.Time, days int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShiftIncompleteReviewDatesFromDate", ctx, userID, fromDate, days)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShiftIncompleteReviewDatesFromDate indicates an expected call of ShiftIncompleteReviewDatesFromDate.
+func (mr *MockUserRepositoryMockRecorder) ShiftIncompleteReviewDatesFromDate(ctx, userID, fromDate, days any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShiftIncompleteReviewDatesFromDate", reflect.TypeOf((*MockUserRepository)(nil).ShiftIncompleteReviewDatesFromDate), ctx, userID, fromDate, days)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(ctx context.Context, user *User) error {
 	m.ctrl.T.Helper()
@@ -113,6 +201,34 @@ func (mr *MockUserRepositoryMockRecorder) UpdatePassword(ctx, userID, password a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepository)(nil).UpdatePassword), ctx, userID, password)
 }
 
+// UpdateRestDays mocks base method.
+func (m *MockUserRepository) UpdateRestDays(ctx context.Context, restDays *RestDays) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRestDays", ctx, restDays)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRestDays indicates an expected call of UpdateRestDays.
+func (mr *MockUserRepositoryMockRecorder) UpdateRestDays(ctx, restDays any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRestDays", reflect.TypeOf((*MockUserRepository)(nil).UpdateRestDays), ctx, restDays)
+}
+
+// UpdateReviewLimit mocks base method.
+func (m *MockUserRepository) UpdateReviewLimit(ctx context.Context, reviewLimit *ReviewLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReviewLimit", ctx, reviewLimit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReviewLimit indicates an expected call of UpdateReviewLimit.
+func (mr *MockUserRepositoryMockRecorder) UpdateReviewLimit(ctx, reviewLimit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewLimit", reflect.TypeOf((*MockUserRepository)(nil).UpdateReviewLimit), ctx, reviewLimit)
+}
+
 // UpdateVerifiedAt mocks base method.
 func (m *MockUserRepository) UpdateVerifiedAt(ctx context.Context, verifiedAt *time.Time, userID string) error {
 	m.ctrl.T.Helper()
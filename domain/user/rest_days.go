@@ -0,0 +1,121 @@
+package user
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// 復習日を置かないユーザー毎の休息日（曜日と特定の日付）
+type RestDays struct {
+	UserID   string
+	Weekdays []time.Weekday
+	Dates    []time.Time
+}
+
+func NewRestDays(
+	userID string,
+	weekdays []time.Weekday,
+	dates []time.Time,
+) (*RestDays, error) {
+	if err := validateRestWeekdays(weekdays); err != nil {
+		return nil, err
+	}
+	if err := validateRestDates(dates); err != nil {
+		return nil, err
+	}
+
+	r := &RestDays{
+		UserID:   userID,
+		Weekdays: weekdays,
+		Dates:    dates,
+	}
+	return r, nil
+}
+
+func ReconstructRestDays(
+	userID string,
+	weekdays []time.Weekday,
+	dates []time.Time,
+) (*RestDays, error) {
+	r := &RestDays{
+		UserID:   userID,
+		Weekdays: weekdays,
+		Dates:    dates,
+	}
+	return r, nil
+}
+
+func validateRestWeekdays(weekdays []time.Weekday) error {
+	return validation.Validate(
+		weekdays,
+		validation.By(func(value interface{}) error {
+			wds, _ := value.([]time.Weekday)
+			seen := make(map[time.Weekday]struct{}, len(wds))
+			for _, wd := range wds {
+				if wd < time.Sunday || wd > time.Saturday {
+					return errors.New("休息日の曜日の値が不正です")
+				}
+				if _, ok := seen[wd]; ok {
+					return errors.New("休息日の曜日が重複しています")
+				}
+				seen[wd] = struct{}{}
+			}
+			if len(seen) == 7 {
+				return errors.New("全ての曜日を休息日にはできません")
+			}
+			return nil
+		}),
+	)
+}
+
+func validateRestDates(dates []time.Time) error {
+	return validation.Validate(
+		dates,
+		validation.By(func(value interface{}) error {
+			ds, _ := value.([]time.Time)
+			seen := make(map[string]struct{}, len(ds))
+			for _, d := range ds {
+				if d.IsZero() {
+					return errors.New("休息日の日付は必須です")
+				}
+				// 同じ日付を2回登録しようとすると主キーが重複するため、ここで弾く
+				key := d.Format("2006-01-02")
+				if _, ok := seen[key]; ok {
+					return ErrDuplicateRestDate
+				}
+				seen[key] = struct{}{}
+			}
+			return nil
+		}),
+	)
+}
+
+func (r *RestDays) IsRestDay(date time.Time) bool {
+	for _, wd := range r.Weekdays {
+		if date.Weekday() == wd {
+			return true
+		}
+	}
+	y, m, d := date.Date()
+	for _, restDate := range r.Dates {
+		ry, rm, rd := restDate.Date()
+		if y == ry && m == rm && d == rd {
+			return true
+		}
+	}
+	return false
+}
+
+// 指定日が休息日なら、休息日でない次の日を返す
+func (r *RestDays) NextAvailableDate(date time.Time) time.Time {
+	// 全ての曜日を休息日にはできないため、1年以内に必ず見つかる
+	for i := 0; i <= 366; i++ {
+		candidate := date.AddDate(0, 0, i)
+		if !r.IsRestDay(candidate) {
+			return candidate
+		}
+	}
+	return date
+}
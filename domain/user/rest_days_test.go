@@ -0,0 +1,134 @@
+package user
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewRestDays(t *testing.T) {
+	tests := []struct {
+		name     string
+		weekdays []time.Weekday
+		dates    []time.Time
+		wantErr  bool
+		errMsg   string
+	}{
+		// 正常系
+		{
+			name:     "曜日と日付を指定（正常系）",
+			weekdays: []time.Weekday{time.Saturday, time.Sunday},
+			dates:    []time.Time{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			wantErr:  false,
+		},
+		{
+			name:     "休息日なし（正常系）",
+			weekdays: []time.Weekday{},
+			dates:    []time.Time{},
+			wantErr:  false,
+		},
+
+		// 異常系
+		{
+			name:     "範囲外の曜日（異常系）",
+			weekdays: []time.Weekday{time.Weekday(7)},
+			wantErr:  true,
+			errMsg:   "休息日の曜日の値が不正です",
+		},
+		{
+			name:     "曜日の重複（異常系）",
+			weekdays: []time.Weekday{time.Sunday, time.Sunday},
+			wantErr:  true,
+			errMsg:   "休息日の曜日が重複しています",
+		},
+		{
+			name:     "全ての曜日を指定（異常系）",
+			weekdays: []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday},
+			wantErr:  true,
+			errMsg:   "全ての曜日を休息日にはできません",
+		},
+		{
+			name:    "日付がゼロ値（異常系）",
+			dates:   []time.Time{{}},
+			wantErr: true,
+			errMsg:  "休息日の日付は必須です",
+		},
+		{
+			name: "日付の重複（異常系）",
+			dates: []time.Time{
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			wantErr: true,
+			errMsg:  "休息日の日付が重複しています",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := NewRestDays("user1", tc.weekdays, tc.dates)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("エラーが発生することを期待しましたが、nilでした")
+				}
+				if err.Error() != tc.errMsg {
+					t.Errorf("エラーメッセージが一致しません: got %q, want %q", err.Error(), tc.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if r.UserID != "user1" {
+				t.Errorf("ユーザーIDが一致しません: got %q, want %q", r.UserID, "user1")
+			}
+		})
+	}
+}
+
+func TestRestDays_NextAvailableDate(t *testing.T) {
+	// 土日と2024-01-08（月曜日）が休息日
+	restDays, err := NewRestDays(
+		"user1",
+		[]time.Weekday{time.Saturday, time.Sunday},
+		[]time.Time{time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)},
+	)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		date time.Time
+		want time.Time
+	}{
+		{
+			name: "休息日でなければそのまま",
+			date: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "休息日の曜日は次の平日へ",
+			date: time.Date(2024, 1, 13, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "休息日の曜日と日付が連続する場合はまとめて飛ばす",
+			date: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := restDays.NextAvailableDate(tc.date); !got.Equal(tc.want) {
+				t.Errorf("NextAvailableDate(%v) = %v, want %v", tc.date, got, tc.want)
+			}
+		})
+	}
+}
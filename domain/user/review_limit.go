@@ -0,0 +1,42 @@
+package user
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// 1日の最大復習数の上限値
+const MaxReviewsPerDayLimit = 1000
+
+// ユーザー毎の1日の最大復習数（0は上限なし）
+type ReviewLimit struct {
+	UserID           string
+	MaxReviewsPerDay int
+}
+
+func NewReviewLimit(userID string, maxReviewsPerDay int) (*ReviewLimit, error) {
+	if err := validateMaxReviewsPerDay(maxReviewsPerDay); err != nil {
+		return nil, err
+	}
+
+	r := &ReviewLimit{
+		UserID:           userID,
+		MaxReviewsPerDay: maxReviewsPerDay,
+	}
+	return r, nil
+}
+
+func ReconstructReviewLimit(userID string, maxReviewsPerDay int) (*ReviewLimit, error) {
+	r := &ReviewLimit{
+		UserID:           userID,
+		MaxReviewsPerDay: maxReviewsPerDay,
+	}
+	return r, nil
+}
+
+func validateMaxReviewsPerDay(maxReviewsPerDay int) error {
+	return validation.Validate(
+		maxReviewsPerDay,
+		validation.Min(0).Error("1日の最大復習数は0以上で指定してください"),
+		validation.Max(MaxReviewsPerDayLimit).Error("1日の最大復習数は1000以下で指定してください"),
+	)
+}
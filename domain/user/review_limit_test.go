@@ -0,0 +1,64 @@
+package user
+
+import (
+	"testing"
+)
+
+func TestNewReviewLimit(t *testing.T) {
+	tests := []struct {
+		name             string
+		maxReviewsPerDay int
+		wantErr          bool
+		errMsg           string
+	}{
+		// 正常系
+		{
+			name:             "上限なし（正常系）",
+			maxReviewsPerDay: 0,
+			wantErr:          false,
+		},
+		{
+			name:             "上限値ちょうど（正常系）",
+			maxReviewsPerDay: MaxReviewsPerDayLimit,
+			wantErr:          false,
+		},
+
+		// 異常系
+		{
+			name:             "負の値（異常系）",
+			maxReviewsPerDay: -1,
+			wantErr:          true,
+			errMsg:           "1日の最大復習数は0以上で指定してください",
+		},
+		{
+			name:             "上限値を超える（異常系）",
+			maxReviewsPerDay: MaxReviewsPerDayLimit + 1,
+			wantErr:          true,
+			errMsg:           "1日の最大復習数は1000以下で指定してください",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := NewReviewLimit("user1", tc.maxReviewsPerDay)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("エラーが発生することを期待しましたが、nilでした")
+				}
+				if err.Error() != tc.errMsg {
+					t.Errorf("エラーメッセージが一致しません: got %q, want %q", err.Error(), tc.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if r.MaxReviewsPerDay != tc.maxReviewsPerDay {
+				t.Errorf("1日の最大復習数が一致しません: got %d, want %d", r.MaxReviewsPerDay, tc.maxReviewsPerDay)
+			}
+		})
+	}
+}
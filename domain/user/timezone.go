@@ -0,0 +1,13 @@
+package user
+
+import "time"
+
+// 指定した時刻のユーザーのタイムゾーンでの日付を返す（復習日と同じく、UTCの0時として表す）
+func LocalDate(t time.Time, timezone string) (time.Time, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC), nil
+}
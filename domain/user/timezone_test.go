@@ -0,0 +1,62 @@
+package user
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLocalDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        time.Time
+		timezone string
+		want     time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "UTCではそのままの日付",
+			t:        time.Date(2024, 1, 2, 23, 30, 0, 0, time.UTC),
+			timezone: TimeZoneUTC,
+			want:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "東京ではUTCの23時半が翌日になる",
+			t:        time.Date(2024, 1, 2, 23, 30, 0, 0, time.UTC),
+			timezone: TimeZoneTokyo,
+			want:     time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "ロサンゼルスではUTCの朝が前日になる",
+			t:        time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC),
+			timezone: TimeZoneLosAngeles,
+			want:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "不明なタイムゾーンはエラー",
+			t:        time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC),
+			timezone: "Invalid/Zone",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := LocalDate(tc.t, tc.timezone)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("エラーが発生することを期待しましたが、nilでした")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("日付が一致しません: got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -12,4 +12,18 @@ type UserRepository interface {
 	Update(ctx context.Context, user *User) error
 	UpdatePassword(ctx context.Context, userID, password string) error
 	UpdateVerifiedAt(ctx context.Context, verifiedAt *time.Time, userID string) error
+
+	// 休息日系
+	GetRestDaysByUserID(ctx context.Context, userID string) (*RestDays, error)
+	UpdateRestDays(ctx context.Context, restDays *RestDays) error
+
+	// 1日の最大復習数系
+	GetReviewLimitByUserID(ctx context.Context, userID string) (*ReviewLimit, error)
+	UpdateReviewLimit(ctx context.Context, reviewLimit *ReviewLimit) error
+
+	// 休暇系
+	CreateVacation(ctx context.Context, vacation *Vacation) error
+	HasOverlappingVacation(ctx context.Context, userID string, startDate, endDate time.Time) (bool, error)
+	CountIncompleteReviewDatesFromDate(ctx context.Context, userID string, fromDate time.Time) (map[string]int, error)
+	ShiftIncompleteReviewDatesFromDate(ctx context.Context, userID string, fromDate time.Time, days int) error
 }
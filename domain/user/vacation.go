@@ -0,0 +1,108 @@
+package user
+
+import "time"
+
+// 1回の休暇で指定できる最大日数
+const MaxVacationDays = 365
+
+// ユーザーの休暇（期間中はバッチで期限切れの復習日をずらさず、登録時に未完了の復習日を休暇の日数分だけ後ろにずらす）
+type Vacation struct {
+	VacationID string
+	UserID     string
+	StartDate  time.Time
+	EndDate    time.Time
+}
+
+// 休暇の前後での日毎の未完了の復習数
+type VacationDailyLoad struct {
+	Date   time.Time
+	Before int
+	After  int
+}
+
+func NewVacation(
+	vacationID string,
+	userID string,
+	startDate time.Time,
+	endDate time.Time,
+	today time.Time,
+) (*Vacation, error) {
+	if err := validateVacationPeriod(startDate, endDate, today); err != nil {
+		return nil, err
+	}
+
+	v := &Vacation{
+		VacationID: vacationID,
+		UserID:     userID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+	}
+	return v, nil
+}
+
+func ReconstructVacation(
+	vacationID string,
+	userID string,
+	startDate time.Time,
+	endDate time.Time,
+) (*Vacation, error) {
+	v := &Vacation{
+		VacationID: vacationID,
+		UserID:     userID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+	}
+	return v, nil
+}
+
+func validateVacationPeriod(startDate, endDate, today time.Time) error {
+	if startDate.IsZero() || endDate.IsZero() {
+		return ErrVacationPeriodRequired
+	}
+	if startDate.Before(today) {
+		return ErrVacationStartBeforeToday
+	}
+	if endDate.Before(startDate) {
+		return ErrVacationEndBeforeStart
+	}
+	if days := int(endDate.Sub(startDate).Hours()/24) + 1; days > MaxVacationDays {
+		return ErrVacationTooLong
+	}
+	return nil
+}
+
+// 休暇の日数（開始日と終了日を含む）。未完了の復習日はこの日数だけ後ろにずらす
+func (v *Vacation) Days() int {
+	return int(v.EndDate.Sub(v.StartDate).Hours()/24) + 1
+}
+
+// 日毎の未完了の復習数（キーは"2006-01-02"形式の日付）を、休暇の開始日以降の分だけ休暇の日数分ずらした結果を見積もる。
+// ずらした先が休息日なら休息日でない次の日にする（バッチ・登録時のSQLと同じ規則）。
+// 返すのは休暇の開始日から、休暇中の復習がずれ込む最後の日までの日毎の復習数
+func (v *Vacation) PreviewLoad(counts map[string]int, calendar *RestDays) []*VacationDailyLoad {
+	after := make(map[string]int, len(counts))
+	for key, count := range counts {
+		date, err := time.Parse("2006-01-02", key)
+		if err != nil {
+			continue
+		}
+		if date.Before(v.StartDate) {
+			after[key] += count
+			continue
+		}
+		shifted := calendar.NextAvailableDate(date.AddDate(0, 0, v.Days()))
+		after[shifted.Format("2006-01-02")] += count
+	}
+
+	lastDate := v.EndDate.AddDate(0, 0, v.Days())
+	loads := make([]*VacationDailyLoad, 0, 2*v.Days())
+	for date := v.StartDate; !date.After(lastDate); date = date.AddDate(0, 0, 1) {
+		key := date.Format("2006-01-02")
+		loads = append(loads, &VacationDailyLoad{
+			Date:   date,
+			Before: counts[key],
+			After:  after[key],
+		})
+	}
+	return loads
+}
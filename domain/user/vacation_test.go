@@ -0,0 +1,155 @@
+package user
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewVacation(t *testing.T) {
+	today := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		startDate time.Time
+		endDate   time.Time
+		wantDays  int
+		wantErr   bool
+		errIs     error
+	}{
+		// 正常系
+		{
+			name:      "1週間の休暇（正常系）",
+			startDate: time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2025, 8, 16, 0, 0, 0, 0, time.UTC),
+			wantDays:  7,
+			wantErr:   false,
+		},
+		{
+			name:      "今日から1日だけの休暇（正常系）",
+			startDate: today,
+			endDate:   today,
+			wantDays:  1,
+			wantErr:   false,
+		},
+
+		// 異常系
+		{
+			name:    "開始日と終了日が未指定（異常系）",
+			wantErr: true,
+			errIs:   ErrVacationPeriodRequired,
+		},
+		{
+			name:      "開始日が今日より前（異常系）",
+			startDate: time.Date(2025, 7, 31, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2025, 8, 3, 0, 0, 0, 0, time.UTC),
+			wantErr:   true,
+			errIs:     ErrVacationStartBeforeToday,
+		},
+		{
+			name:      "終了日が開始日より前（異常系）",
+			startDate: time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2025, 8, 9, 0, 0, 0, 0, time.UTC),
+			wantErr:   true,
+			errIs:     ErrVacationEndBeforeStart,
+		},
+		{
+			name:      "休暇が365日を超える（異常系）",
+			startDate: today,
+			endDate:   today.AddDate(0, 0, MaxVacationDays),
+			wantErr:   true,
+			errIs:     ErrVacationTooLong,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := NewVacation("vacation1", "user1", tc.startDate, tc.endDate, today)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("エラーが発生することを期待しましたが、nilでした")
+				}
+				if !errors.Is(err, tc.errIs) {
+					t.Errorf("エラーが異なります: got %v, want %v", err, tc.errIs)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+			if got := v.Days(); got != tc.wantDays {
+				t.Errorf("Days() = %d, want %d", got, tc.wantDays)
+			}
+		})
+	}
+}
+
+func TestVacation_PreviewLoad(t *testing.T) {
+	// 2025-08-04(月)〜2025-08-06(水)の3日間の休暇
+	vacation := &Vacation{
+		VacationID: "vacation1",
+		UserID:     "user1",
+		StartDate:  time.Date(2025, 8, 4, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name     string
+		counts   map[string]int
+		restDays *RestDays
+		want     []*VacationDailyLoad
+	}{
+		{
+			name: "休暇中の復習が休暇明けの同じ曜日にずれる",
+			counts: map[string]int{
+				"2025-08-04": 2,
+				"2025-08-06": 1,
+				"2025-08-08": 3,
+			},
+			restDays: &RestDays{UserID: "user1"},
+			want: []*VacationDailyLoad{
+				{Date: time.Date(2025, 8, 4, 0, 0, 0, 0, time.UTC), Before: 2, After: 0},
+				{Date: time.Date(2025, 8, 5, 0, 0, 0, 0, time.UTC), Before: 0, After: 0},
+				{Date: time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC), Before: 1, After: 0},
+				{Date: time.Date(2025, 8, 7, 0, 0, 0, 0, time.UTC), Before: 0, After: 2},
+				{Date: time.Date(2025, 8, 8, 0, 0, 0, 0, time.UTC), Before: 3, After: 0},
+				{Date: time.Date(2025, 8, 9, 0, 0, 0, 0, time.UTC), Before: 0, After: 1},
+			},
+		},
+		{
+			name: "ずらした先が休息日なら次の日に数える",
+			counts: map[string]int{
+				"2025-08-04": 2,
+				"2025-08-06": 1,
+			},
+			// 2025-08-09は土曜日
+			restDays: &RestDays{UserID: "user1", Weekdays: []time.Weekday{time.Saturday}},
+			want: []*VacationDailyLoad{
+				{Date: time.Date(2025, 8, 4, 0, 0, 0, 0, time.UTC), Before: 2, After: 0},
+				{Date: time.Date(2025, 8, 5, 0, 0, 0, 0, time.UTC), Before: 0, After: 0},
+				{Date: time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC), Before: 1, After: 0},
+				{Date: time.Date(2025, 8, 7, 0, 0, 0, 0, time.UTC), Before: 0, After: 2},
+				{Date: time.Date(2025, 8, 8, 0, 0, 0, 0, time.UTC), Before: 0, After: 0},
+				{Date: time.Date(2025, 8, 9, 0, 0, 0, 0, time.UTC), Before: 0, After: 0},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := vacation.PreviewLoad(tc.counts, tc.restDays)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("PreviewLoad() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -34,6 +34,7 @@ func (r iteratorForCreatePatternSteps) Values() ([]interface{}, error) {
 		r.rows[0].PatternID,
 		r.rows[0].StepNumber,
 		r.rows[0].IntervalDays,
+		r.rows[0].Version,
 	}, nil
 }
 
@@ -43,7 +44,7 @@ func (r iteratorForCreatePatternSteps) Err() error {
 
 // 新規一括挿入時と、一括更新時に使う
 func (q *Queries) CreatePatternSteps(ctx context.Context, arg []CreatePatternStepsParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"pattern_steps"}, []string{"id", "user_id", "pattern_id", "step_number", "interval_days"}, &iteratorForCreatePatternSteps{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"pattern_steps"}, []string{"id", "user_id", "pattern_id", "step_number", "interval_days", "version"}, &iteratorForCreatePatternSteps{rows: arg})
 }
 
 // iteratorForCreateReviewDates implements pgx.CopyFromSource.
@@ -73,8 +74,10 @@ func (r iteratorForCreateReviewDates) Values() ([]interface{}, error) {
 		r.rows[0].ItemID,
 		r.rows[0].StepNumber,
 		r.rows[0].InitialScheduledDate,
+		r.rows[0].OriginalScheduledDate,
 		r.rows[0].ScheduledDate,
 		r.rows[0].IsCompleted,
+		r.rows[0].CompletedDate,
 	}, nil
 }
 
@@ -84,5 +87,5 @@ func (r iteratorForCreateReviewDates) Err() error {
 
 // 新規一括挿入時と、一括更新時に使う
 func (q *Queries) CreateReviewDates(ctx context.Context, arg []CreateReviewDatesParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"review_dates"}, []string{"id", "user_id", "category_id", "box_id", "item_id", "step_number", "initial_scheduled_date", "scheduled_date", "is_completed"}, &iteratorForCreateReviewDates{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"review_dates"}, []string{"id", "user_id", "category_id", "box_id", "item_id", "step_number", "initial_scheduled_date", "original_scheduled_date", "scheduled_date", "is_completed", "completed_date"}, &iteratorForCreateReviewDates{rows: arg})
 }
@@ -15,49 +15,148 @@ const countAllDailyReviewDates = `-- name: CountAllDailyReviewDates :one
 SELECT
     COUNT(*) AS count
 FROM
-    review_dates
+    review_dates rd
+JOIN
+    review_items ri
+ON
+    ri.id = rd.item_id
+LEFT JOIN
+    review_patterns rp
+ON
+    rp.id = ri.pattern_id
 WHERE
-    user_id = $1
+    rd.user_id = $1
+AND (
+    rd.scheduled_date = $2
+OR (
+    -- 期限切れのまま残す復習パターンでは、未完了の期限切れの復習日も今日の復習に含める
+    rp.overdue_policy = 'keep'
+    AND rd.is_completed = false
+    AND rd.scheduled_date < $2
+))
 AND
-    scheduled_date = $2
+    ($3::uuid IS NULL OR EXISTS (SELECT 1 FROM review_item_tags rit WHERE rit.item_id = rd.item_id AND rit.tag_id = $3))
 `
 
 type CountAllDailyReviewDatesParams struct {
 	UserID     pgtype.UUID `json:"user_id"`
 	TargetDate pgtype.Date `json:"target_date"`
+	TagID      pgtype.UUID `json:"tag_id"`
 }
 
-// 今日の全復習日数を取得
+// 今日の全復習日数を取得（期限切れのまま残している復習日を含む。タグで絞り込み可能）
 func (q *Queries) CountAllDailyReviewDates(ctx context.Context, arg CountAllDailyReviewDatesParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countAllDailyReviewDates, arg.UserID, arg.TargetDate)
+	row := q.db.QueryRow(ctx, countAllDailyReviewDates, arg.UserID, arg.TargetDate, arg.TagID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
-const countDailyDatesGroupedByBoxByUserID = `-- name: CountDailyDatesGroupedByBoxByUserID :many
+const countCompletedReviewsGroupedByCompletedDate = `-- name: CountCompletedReviewsGroupedByCompletedDate :many
 SELECT
-    category_id,
-    box_id,
+    completed_date,
     COUNT(*) AS count
 FROM
     review_dates
 WHERE
     user_id = $1
 AND
-    scheduled_date = $2
+    is_completed = true
+AND
+    completed_date BETWEEN $2 AND $3
 AND
-    is_completed = false
+    ($4::uuid IS NULL OR category_id = $4)
 AND
-    box_id IS NOT NULL
+    ($5::uuid IS NULL OR box_id = $5)
+AND
+    (NOT $6::boolean OR (box_id IS NULL AND ($4::uuid IS NOT NULL OR category_id IS NULL)))
 GROUP BY
-    category_id,
-    box_id
+    completed_date
+ORDER BY
+    completed_date
+`
+
+type CountCompletedReviewsGroupedByCompletedDateParams struct {
+	UserID       pgtype.UUID `json:"user_id"`
+	FromDate     pgtype.Date `json:"from_date"`
+	ToDate       pgtype.Date `json:"to_date"`
+	CategoryID   pgtype.UUID `json:"category_id"`
+	BoxID        pgtype.UUID `json:"box_id"`
+	Unclassified bool        `json:"unclassified"`
+}
+
+type CountCompletedReviewsGroupedByCompletedDateRow struct {
+	CompletedDate pgtype.Date `json:"completed_date"`
+	Count         int64       `json:"count"`
+}
+
+// ヒートマップ用に、指定期間の日毎に完了した復習日数を取得（カテゴリー・ボックス・未分類で絞り込み可能）
+func (q *Queries) CountCompletedReviewsGroupedByCompletedDate(ctx context.Context, arg CountCompletedReviewsGroupedByCompletedDateParams) ([]CountCompletedReviewsGroupedByCompletedDateRow, error) {
+	rows, err := q.db.Query(ctx, countCompletedReviewsGroupedByCompletedDate,
+		arg.UserID,
+		arg.FromDate,
+		arg.ToDate,
+		arg.CategoryID,
+		arg.BoxID,
+		arg.Unclassified,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountCompletedReviewsGroupedByCompletedDateRow{}
+	for rows.Next() {
+		var i CountCompletedReviewsGroupedByCompletedDateRow
+		if err := rows.Scan(&i.CompletedDate, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countDailyDatesGroupedByBoxByUserID = `-- name: CountDailyDatesGroupedByBoxByUserID :many
+SELECT
+    rd.category_id,
+    rd.box_id,
+    COUNT(*) AS count
+FROM
+    review_dates rd
+JOIN
+    review_items ri
+ON
+    ri.id = rd.item_id
+LEFT JOIN
+    review_patterns rp
+ON
+    rp.id = ri.pattern_id
+WHERE
+    rd.user_id = $1
+AND
+    rd.is_completed = false
+AND (
+    rd.scheduled_date = $2
+OR (
+    -- 期限切れのまま残す復習パターンでは、期限切れの復習日も今日の復習に含める（CountAllDailyReviewDatesと同じ条件）
+    rp.overdue_policy = 'keep'
+    AND rd.scheduled_date < $2
+))
+AND
+    rd.box_id IS NOT NULL
+AND
+    ($3::uuid IS NULL OR EXISTS (SELECT 1 FROM review_item_tags rit WHERE rit.item_id = rd.item_id AND rit.tag_id = $3))
+GROUP BY
+    rd.category_id,
+    rd.box_id
 `
 
 type CountDailyDatesGroupedByBoxByUserIDParams struct {
 	UserID     pgtype.UUID `json:"user_id"`
 	TargetDate pgtype.Date `json:"target_date"`
+	TagID      pgtype.UUID `json:"tag_id"`
 }
 
 type CountDailyDatesGroupedByBoxByUserIDRow struct {
@@ -67,7 +166,7 @@ type CountDailyDatesGroupedByBoxByUserIDRow struct {
 }
 
 func (q *Queries) CountDailyDatesGroupedByBoxByUserID(ctx context.Context, arg CountDailyDatesGroupedByBoxByUserIDParams) ([]CountDailyDatesGroupedByBoxByUserIDRow, error) {
-	rows, err := q.db.Query(ctx, countDailyDatesGroupedByBoxByUserID, arg.UserID, arg.TargetDate)
+	rows, err := q.db.Query(ctx, countDailyDatesGroupedByBoxByUserID, arg.UserID, arg.TargetDate, arg.TagID)
 	if err != nil {
 		return nil, err
 	}
@@ -90,24 +189,39 @@ const countDailyDatesUnclassifiedByUserID = `-- name: CountDailyDatesUnclassifie
 SELECT
     COUNT(*) AS count
 FROM
-    review_dates
+    review_dates rd
+JOIN
+    review_items ri
+ON
+    ri.id = rd.item_id
+LEFT JOIN
+    review_patterns rp
+ON
+    rp.id = ri.pattern_id
 WHERE
-    user_id = $1
+    rd.user_id = $1
 AND
-    scheduled_date = $2
+    rd.is_completed = false
+AND (
+    rd.scheduled_date = $2
+OR (
+    rp.overdue_policy = 'keep'
+    AND rd.scheduled_date < $2
+))
 AND
-    is_completed = false
+    rd.box_id IS NULL
 AND
-    box_id IS NULL
+    ($3::uuid IS NULL OR EXISTS (SELECT 1 FROM review_item_tags rit WHERE rit.item_id = rd.item_id AND rit.tag_id = $3))
 `
 
 type CountDailyDatesUnclassifiedByUserIDParams struct {
 	UserID     pgtype.UUID `json:"user_id"`
 	TargetDate pgtype.Date `json:"target_date"`
+	TagID      pgtype.UUID `json:"tag_id"`
 }
 
 func (q *Queries) CountDailyDatesUnclassifiedByUserID(ctx context.Context, arg CountDailyDatesUnclassifiedByUserIDParams) ([]int64, error) {
-	rows, err := q.db.Query(ctx, countDailyDatesUnclassifiedByUserID, arg.UserID, arg.TargetDate)
+	rows, err := q.db.Query(ctx, countDailyDatesUnclassifiedByUserID, arg.UserID, arg.TargetDate, arg.TagID)
 	if err != nil {
 		return nil, err
 	}
@@ -128,25 +242,40 @@ func (q *Queries) CountDailyDatesUnclassifiedByUserID(ctx context.Context, arg C
 
 const countDailyDatesUnclassifiedGroupedByCategoryByUserID = `-- name: CountDailyDatesUnclassifiedGroupedByCategoryByUserID :many
 SELECT
-    category_id,
+    rd.category_id,
     COUNT(*) AS count
 FROM
-    review_dates
+    review_dates rd
+JOIN
+    review_items ri
+ON
+    ri.id = rd.item_id
+LEFT JOIN
+    review_patterns rp
+ON
+    rp.id = ri.pattern_id
 WHERE
-    user_id = $1
+    rd.user_id = $1
 AND
-    scheduled_date = $2
+    rd.is_completed = false
+AND (
+    rd.scheduled_date = $2
+OR (
+    rp.overdue_policy = 'keep'
+    AND rd.scheduled_date < $2
+))
 AND
-    is_completed = false
+    rd.box_id IS NULL
 AND
-    box_id IS NULL
+    ($3::uuid IS NULL OR EXISTS (SELECT 1 FROM review_item_tags rit WHERE rit.item_id = rd.item_id AND rit.tag_id = $3))
 GROUP BY
-    category_id
+    rd.category_id
 `
 
 type CountDailyDatesUnclassifiedGroupedByCategoryByUserIDParams struct {
 	UserID     pgtype.UUID `json:"user_id"`
 	TargetDate pgtype.Date `json:"target_date"`
+	TagID      pgtype.UUID `json:"tag_id"`
 }
 
 type CountDailyDatesUnclassifiedGroupedByCategoryByUserIDRow struct {
@@ -155,7 +284,7 @@ type CountDailyDatesUnclassifiedGroupedByCategoryByUserIDRow struct {
 }
 
 func (q *Queries) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, arg CountDailyDatesUnclassifiedGroupedByCategoryByUserIDParams) ([]CountDailyDatesUnclassifiedGroupedByCategoryByUserIDRow, error) {
-	rows, err := q.db.Query(ctx, countDailyDatesUnclassifiedGroupedByCategoryByUserID, arg.UserID, arg.TargetDate)
+	rows, err := q.db.Query(ctx, countDailyDatesUnclassifiedGroupedByCategoryByUserID, arg.UserID, arg.TargetDate, arg.TagID)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +303,53 @@ func (q *Queries) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx conte
 	return items, nil
 }
 
+const countIncompleteReviewDatesGroupedByScheduledDate = `-- name: CountIncompleteReviewDatesGroupedByScheduledDate :many
+SELECT
+    scheduled_date,
+    COUNT(*) AS count
+FROM
+    review_dates
+WHERE
+    user_id = $1
+AND
+    ($2::uuid IS NULL OR item_id <> $2)
+AND
+    is_completed = false
+GROUP BY
+    scheduled_date
+`
+
+type CountIncompleteReviewDatesGroupedByScheduledDateParams struct {
+	UserID         pgtype.UUID `json:"user_id"`
+	ExcludedItemID pgtype.UUID `json:"excluded_item_id"`
+}
+
+type CountIncompleteReviewDatesGroupedByScheduledDateRow struct {
+	ScheduledDate pgtype.Date `json:"scheduled_date"`
+	Count         int64       `json:"count"`
+}
+
+// 未完了の復習日数を日付毎に取得（復習日の負荷分散で使う。再計算対象の復習物を指定した場合はその復習物自身を除く）
+func (q *Queries) CountIncompleteReviewDatesGroupedByScheduledDate(ctx context.Context, arg CountIncompleteReviewDatesGroupedByScheduledDateParams) ([]CountIncompleteReviewDatesGroupedByScheduledDateRow, error) {
+	rows, err := q.db.Query(ctx, countIncompleteReviewDatesGroupedByScheduledDate, arg.UserID, arg.ExcludedItemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountIncompleteReviewDatesGroupedByScheduledDateRow{}
+	for rows.Next() {
+		var i CountIncompleteReviewDatesGroupedByScheduledDateRow
+		if err := rows.Scan(&i.ScheduledDate, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countItemsGroupedByBoxByUserID = `-- name: CountItemsGroupedByBoxByUserID :many
 
 SELECT
@@ -218,6 +394,204 @@ func (q *Queries) CountItemsGroupedByBoxByUserID(ctx context.Context, userID pgt
 	return items, nil
 }
 
+const countLearnedItemsGroupedByLearnedDate = `-- name: CountLearnedItemsGroupedByLearnedDate :many
+SELECT
+    learned_date,
+    COUNT(*) AS count
+FROM
+    review_items
+WHERE
+    user_id = $1
+AND
+    learned_date BETWEEN $2 AND $3
+AND
+    ($4::uuid IS NULL OR category_id = $4)
+AND
+    ($5::uuid IS NULL OR box_id = $5)
+AND
+    (NOT $6::boolean OR (box_id IS NULL AND ($4::uuid IS NOT NULL OR category_id IS NULL)))
+GROUP BY
+    learned_date
+ORDER BY
+    learned_date
+`
+
+type CountLearnedItemsGroupedByLearnedDateParams struct {
+	UserID       pgtype.UUID `json:"user_id"`
+	FromDate     pgtype.Date `json:"from_date"`
+	ToDate       pgtype.Date `json:"to_date"`
+	CategoryID   pgtype.UUID `json:"category_id"`
+	BoxID        pgtype.UUID `json:"box_id"`
+	Unclassified bool        `json:"unclassified"`
+}
+
+type CountLearnedItemsGroupedByLearnedDateRow struct {
+	LearnedDate pgtype.Date `json:"learned_date"`
+	Count       int64       `json:"count"`
+}
+
+// ヒートマップ用に、指定期間の日毎に学習した復習物数を取得（カテゴリー・ボックス・未分類で絞り込み可能）
+func (q *Queries) CountLearnedItemsGroupedByLearnedDate(ctx context.Context, arg CountLearnedItemsGroupedByLearnedDateParams) ([]CountLearnedItemsGroupedByLearnedDateRow, error) {
+	rows, err := q.db.Query(ctx, countLearnedItemsGroupedByLearnedDate,
+		arg.UserID,
+		arg.FromDate,
+		arg.ToDate,
+		arg.CategoryID,
+		arg.BoxID,
+		arg.Unclassified,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountLearnedItemsGroupedByLearnedDateRow{}
+	for rows.Next() {
+		var i CountLearnedItemsGroupedByLearnedDateRow
+		if err := rows.Scan(&i.LearnedDate, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countReviewCompletionGroupedByOriginalScheduledDate = `-- name: CountReviewCompletionGroupedByOriginalScheduledDate :many
+SELECT
+    rd.original_scheduled_date,
+    COUNT(*) AS due_count,
+    COUNT(*) FILTER (WHERE rd.is_completed = true) AS completed_count
+FROM
+    review_dates rd
+JOIN
+    review_items ri ON rd.item_id = ri.id
+WHERE
+    rd.user_id = $1
+AND
+    rd.original_scheduled_date BETWEEN $2 AND $3
+AND
+    (rd.is_completed = true OR ri.is_finished = false)
+GROUP BY
+    rd.original_scheduled_date
+ORDER BY
+    rd.original_scheduled_date
+`
+
+type CountReviewCompletionGroupedByOriginalScheduledDateParams struct {
+	UserID   pgtype.UUID `json:"user_id"`
+	FromDate pgtype.Date `json:"from_date"`
+	ToDate   pgtype.Date `json:"to_date"`
+}
+
+type CountReviewCompletionGroupedByOriginalScheduledDateRow struct {
+	OriginalScheduledDate pgtype.Date `json:"original_scheduled_date"`
+	DueCount              int64       `json:"due_count"`
+	CompletedCount        int64       `json:"completed_count"`
+}
+
+// 完了率の計算用に、指定期間の日毎の予定されていた復習日数と完了済みの復習日数を取得（途中完了した復習物の未完了の復習日は数えない）
+// 期限切れのずらしで動いた日ではなく、作成した時点の予定日で数える
+func (q *Queries) CountReviewCompletionGroupedByOriginalScheduledDate(ctx context.Context, arg CountReviewCompletionGroupedByOriginalScheduledDateParams) ([]CountReviewCompletionGroupedByOriginalScheduledDateRow, error) {
+	rows, err := q.db.Query(ctx, countReviewCompletionGroupedByOriginalScheduledDate, arg.UserID, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountReviewCompletionGroupedByOriginalScheduledDateRow{}
+	for rows.Next() {
+		var i CountReviewCompletionGroupedByOriginalScheduledDateRow
+		if err := rows.Scan(&i.OriginalScheduledDate, &i.DueCount, &i.CompletedCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countReviewForecastByUserID = `-- name: CountReviewForecastByUserID :many
+
+SELECT
+    GREATEST(rd.scheduled_date, $2::date)::date AS scheduled_date,
+    rd.category_id,
+    rd.box_id,
+    COUNT(*) AS count
+FROM
+    review_dates rd
+JOIN
+    review_items ri
+ON
+    ri.id = rd.item_id
+LEFT JOIN
+    review_patterns rp
+ON
+    rp.id = ri.pattern_id
+WHERE
+    rd.user_id = $1
+AND
+    rd.is_completed = false
+AND (
+    rd.scheduled_date BETWEEN $2 AND $3
+OR (
+    rp.overdue_policy = 'keep'
+    AND rd.scheduled_date < $2
+))
+AND
+    ($4::uuid IS NULL OR EXISTS (SELECT 1 FROM review_item_tags rit WHERE rit.item_id = rd.item_id AND rit.tag_id = $4))
+GROUP BY
+    GREATEST(rd.scheduled_date, $2::date),
+    rd.category_id,
+    rd.box_id
+ORDER BY
+    GREATEST(rd.scheduled_date, $2::date),
+    rd.category_id,
+    rd.box_id
+`
+
+type CountReviewForecastByUserIDParams struct {
+	UserID   pgtype.UUID `json:"user_id"`
+	FromDate pgtype.Date `json:"from_date"`
+	ToDate   pgtype.Date `json:"to_date"`
+	TagID    pgtype.UUID `json:"tag_id"`
+}
+
+type CountReviewForecastByUserIDRow struct {
+	ScheduledDate pgtype.Date `json:"scheduled_date"`
+	CategoryID    pgtype.UUID `json:"category_id"`
+	BoxID         pgtype.UUID `json:"box_id"`
+	Count         int64       `json:"count"`
+}
+
+// 指定期間の日毎・カテゴリー毎・ボックス毎の未完了の復習日数を取得（負荷予測用。タグで絞り込み可能。keepの期限切れ分は開始日に計上）
+func (q *Queries) CountReviewForecastByUserID(ctx context.Context, arg CountReviewForecastByUserIDParams) ([]CountReviewForecastByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, countReviewForecastByUserID, arg.UserID, arg.FromDate, arg.ToDate, arg.TagID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountReviewForecastByUserIDRow{}
+	for rows.Next() {
+		var i CountReviewForecastByUserIDRow
+		if err := rows.Scan(
+			&i.ScheduledDate,
+			&i.CategoryID,
+			&i.BoxID,
+			&i.Count,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countUnclassifiedItemsByUserID = `-- name: CountUnclassifiedItemsByUserID :many
 SELECT
     COUNT(*) AS count
@@ -300,6 +674,7 @@ INSERT INTO
         category_id,
         box_id,
         pattern_id,
+        pattern_version,
         name,
         detail,
         learned_date,
@@ -318,22 +693,24 @@ VALUES (
     $8,
     $9,
     $10,
-    $11
+    $11,
+    $12
     )
 `
 
 type CreateItemParams struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	CategoryID   pgtype.UUID        `json:"category_id"`
-	BoxID        pgtype.UUID        `json:"box_id"`
-	PatternID    pgtype.UUID        `json:"pattern_id"`
-	Name         string             `json:"name"`
-	Detail       pgtype.Text        `json:"detail"`
-	LearnedDate  pgtype.Date        `json:"learned_date"`
-	IsFinished   bool               `json:"is_finished"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
 }
 
 func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) error {
@@ -343,6 +720,7 @@ func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) error {
 		arg.CategoryID,
 		arg.BoxID,
 		arg.PatternID,
+		arg.PatternVersion,
 		arg.Name,
 		arg.Detail,
 		arg.LearnedDate,
@@ -354,45 +732,287 @@ func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) error {
 }
 
 type CreateReviewDatesParams struct {
-	ID                   pgtype.UUID `json:"id"`
-	UserID               pgtype.UUID `json:"user_id"`
-	CategoryID           pgtype.UUID `json:"category_id"`
-	BoxID                pgtype.UUID `json:"box_id"`
-	ItemID               pgtype.UUID `json:"item_id"`
-	StepNumber           int16       `json:"step_number"`
-	InitialScheduledDate pgtype.Date `json:"initial_scheduled_date"`
-	ScheduledDate        pgtype.Date `json:"scheduled_date"`
-	IsCompleted          bool        `json:"is_completed"`
-}
-
-const deleteItem = `-- name: DeleteItem :exec
-DELETE
-FROM
-    review_items
-WHERE
-    id = $1
-AND
-    user_id = $2
+	ID                    pgtype.UUID `json:"id"`
+	UserID                pgtype.UUID `json:"user_id"`
+	CategoryID            pgtype.UUID `json:"category_id"`
+	BoxID                 pgtype.UUID `json:"box_id"`
+	ItemID                pgtype.UUID `json:"item_id"`
+	StepNumber            int16       `json:"step_number"`
+	InitialScheduledDate  pgtype.Date `json:"initial_scheduled_date"`
+	OriginalScheduledDate pgtype.Date `json:"original_scheduled_date"`
+	ScheduledDate         pgtype.Date `json:"scheduled_date"`
+	IsCompleted           bool        `json:"is_completed"`
+	CompletedDate         pgtype.Date `json:"completed_date"`
+}
+
+const createItemOperationSnapshot = `-- name: CreateItemOperationSnapshot :exec
+INSERT INTO item_operation_snapshots (
+    operation_id,
+    user_id,
+    item_id,
+    kind,
+    item_snapshot,
+    review_dates_snapshot,
+    review_logs_snapshot,
+    review_failures_snapshot,
+    item_tags_snapshot,
+    operated_at
+)
+SELECT
+    $1::uuid,
+    $2::uuid,
+    $3::uuid,
+    $4::item_operation_kind_enum,
+    (
+        SELECT
+            to_jsonb(ri)
+        FROM
+            review_items ri
+        WHERE
+            ri.id = $3
+        AND
+            ri.user_id = $2
+    ),
+    COALESCE((
+        SELECT
+            jsonb_agg(to_jsonb(rd) ORDER BY rd.step_number)
+        FROM
+            review_dates rd
+        WHERE
+            rd.item_id = $3
+        AND
+            rd.user_id = $2
+    ), '[]'::jsonb),
+    COALESCE((
+        SELECT
+            jsonb_agg(to_jsonb(rl) ORDER BY rl.reviewed_at, rl.id)
+        FROM
+            review_logs rl
+        WHERE
+            rl.item_id = $3
+        AND
+            rl.user_id = $2
+    ), '[]'::jsonb),
+    COALESCE((
+        SELECT
+            jsonb_agg(to_jsonb(rf) ORDER BY rf.created_at, rf.id)
+        FROM
+            review_failures rf
+        WHERE
+            rf.item_id = $3
+        AND
+            rf.user_id = $2
+    ), '[]'::jsonb),
+    COALESCE((
+        SELECT
+            jsonb_agg(to_jsonb(rit) ORDER BY rit.tag_id)
+        FROM
+            review_item_tags rit
+        JOIN
+            tags t ON t.id = rit.tag_id
+        WHERE
+            rit.item_id = $3
+        AND
+            t.user_id = $2
+    ), '[]'::jsonb),
+    $5::timestamptz
 `
 
-type DeleteItemParams struct {
-	ID     pgtype.UUID `json:"id"`
-	UserID pgtype.UUID `json:"user_id"`
+type CreateItemOperationSnapshotParams struct {
+	OperationID pgtype.UUID           `json:"operation_id"`
+	UserID      pgtype.UUID           `json:"user_id"`
+	ItemID      pgtype.UUID           `json:"item_id"`
+	Kind        ItemOperationKindEnum `json:"kind"`
+	OperatedAt  pgtype.Timestamptz    `json:"operated_at"`
 }
 
-func (q *Queries) DeleteItem(ctx context.Context, arg DeleteItemParams) error {
-	_, err := q.db.Exec(ctx, deleteItem, arg.ID, arg.UserID)
+// 操作の直前の復習物・復習日・履歴・想起失敗・タグの紐付けの行をJSONBでそのまま保存する（復習物がまだない場合はitem_snapshotがNULLになる）
+func (q *Queries) CreateItemOperationSnapshot(ctx context.Context, arg CreateItemOperationSnapshotParams) error {
+	_, err := q.db.Exec(ctx, createItemOperationSnapshot,
+		arg.OperationID,
+		arg.UserID,
+		arg.ItemID,
+		arg.Kind,
+		arg.OperatedAt,
+	)
 	return err
 }
 
-const deleteReviewDates = `-- name: DeleteReviewDates :exec
-DELETE
-FROM
-    review_dates
-WHERE
-    item_id = $1
-AND
-    user_id = $2
+const createReviewFailure = `-- name: CreateReviewFailure :exec
+INSERT INTO
+    review_failures (
+        id,
+        user_id,
+        item_id,
+        step_number,
+        scheduled_date,
+        failed_date
+    )
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+    )
+`
+
+type CreateReviewFailureParams struct {
+	ID            pgtype.UUID `json:"id"`
+	UserID        pgtype.UUID `json:"user_id"`
+	ItemID        pgtype.UUID `json:"item_id"`
+	StepNumber    int16       `json:"step_number"`
+	ScheduledDate pgtype.Date `json:"scheduled_date"`
+	FailedDate    pgtype.Date `json:"failed_date"`
+}
+
+// 想起失敗の記録
+func (q *Queries) CreateReviewFailure(ctx context.Context, arg CreateReviewFailureParams) error {
+	_, err := q.db.Exec(ctx, createReviewFailure,
+		arg.ID,
+		arg.UserID,
+		arg.ItemID,
+		arg.StepNumber,
+		arg.ScheduledDate,
+		arg.FailedDate,
+	)
+	return err
+}
+
+const createReviewLog = `-- name: CreateReviewLog :exec
+INSERT INTO
+    review_logs (
+        id,
+        user_id,
+        item_id,
+        review_date_id,
+        step_number,
+        outcome,
+        grade,
+        duration_seconds,
+        reviewed_date,
+        reviewed_at
+    )
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+    )
+`
+
+type CreateReviewLogParams struct {
+	ID              pgtype.UUID        `json:"id"`
+	UserID          pgtype.UUID        `json:"user_id"`
+	ItemID          pgtype.UUID        `json:"item_id"`
+	ReviewDateID    pgtype.UUID        `json:"review_date_id"`
+	StepNumber      int16              `json:"step_number"`
+	Outcome         ReviewOutcomeEnum  `json:"outcome"`
+	Grade           pgtype.Int2        `json:"grade"`
+	DurationSeconds pgtype.Int4        `json:"duration_seconds"`
+	ReviewedDate    pgtype.Date        `json:"reviewed_date"`
+	ReviewedAt      pgtype.Timestamptz `json:"reviewed_at"`
+}
+
+// 復習日を完了・未完了にした履歴の記録
+func (q *Queries) CreateReviewLog(ctx context.Context, arg CreateReviewLogParams) error {
+	_, err := q.db.Exec(ctx, createReviewLog,
+		arg.ID,
+		arg.UserID,
+		arg.ItemID,
+		arg.ReviewDateID,
+		arg.StepNumber,
+		arg.Outcome,
+		arg.Grade,
+		arg.DurationSeconds,
+		arg.ReviewedDate,
+		arg.ReviewedAt,
+	)
+	return err
+}
+
+const deleteItem = `-- name: DeleteItem :exec
+DELETE
+FROM
+    review_items
+WHERE
+    id = $1
+AND
+    user_id = $2
+`
+
+type DeleteItemParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteItem(ctx context.Context, arg DeleteItemParams) error {
+	_, err := q.db.Exec(ctx, deleteItem, arg.ID, arg.UserID)
+	return err
+}
+
+const deleteItemOperationSnapshots = `-- name: DeleteItemOperationSnapshots :exec
+DELETE FROM
+    item_operation_snapshots
+WHERE
+    operation_id = $1
+AND
+    user_id = $2
+`
+
+type DeleteItemOperationSnapshotsParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteItemOperationSnapshots(ctx context.Context, arg DeleteItemOperationSnapshotsParams) error {
+	_, err := q.db.Exec(ctx, deleteItemOperationSnapshots, arg.OperationID, arg.UserID)
+	return err
+}
+
+const deleteItemsCreatedByItemOperation = `-- name: DeleteItemsCreatedByItemOperation :exec
+DELETE FROM
+    review_items ri
+USING
+    item_operation_snapshots s
+WHERE
+    s.operation_id = $1
+AND
+    s.user_id = $2
+AND
+    s.item_snapshot IS NULL
+AND
+    ri.id = s.item_id
+AND
+    ri.user_id = s.user_id
+`
+
+type DeleteItemsCreatedByItemOperationParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+// 作成の操作を取り消すため、操作の前になかった復習物を削除する（復習日はカスケードで削除される）
+func (q *Queries) DeleteItemsCreatedByItemOperation(ctx context.Context, arg DeleteItemsCreatedByItemOperationParams) error {
+	_, err := q.db.Exec(ctx, deleteItemsCreatedByItemOperation, arg.OperationID, arg.UserID)
+	return err
+}
+
+const deleteReviewDates = `-- name: DeleteReviewDates :exec
+DELETE
+FROM
+    review_dates
+WHERE
+    item_id = $1
+AND
+    user_id = $2
 `
 
 type DeleteReviewDatesParams struct {
@@ -406,6 +1026,121 @@ func (q *Queries) DeleteReviewDates(ctx context.Context, arg DeleteReviewDatesPa
 	return err
 }
 
+const deleteReviewDatesByIDs = `-- name: DeleteReviewDatesByIDs :exec
+
+DELETE
+FROM
+    review_dates
+WHERE
+    id = ANY($1::uuid[])
+AND
+    user_id = $2
+`
+
+type DeleteReviewDatesByIDsParams struct {
+	Ids    []pgtype.UUID `json:"ids"`
+	UserID pgtype.UUID   `json:"user_id"`
+}
+
+// 復習パターンのステップ変更を既存の復習物に反映する時に、未完了の復習日のうち変更後のステップに対応しないものを削除する
+func (q *Queries) DeleteReviewDatesByIDs(ctx context.Context, arg DeleteReviewDatesByIDsParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewDatesByIDs, arg.Ids, arg.UserID)
+	return err
+}
+
+const deleteReviewDatesNotInItemOperationSnapshots = `-- name: DeleteReviewDatesNotInItemOperationSnapshots :exec
+DELETE FROM
+    review_dates rd
+USING
+    item_operation_snapshots s
+WHERE
+    s.operation_id = $1
+AND
+    s.user_id = $2
+AND
+    s.item_snapshot IS NOT NULL
+AND
+    rd.item_id = s.item_id
+AND
+    rd.user_id = s.user_id
+AND
+    NOT EXISTS (
+        SELECT
+            1
+        FROM
+            jsonb_populate_recordset(NULL::review_dates, s.review_dates_snapshot) d
+        WHERE
+            d.id = rd.id
+    )
+`
+
+type DeleteReviewDatesNotInItemOperationSnapshotsParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+// 操作の後に作られた復習日を削除する（同じステップ番号の復習日を戻す前に消しておく）
+func (q *Queries) DeleteReviewDatesNotInItemOperationSnapshots(ctx context.Context, arg DeleteReviewDatesNotInItemOperationSnapshotsParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewDatesNotInItemOperationSnapshots, arg.OperationID, arg.UserID)
+	return err
+}
+
+const deleteReviewFailuresRecordedSinceItemOperation = `-- name: DeleteReviewFailuresRecordedSinceItemOperation :exec
+DELETE FROM
+    review_failures rf
+USING
+    item_operation_snapshots s
+WHERE
+    s.operation_id = $1
+AND
+    s.user_id = $2
+AND
+    rf.item_id = s.item_id
+AND
+    rf.user_id = s.user_id
+AND
+    rf.created_at >= s.created_at
+`
+
+type DeleteReviewFailuresRecordedSinceItemOperationParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+// 取り消す操作とその後の操作で記録された想起失敗を削除する
+func (q *Queries) DeleteReviewFailuresRecordedSinceItemOperation(ctx context.Context, arg DeleteReviewFailuresRecordedSinceItemOperationParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewFailuresRecordedSinceItemOperation, arg.OperationID, arg.UserID)
+	return err
+}
+
+const deleteReviewLogsRecordedSinceItemOperation = `-- name: DeleteReviewLogsRecordedSinceItemOperation :exec
+DELETE FROM
+    review_logs rl
+USING
+    item_operation_snapshots s
+WHERE
+    s.operation_id = $1
+AND
+    s.user_id = $2
+AND
+    rl.item_id = s.item_id
+AND
+    rl.user_id = s.user_id
+AND
+    rl.created_at >= s.created_at
+`
+
+type DeleteReviewLogsRecordedSinceItemOperationParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+// 取り消す操作とその後の操作で記録された復習の履歴を削除する（スナップショットと同じトランザクションで記録されるため作成日時で判定する）
+func (q *Queries) DeleteReviewLogsRecordedSinceItemOperation(ctx context.Context, arg DeleteReviewLogsRecordedSinceItemOperationParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewLogsRecordedSinceItemOperation, arg.OperationID, arg.UserID)
+	return err
+}
+
 const getAllDailyReviewDates = `-- name: GetAllDailyReviewDates :many
 SELECT
     rd.id,
@@ -422,7 +1157,8 @@ SELECT
     ri.detail,
     ri.learned_date,
     ri.registered_at,
-    ri.edited_at
+    ri.edited_at,
+    rp.target_weight
 FROM (
     SELECT
         id,
@@ -454,8 +1190,18 @@ JOIN
     review_items AS ri
 ON
     ri.id = rd.item_id
+LEFT JOIN
+    review_patterns AS rp
+ON
+    rp.id = ri.pattern_id
 WHERE
     rd.scheduled_date = $2::date
+OR (
+    -- 期限切れのまま残す復習パターンでは、未完了の期限切れの復習日も今日の復習に含める
+    rp.overdue_policy = 'keep'
+    AND rd.is_completed = false
+    AND rd.scheduled_date < $2::date
+)
 ORDER BY
     rd.category_id    NULLS LAST,
     rd.box_id         NULLS LAST,
@@ -468,26 +1214,27 @@ type GetAllDailyReviewDatesParams struct {
 }
 
 type GetAllDailyReviewDatesRow struct {
-	ID                   pgtype.UUID        `json:"id"`
-	CategoryID           pgtype.UUID        `json:"category_id"`
-	BoxID                pgtype.UUID        `json:"box_id"`
-	StepNumber           int16              `json:"step_number"`
-	InitialScheduledDate pgtype.Date        `json:"initial_scheduled_date"`
-	PrevScheduledDate    pgtype.Date        `json:"prev_scheduled_date"`
-	ScheduledDate        pgtype.Date        `json:"scheduled_date"`
-	NextScheduledDate    pgtype.Date        `json:"next_scheduled_date"`
-	IsCompleted          bool               `json:"is_completed"`
-	ItemID               pgtype.UUID        `json:"item_id"`
-	Name                 string             `json:"name"`
-	Detail               pgtype.Text        `json:"detail"`
-	LearnedDate          pgtype.Date        `json:"learned_date"`
-	RegisteredAt         pgtype.Timestamptz `json:"registered_at"`
-	EditedAt             pgtype.Timestamptz `json:"edited_at"`
+	ID                   pgtype.UUID          `json:"id"`
+	CategoryID           pgtype.UUID          `json:"category_id"`
+	BoxID                pgtype.UUID          `json:"box_id"`
+	StepNumber           int16                `json:"step_number"`
+	InitialScheduledDate pgtype.Date          `json:"initial_scheduled_date"`
+	PrevScheduledDate    pgtype.Date          `json:"prev_scheduled_date"`
+	ScheduledDate        pgtype.Date          `json:"scheduled_date"`
+	NextScheduledDate    pgtype.Date          `json:"next_scheduled_date"`
+	IsCompleted          bool                 `json:"is_completed"`
+	ItemID               pgtype.UUID          `json:"item_id"`
+	Name                 string               `json:"name"`
+	Detail               pgtype.Text          `json:"detail"`
+	LearnedDate          pgtype.Date          `json:"learned_date"`
+	RegisteredAt         pgtype.Timestamptz   `json:"registered_at"`
+	EditedAt             pgtype.Timestamptz   `json:"edited_at"`
+	TargetWeight         NullTargetWeightEnum `json:"target_weight"`
 }
 
 // LAG→item_idごとにstep_numberの昇順で並べた時、scheduled_dateが持つstep_numberより一個前のstep_numberのscheduled_dateを取得
 // LEAD→item_idごとにstep_numberの昇順で並べた時、scheduled_dateが持つstep_numberより一個後のstep_numberのscheduled_dateを取得
-// 今日の復習日を取得するクエリ
+// 今日の復習日を取得するクエリ（期限切れのまま残している復習日を含む）
 func (q *Queries) GetAllDailyReviewDates(ctx context.Context, arg GetAllDailyReviewDatesParams) ([]GetAllDailyReviewDatesRow, error) {
 	rows, err := q.db.Query(ctx, getAllDailyReviewDates, arg.UserID, arg.Today)
 	if err != nil {
@@ -513,6 +1260,7 @@ func (q *Queries) GetAllDailyReviewDates(ctx context.Context, arg GetAllDailyRev
 			&i.LearnedDate,
 			&i.RegisteredAt,
 			&i.EditedAt,
+			&i.TargetWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -534,7 +1282,8 @@ SELECT
     step_number,
     initial_scheduled_date,
     scheduled_date,
-    is_completed
+    is_completed,
+    completed_date
 FROM
     review_dates
 WHERE
@@ -561,6 +1310,7 @@ type GetAllReviewDatesByBoxIDRow struct {
 	InitialScheduledDate pgtype.Date `json:"initial_scheduled_date"`
 	ScheduledDate        pgtype.Date `json:"scheduled_date"`
 	IsCompleted          bool        `json:"is_completed"`
+	CompletedDate        pgtype.Date `json:"completed_date"`
 }
 
 // 　ボックス内画面用の全復習物一覧取得機能（復習日（子）のみ一覧取得（親は区別しない。親が未完了復習物かどうかも区別しない））。
@@ -583,6 +1333,7 @@ func (q *Queries) GetAllReviewDatesByBoxID(ctx context.Context, arg GetAllReview
 			&i.InitialScheduledDate,
 			&i.ScheduledDate,
 			&i.IsCompleted,
+			&i.CompletedDate,
 		); err != nil {
 			return nil, err
 		}
@@ -601,6 +1352,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -625,17 +1377,18 @@ type GetAllUnFinishedItemsByBoxIDParams struct {
 }
 
 type GetAllUnFinishedItemsByBoxIDRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	CategoryID   pgtype.UUID        `json:"category_id"`
-	BoxID        pgtype.UUID        `json:"box_id"`
-	PatternID    pgtype.UUID        `json:"pattern_id"`
-	Name         string             `json:"name"`
-	Detail       pgtype.Text        `json:"detail"`
-	LearnedDate  pgtype.Date        `json:"learned_date"`
-	IsFinished   bool               `json:"is_finished"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
 }
 
 // ボックス内画面用の未完了の全復習物一覧取得機能（復習物（親）のみ一覧取得）
@@ -654,6 +1407,7 @@ func (q *Queries) GetAllUnFinishedItemsByBoxID(ctx context.Context, arg GetAllUn
 			&i.CategoryID,
 			&i.BoxID,
 			&i.PatternID,
+			&i.PatternVersion,
 			&i.Name,
 			&i.Detail,
 			&i.LearnedDate,
@@ -678,6 +1432,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -704,17 +1459,18 @@ type GetAllUnFinishedUnclassifiedItemsByCategoryIDParams struct {
 }
 
 type GetAllUnFinishedUnclassifiedItemsByCategoryIDRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	CategoryID   pgtype.UUID        `json:"category_id"`
-	BoxID        pgtype.UUID        `json:"box_id"`
-	PatternID    pgtype.UUID        `json:"pattern_id"`
-	Name         string             `json:"name"`
-	Detail       pgtype.Text        `json:"detail"`
-	LearnedDate  pgtype.Date        `json:"learned_date"`
-	IsFinished   bool               `json:"is_finished"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
 }
 
 func (q *Queries) GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx context.Context, arg GetAllUnFinishedUnclassifiedItemsByCategoryIDParams) ([]GetAllUnFinishedUnclassifiedItemsByCategoryIDRow, error) {
@@ -732,6 +1488,7 @@ func (q *Queries) GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx context.Cont
 			&i.CategoryID,
 			&i.BoxID,
 			&i.PatternID,
+			&i.PatternVersion,
 			&i.Name,
 			&i.Detail,
 			&i.LearnedDate,
@@ -756,6 +1513,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -777,17 +1535,18 @@ ORDER BY
 `
 
 type GetAllUnFinishedUnclassifiedItemsByUserIDRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	CategoryID   pgtype.UUID        `json:"category_id"`
-	BoxID        pgtype.UUID        `json:"box_id"`
-	PatternID    pgtype.UUID        `json:"pattern_id"`
-	Name         string             `json:"name"`
-	Detail       pgtype.Text        `json:"detail"`
-	LearnedDate  pgtype.Date        `json:"learned_date"`
-	IsFinished   bool               `json:"is_finished"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
 }
 
 // ホーム画面の未分類未完了復習物
@@ -806,6 +1565,7 @@ func (q *Queries) GetAllUnFinishedUnclassifiedItemsByUserID(ctx context.Context,
 			&i.CategoryID,
 			&i.BoxID,
 			&i.PatternID,
+			&i.PatternVersion,
 			&i.Name,
 			&i.Detail,
 			&i.LearnedDate,
@@ -833,7 +1593,8 @@ SELECT
     step_number,
     initial_scheduled_date,
     scheduled_date,
-    is_completed
+    is_completed,
+    completed_date
 FROM
     review_dates
 WHERE
@@ -862,6 +1623,7 @@ type GetAllUnclassifiedReviewDatesByCategoryIDRow struct {
 	InitialScheduledDate pgtype.Date `json:"initial_scheduled_date"`
 	ScheduledDate        pgtype.Date `json:"scheduled_date"`
 	IsCompleted          bool        `json:"is_completed"`
+	CompletedDate        pgtype.Date `json:"completed_date"`
 }
 
 func (q *Queries) GetAllUnclassifiedReviewDatesByCategoryID(ctx context.Context, arg GetAllUnclassifiedReviewDatesByCategoryIDParams) ([]GetAllUnclassifiedReviewDatesByCategoryIDRow, error) {
@@ -883,6 +1645,7 @@ func (q *Queries) GetAllUnclassifiedReviewDatesByCategoryID(ctx context.Context,
 			&i.InitialScheduledDate,
 			&i.ScheduledDate,
 			&i.IsCompleted,
+			&i.CompletedDate,
 		); err != nil {
 			return nil, err
 		}
@@ -904,7 +1667,8 @@ SELECT
     step_number,
     initial_scheduled_date,
     scheduled_date,
-    is_completed
+    is_completed,
+    completed_date
 FROM
     review_dates
 WHERE
@@ -928,6 +1692,7 @@ type GetAllUnclassifiedReviewDatesByUserIDRow struct {
 	InitialScheduledDate pgtype.Date `json:"initial_scheduled_date"`
 	ScheduledDate        pgtype.Date `json:"scheduled_date"`
 	IsCompleted          bool        `json:"is_completed"`
+	CompletedDate        pgtype.Date `json:"completed_date"`
 }
 
 func (q *Queries) GetAllUnclassifiedReviewDatesByUserID(ctx context.Context, userID pgtype.UUID) ([]GetAllUnclassifiedReviewDatesByUserIDRow, error) {
@@ -949,6 +1714,7 @@ func (q *Queries) GetAllUnclassifiedReviewDatesByUserID(ctx context.Context, use
 			&i.InitialScheduledDate,
 			&i.ScheduledDate,
 			&i.IsCompleted,
+			&i.CompletedDate,
 		); err != nil {
 			return nil, err
 		}
@@ -960,6 +1726,70 @@ func (q *Queries) GetAllUnclassifiedReviewDatesByUserID(ctx context.Context, use
 	return items, nil
 }
 
+const getAverageReviewDelayByUserID = `-- name: GetAverageReviewDelayByUserID :one
+SELECT
+    AVG(completed_date - original_scheduled_date)::float8 AS average_delay_days
+FROM
+    review_dates
+WHERE
+    user_id = $1
+AND
+    is_completed = true
+AND
+    completed_date IS NOT NULL
+`
+
+// 作成した時点の予定日から実際に完了した日までの平均の日数を取得（完了した日を記録していない復習日は除く）
+func (q *Queries) GetAverageReviewDelayByUserID(ctx context.Context, userID pgtype.UUID) (pgtype.Float8, error) {
+	row := q.db.QueryRow(ctx, getAverageReviewDelayByUserID, userID)
+	var average_delay_days pgtype.Float8
+	err := row.Scan(&average_delay_days)
+	return average_delay_days, err
+}
+
+const getCompletedDatesByUserID = `-- name: GetCompletedDatesByUserID :many
+SELECT DISTINCT
+    completed_date
+FROM
+    review_dates
+WHERE
+    user_id = $1
+AND
+    is_completed = true
+AND
+    completed_date IS NOT NULL
+AND
+    completed_date <= $2
+ORDER BY
+    completed_date
+`
+
+type GetCompletedDatesByUserIDParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	ToDate pgtype.Date `json:"to_date"`
+}
+
+// 連続学習日数の計算用に、復習日を完了した日を重複なく古い順に取得
+func (q *Queries) GetCompletedDatesByUserID(ctx context.Context, arg GetCompletedDatesByUserIDParams) ([]pgtype.Date, error) {
+	rows, err := q.db.Query(ctx, getCompletedDatesByUserID, arg.UserID, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.Date{}
+	for rows.Next() {
+		var completed_date pgtype.Date
+		if err := rows.Scan(&completed_date); err != nil {
+			return nil, err
+		}
+		items = append(items, completed_date)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getEditedAtByItemID = `-- name: GetEditedAtByItemID :one
 SELECT
     edited_at
@@ -991,6 +1821,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -1015,17 +1846,18 @@ type GetFinishedItemsByBoxIDParams struct {
 }
 
 type GetFinishedItemsByBoxIDRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	CategoryID   pgtype.UUID        `json:"category_id"`
-	BoxID        pgtype.UUID        `json:"box_id"`
-	PatternID    pgtype.UUID        `json:"pattern_id"`
-	Name         string             `json:"name"`
-	Detail       pgtype.Text        `json:"detail"`
-	LearnedDate  pgtype.Date        `json:"learned_date"`
-	IsFinished   bool               `json:"is_finished"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
 }
 
 // ボックス内画面用の完了の全復習物一覧取得系（復習物（親）のみ一覧取得）
@@ -1044,6 +1876,7 @@ func (q *Queries) GetFinishedItemsByBoxID(ctx context.Context, arg GetFinishedIt
 			&i.CategoryID,
 			&i.BoxID,
 			&i.PatternID,
+			&i.PatternVersion,
 			&i.Name,
 			&i.Detail,
 			&i.LearnedDate,
@@ -1068,6 +1901,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -1088,17 +1922,18 @@ type GetItemByIDParams struct {
 }
 
 type GetItemByIDRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	CategoryID   pgtype.UUID        `json:"category_id"`
-	BoxID        pgtype.UUID        `json:"box_id"`
-	PatternID    pgtype.UUID        `json:"pattern_id"`
-	Name         string             `json:"name"`
-	Detail       pgtype.Text        `json:"detail"`
-	LearnedDate  pgtype.Date        `json:"learned_date"`
-	IsFinished   bool               `json:"is_finished"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
 }
 
 // 学習日変更など、どういうリクエストなのかを判定するために使う
@@ -1111,6 +1946,7 @@ func (q *Queries) GetItemByID(ctx context.Context, arg GetItemByIDParams) (GetIt
 		&i.CategoryID,
 		&i.BoxID,
 		&i.PatternID,
+		&i.PatternVersion,
 		&i.Name,
 		&i.Detail,
 		&i.LearnedDate,
@@ -1121,6 +1957,210 @@ func (q *Queries) GetItemByID(ctx context.Context, arg GetItemByIDParams) (GetIt
 	return i, err
 }
 
+const getItemIDsByTagID = `-- name: GetItemIDsByTagID :many
+SELECT
+    it.item_id
+FROM
+    review_item_tags it
+JOIN
+    tags t ON t.id = it.tag_id
+WHERE
+    it.tag_id = $1
+AND
+    t.user_id = $2
+`
+
+type GetItemIDsByTagIDParams struct {
+	TagID  pgtype.UUID `json:"tag_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+// 一覧・今日の復習日をタグで絞り込むために使う
+func (q *Queries) GetItemIDsByTagID(ctx context.Context, arg GetItemIDsByTagIDParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, getItemIDsByTagID, arg.TagID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var item_id pgtype.UUID
+		if err := rows.Scan(&item_id); err != nil {
+			return nil, err
+		}
+		items = append(items, item_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getItemOperationsByUserID = `-- name: GetItemOperationsByUserID :many
+SELECT
+    operation_id,
+    kind,
+    array_agg(item_id ORDER BY created_at, id)::uuid[] AS item_ids,
+    operated_at
+FROM
+    item_operation_snapshots
+WHERE
+    user_id = $1
+AND
+    operated_at >= $2
+GROUP BY
+    operation_id,
+    kind,
+    operated_at
+ORDER BY
+    operated_at DESC,
+    operation_id DESC
+LIMIT $3
+`
+
+type GetItemOperationsByUserIDParams struct {
+	UserID        pgtype.UUID        `json:"user_id"`
+	OperatedFrom  pgtype.Timestamptz `json:"operated_from"`
+	MaxOperations int32              `json:"max_operations"`
+}
+
+type GetItemOperationsByUserIDRow struct {
+	OperationID pgtype.UUID           `json:"operation_id"`
+	Kind        ItemOperationKindEnum `json:"kind"`
+	ItemIds     []pgtype.UUID         `json:"item_ids"`
+	OperatedAt  pgtype.Timestamptz    `json:"operated_at"`
+}
+
+// 指定日時以降の操作を新しい順に取得する
+func (q *Queries) GetItemOperationsByUserID(ctx context.Context, arg GetItemOperationsByUserIDParams) ([]GetItemOperationsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, getItemOperationsByUserID, arg.UserID, arg.OperatedFrom, arg.MaxOperations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetItemOperationsByUserIDRow
+	for rows.Next() {
+		var i GetItemOperationsByUserIDRow
+		if err := rows.Scan(
+			&i.OperationID,
+			&i.Kind,
+			&i.ItemIds,
+			&i.OperatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLeechItemsByUserID = `-- name: GetLeechItemsByUserID :many
+SELECT
+    ri.id,
+    ri.category_id,
+    ri.box_id,
+    ri.name,
+    ri.slip_count,
+    COUNT(rf.id) AS failure_count
+FROM
+    review_items ri
+LEFT JOIN
+    review_failures rf
+ON
+    rf.item_id = ri.id
+WHERE
+    ri.user_id = $1
+AND
+    ri.is_finished = false
+GROUP BY
+    ri.id
+HAVING
+    ri.slip_count >= $2
+OR
+    COUNT(rf.id) >= $3::bigint
+ORDER BY
+    ri.slip_count + COUNT(rf.id) DESC,
+    ri.registered_at
+`
+
+type GetLeechItemsByUserIDParams struct {
+	UserID           pgtype.UUID `json:"user_id"`
+	SlipThreshold    int32       `json:"slip_threshold"`
+	FailureThreshold int64       `json:"failure_threshold"`
+}
+
+type GetLeechItemsByUserIDRow struct {
+	ID           pgtype.UUID `json:"id"`
+	CategoryID   pgtype.UUID `json:"category_id"`
+	BoxID        pgtype.UUID `json:"box_id"`
+	Name         string      `json:"name"`
+	SlipCount    int32       `json:"slip_count"`
+	FailureCount int64       `json:"failure_count"`
+}
+
+// ずらされた回数か想起に失敗した回数が基準以上の、完了していない復習物を取得（回数の多い順）
+func (q *Queries) GetLeechItemsByUserID(ctx context.Context, arg GetLeechItemsByUserIDParams) ([]GetLeechItemsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, getLeechItemsByUserID, arg.UserID, arg.SlipThreshold, arg.FailureThreshold)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLeechItemsByUserIDRow
+	for rows.Next() {
+		var i GetLeechItemsByUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.BoxID,
+			&i.Name,
+			&i.SlipCount,
+			&i.FailureCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMemoryStateByItemID = `-- name: GetMemoryStateByItemID :one
+SELECT
+    ease_factor,
+    stability,
+    difficulty
+FROM
+    review_items
+WHERE
+    id = $1
+AND
+    user_id = $2
+`
+
+type GetMemoryStateByItemIDParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+type GetMemoryStateByItemIDRow struct {
+	EaseFactor float64 `json:"ease_factor"`
+	Stability  float64 `json:"stability"`
+	Difficulty float64 `json:"difficulty"`
+}
+
+// 想起度に応じたスケジューリングで使う記憶の状態の取得
+func (q *Queries) GetMemoryStateByItemID(ctx context.Context, arg GetMemoryStateByItemIDParams) (GetMemoryStateByItemIDRow, error) {
+	row := q.db.QueryRow(ctx, getMemoryStateByItemID, arg.ID, arg.UserID)
+	var i GetMemoryStateByItemIDRow
+	err := row.Scan(&i.EaseFactor, &i.Stability, &i.Difficulty)
+	return i, err
+}
+
 const getReviewDateIDsByItemID = `-- name: GetReviewDateIDsByItemID :many
 SELECT
     id
@@ -1146,13 +2186,235 @@ func (q *Queries) GetReviewDateIDsByItemID(ctx context.Context, arg GetReviewDat
 		return nil, err
 	}
 	defer rows.Close()
-	items := []pgtype.UUID{}
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewDatesByItemID = `-- name: GetReviewDatesByItemID :many
+SELECT
+    id,
+    user_id,
+    category_id,
+    box_id,
+    item_id,
+    step_number,
+    initial_scheduled_date,
+    scheduled_date,
+    is_completed,
+    completed_date
+FROM
+    review_dates
+WHERE
+    item_id = $1
+AND
+    user_id = $2
+ORDER BY
+    step_number
+`
+
+type GetReviewDatesByItemIDParams struct {
+	ItemID pgtype.UUID `json:"item_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+type GetReviewDatesByItemIDRow struct {
+	ID                   pgtype.UUID `json:"id"`
+	UserID               pgtype.UUID `json:"user_id"`
+	CategoryID           pgtype.UUID `json:"category_id"`
+	BoxID                pgtype.UUID `json:"box_id"`
+	ItemID               pgtype.UUID `json:"item_id"`
+	StepNumber           int16       `json:"step_number"`
+	InitialScheduledDate pgtype.Date `json:"initial_scheduled_date"`
+	ScheduledDate        pgtype.Date `json:"scheduled_date"`
+	IsCompleted          bool        `json:"is_completed"`
+	CompletedDate        pgtype.Date `json:"completed_date"`
+}
+
+func (q *Queries) GetReviewDatesByItemID(ctx context.Context, arg GetReviewDatesByItemIDParams) ([]GetReviewDatesByItemIDRow, error) {
+	rows, err := q.db.Query(ctx, getReviewDatesByItemID, arg.ItemID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReviewDatesByItemIDRow{}
+	for rows.Next() {
+		var i GetReviewDatesByItemIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CategoryID,
+			&i.BoxID,
+			&i.ItemID,
+			&i.StepNumber,
+			&i.InitialScheduledDate,
+			&i.ScheduledDate,
+			&i.IsCompleted,
+			&i.CompletedDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewDatesOfUnFinishedItemsByPatternID = `-- name: GetReviewDatesOfUnFinishedItemsByPatternID :many
+
+SELECT
+    rd.id,
+    rd.user_id,
+    rd.category_id,
+    rd.box_id,
+    rd.item_id,
+    rd.step_number,
+    rd.initial_scheduled_date,
+    rd.scheduled_date,
+    rd.is_completed,
+    rd.completed_date
+FROM
+    review_dates rd
+JOIN
+    review_items ri ON rd.item_id = ri.id
+WHERE
+    ri.pattern_id = $1
+AND
+    ri.user_id = $2
+AND
+    ri.is_finished = false
+ORDER BY
+    rd.item_id,
+    rd.step_number
+`
+
+type GetReviewDatesOfUnFinishedItemsByPatternIDParams struct {
+	PatternID pgtype.UUID `json:"pattern_id"`
+	UserID    pgtype.UUID `json:"user_id"`
+}
+
+type GetReviewDatesOfUnFinishedItemsByPatternIDRow struct {
+	ID                   pgtype.UUID `json:"id"`
+	UserID               pgtype.UUID `json:"user_id"`
+	CategoryID           pgtype.UUID `json:"category_id"`
+	BoxID                pgtype.UUID `json:"box_id"`
+	ItemID               pgtype.UUID `json:"item_id"`
+	StepNumber           int16       `json:"step_number"`
+	InitialScheduledDate pgtype.Date `json:"initial_scheduled_date"`
+	ScheduledDate        pgtype.Date `json:"scheduled_date"`
+	IsCompleted          bool        `json:"is_completed"`
+	CompletedDate        pgtype.Date `json:"completed_date"`
+}
+
+// 復習パターンのステップ変更を反映する対象の、パターンに紐づく未完了の復習物が持つ復習日を取得
+func (q *Queries) GetReviewDatesOfUnFinishedItemsByPatternID(ctx context.Context, arg GetReviewDatesOfUnFinishedItemsByPatternIDParams) ([]GetReviewDatesOfUnFinishedItemsByPatternIDRow, error) {
+	rows, err := q.db.Query(ctx, getReviewDatesOfUnFinishedItemsByPatternID, arg.PatternID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReviewDatesOfUnFinishedItemsByPatternIDRow{}
+	for rows.Next() {
+		var i GetReviewDatesOfUnFinishedItemsByPatternIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CategoryID,
+			&i.BoxID,
+			&i.ItemID,
+			&i.StepNumber,
+			&i.InitialScheduledDate,
+			&i.ScheduledDate,
+			&i.IsCompleted,
+			&i.CompletedDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewLogsByItemID = `-- name: GetReviewLogsByItemID :many
+SELECT
+    id,
+    user_id,
+    item_id,
+    review_date_id,
+    step_number,
+    outcome,
+    grade,
+    duration_seconds,
+    reviewed_date,
+    reviewed_at
+FROM
+    review_logs
+WHERE
+    item_id = $1
+AND
+    user_id = $2
+ORDER BY
+    reviewed_at DESC,
+    created_at DESC
+`
+
+type GetReviewLogsByItemIDParams struct {
+	ItemID pgtype.UUID `json:"item_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+type GetReviewLogsByItemIDRow struct {
+	ID              pgtype.UUID        `json:"id"`
+	UserID          pgtype.UUID        `json:"user_id"`
+	ItemID          pgtype.UUID        `json:"item_id"`
+	ReviewDateID    pgtype.UUID        `json:"review_date_id"`
+	StepNumber      int16              `json:"step_number"`
+	Outcome         ReviewOutcomeEnum  `json:"outcome"`
+	Grade           pgtype.Int2        `json:"grade"`
+	DurationSeconds pgtype.Int4        `json:"duration_seconds"`
+	ReviewedDate    pgtype.Date        `json:"reviewed_date"`
+	ReviewedAt      pgtype.Timestamptz `json:"reviewed_at"`
+}
+
+// 復習物の履歴を新しい順に取得
+func (q *Queries) GetReviewLogsByItemID(ctx context.Context, arg GetReviewLogsByItemIDParams) ([]GetReviewLogsByItemIDRow, error) {
+	rows, err := q.db.Query(ctx, getReviewLogsByItemID, arg.ItemID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReviewLogsByItemIDRow{}
 	for rows.Next() {
-		var id pgtype.UUID
-		if err := rows.Scan(&id); err != nil {
+		var i GetReviewLogsByItemIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ItemID,
+			&i.ReviewDateID,
+			&i.StepNumber,
+			&i.Outcome,
+			&i.Grade,
+			&i.DurationSeconds,
+			&i.ReviewedDate,
+			&i.ReviewedAt,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, id)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -1160,63 +2422,76 @@ func (q *Queries) GetReviewDateIDsByItemID(ctx context.Context, arg GetReviewDat
 	return items, nil
 }
 
-const getReviewDatesByItemID = `-- name: GetReviewDatesByItemID :many
+const getUnFinishedItemsByPatternID = `-- name: GetUnFinishedItemsByPatternID :many
+
 SELECT
     id,
     user_id,
     category_id,
     box_id,
-    item_id,
-    step_number,
-    initial_scheduled_date,
-    scheduled_date,
-    is_completed
+    pattern_id,
+    pattern_version,
+    name,
+    detail,
+    learned_date,
+    is_finished,
+    registered_at,
+    edited_at
 FROM
-    review_dates
+    review_items
 WHERE
-    item_id = $1
+    pattern_id = $1
 AND
     user_id = $2
+AND
+    is_finished = false
 ORDER BY
-    step_number
+    registered_at
 `
 
-type GetReviewDatesByItemIDParams struct {
-	ItemID pgtype.UUID `json:"item_id"`
-	UserID pgtype.UUID `json:"user_id"`
-}
-
-type GetReviewDatesByItemIDRow struct {
-	ID                   pgtype.UUID `json:"id"`
-	UserID               pgtype.UUID `json:"user_id"`
-	CategoryID           pgtype.UUID `json:"category_id"`
-	BoxID                pgtype.UUID `json:"box_id"`
-	ItemID               pgtype.UUID `json:"item_id"`
-	StepNumber           int16       `json:"step_number"`
-	InitialScheduledDate pgtype.Date `json:"initial_scheduled_date"`
-	ScheduledDate        pgtype.Date `json:"scheduled_date"`
-	IsCompleted          bool        `json:"is_completed"`
+type GetUnFinishedItemsByPatternIDParams struct {
+	PatternID pgtype.UUID `json:"pattern_id"`
+	UserID    pgtype.UUID `json:"user_id"`
 }
 
-func (q *Queries) GetReviewDatesByItemID(ctx context.Context, arg GetReviewDatesByItemIDParams) ([]GetReviewDatesByItemIDRow, error) {
-	rows, err := q.db.Query(ctx, getReviewDatesByItemID, arg.ItemID, arg.UserID)
+type GetUnFinishedItemsByPatternIDRow struct {
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
+}
+
+// 復習パターンのステップ変更を反映する対象の、パターンに紐づく未完了の復習物を取得
+func (q *Queries) GetUnFinishedItemsByPatternID(ctx context.Context, arg GetUnFinishedItemsByPatternIDParams) ([]GetUnFinishedItemsByPatternIDRow, error) {
+	rows, err := q.db.Query(ctx, getUnFinishedItemsByPatternID, arg.PatternID, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetReviewDatesByItemIDRow{}
+	items := []GetUnFinishedItemsByPatternIDRow{}
 	for rows.Next() {
-		var i GetReviewDatesByItemIDRow
+		var i GetUnFinishedItemsByPatternIDRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
 			&i.CategoryID,
 			&i.BoxID,
-			&i.ItemID,
-			&i.StepNumber,
-			&i.InitialScheduledDate,
-			&i.ScheduledDate,
-			&i.IsCompleted,
+			&i.PatternID,
+			&i.PatternVersion,
+			&i.Name,
+			&i.Detail,
+			&i.LearnedDate,
+			&i.IsFinished,
+			&i.RegisteredAt,
+			&i.EditedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1235,6 +2510,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -1261,17 +2537,18 @@ type GetUnclassfiedFinishedItemsByCategoryIDParams struct {
 }
 
 type GetUnclassfiedFinishedItemsByCategoryIDRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	CategoryID   pgtype.UUID        `json:"category_id"`
-	BoxID        pgtype.UUID        `json:"box_id"`
-	PatternID    pgtype.UUID        `json:"pattern_id"`
-	Name         string             `json:"name"`
-	Detail       pgtype.Text        `json:"detail"`
-	LearnedDate  pgtype.Date        `json:"learned_date"`
-	IsFinished   bool               `json:"is_finished"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
 }
 
 func (q *Queries) GetUnclassfiedFinishedItemsByCategoryID(ctx context.Context, arg GetUnclassfiedFinishedItemsByCategoryIDParams) ([]GetUnclassfiedFinishedItemsByCategoryIDRow, error) {
@@ -1289,6 +2566,7 @@ func (q *Queries) GetUnclassfiedFinishedItemsByCategoryID(ctx context.Context, a
 			&i.CategoryID,
 			&i.BoxID,
 			&i.PatternID,
+			&i.PatternVersion,
 			&i.Name,
 			&i.Detail,
 			&i.LearnedDate,
@@ -1313,6 +2591,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -1334,17 +2613,18 @@ ORDER BY
 `
 
 type GetUnclassfiedFinishedItemsByUserIDRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	CategoryID   pgtype.UUID        `json:"category_id"`
-	BoxID        pgtype.UUID        `json:"box_id"`
-	PatternID    pgtype.UUID        `json:"pattern_id"`
-	Name         string             `json:"name"`
-	Detail       pgtype.Text        `json:"detail"`
-	LearnedDate  pgtype.Date        `json:"learned_date"`
-	IsFinished   bool               `json:"is_finished"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
 }
 
 func (q *Queries) GetUnclassfiedFinishedItemsByUserID(ctx context.Context, userID pgtype.UUID) ([]GetUnclassfiedFinishedItemsByUserIDRow, error) {
@@ -1362,6 +2642,7 @@ func (q *Queries) GetUnclassfiedFinishedItemsByUserID(ctx context.Context, userI
 			&i.CategoryID,
 			&i.BoxID,
 			&i.PatternID,
+			&i.PatternVersion,
 			&i.Name,
 			&i.Detail,
 			&i.LearnedDate,
@@ -1406,6 +2687,64 @@ func (q *Queries) HasCompletedReviewDateByItemID(ctx context.Context, arg HasCom
 	return exists, err
 }
 
+const hasDeletedReferenceInItemOperationSnapshots = `-- name: HasDeletedReferenceInItemOperationSnapshots :one
+SELECT EXISTS (
+    SELECT
+        1
+    FROM
+        item_operation_snapshots s
+    CROSS JOIN LATERAL
+        jsonb_populate_record(NULL::review_items, s.item_snapshot) r
+    WHERE
+        s.operation_id = $1
+    AND
+        s.user_id = $2
+    AND
+        s.item_snapshot IS NOT NULL
+    AND (
+        (r.category_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM categories c WHERE c.id = r.category_id))
+        OR
+        (r.box_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM review_boxes b WHERE b.id = r.box_id))
+        OR
+        (r.pattern_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM review_patterns p WHERE p.id = r.pattern_id))
+    ))
+`
+
+type HasDeletedReferenceInItemOperationSnapshotsParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+// 保存した復習物が参照するカテゴリー・ボックス・復習パターンのうち、操作の後に削除されたものがあるか判別する
+func (q *Queries) HasDeletedReferenceInItemOperationSnapshots(ctx context.Context, arg HasDeletedReferenceInItemOperationSnapshotsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasDeletedReferenceInItemOperationSnapshots, arg.OperationID, arg.UserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const incrementItemSlipCount = `-- name: IncrementItemSlipCount :exec
+UPDATE
+    review_items
+SET
+    slip_count = slip_count + 1
+WHERE
+    id = $1
+AND
+    user_id = $2
+`
+
+type IncrementItemSlipCountParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+// 復習日を手動で先送りした回数をずらされた回数に加える
+func (q *Queries) IncrementItemSlipCount(ctx context.Context, arg IncrementItemSlipCountParams) error {
+	_, err := q.db.Exec(ctx, incrementItemSlipCount, arg.ID, arg.UserID)
+	return err
+}
+
 const isPatternRelatedToItemByPatternID = `-- name: IsPatternRelatedToItemByPatternID :one
 SELECT EXISTS (
     SELECT
@@ -1432,6 +2771,315 @@ func (q *Queries) IsPatternRelatedToItemByPatternID(ctx context.Context, arg IsP
 	return exists, err
 }
 
+const restoreItemTagsFromItemOperationSnapshots = `-- name: RestoreItemTagsFromItemOperationSnapshots :exec
+INSERT INTO review_item_tags (
+    item_id,
+    tag_id,
+    created_at
+)
+SELECT
+    t.item_id,
+    t.tag_id,
+    t.created_at
+FROM
+    item_operation_snapshots s
+CROSS JOIN LATERAL
+    jsonb_populate_recordset(NULL::review_item_tags, s.item_tags_snapshot) t
+WHERE
+    s.operation_id = $1
+AND
+    s.user_id = $2
+AND
+    s.item_snapshot IS NOT NULL
+AND
+    EXISTS (
+        SELECT
+            1
+        FROM
+            tags tg
+        WHERE
+            tg.id = t.tag_id
+        AND
+            tg.user_id = s.user_id
+    )
+ON CONFLICT (item_id, tag_id) DO NOTHING
+`
+
+type RestoreItemTagsFromItemOperationSnapshotsParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+// 保存した行でタグの紐付けを元に戻す（操作の後に削除されたタグは紐付けない）
+func (q *Queries) RestoreItemTagsFromItemOperationSnapshots(ctx context.Context, arg RestoreItemTagsFromItemOperationSnapshotsParams) error {
+	_, err := q.db.Exec(ctx, restoreItemTagsFromItemOperationSnapshots, arg.OperationID, arg.UserID)
+	return err
+}
+
+const restoreItemsFromItemOperationSnapshots = `-- name: RestoreItemsFromItemOperationSnapshots :exec
+INSERT INTO review_items
+SELECT
+    r.*
+FROM
+    item_operation_snapshots s
+CROSS JOIN LATERAL
+    jsonb_populate_record(NULL::review_items, s.item_snapshot) r
+WHERE
+    s.operation_id = $1
+AND
+    s.user_id = $2
+AND
+    s.item_snapshot IS NOT NULL
+ON CONFLICT (id) DO UPDATE SET
+    category_id = EXCLUDED.category_id,
+    box_id = EXCLUDED.box_id,
+    pattern_id = EXCLUDED.pattern_id,
+    name = EXCLUDED.name,
+    detail = EXCLUDED.detail,
+    learned_date = EXCLUDED.learned_date,
+    is_finished = EXCLUDED.is_finished,
+    registered_at = EXCLUDED.registered_at,
+    edited_at = EXCLUDED.edited_at,
+    ease_factor = EXCLUDED.ease_factor,
+    stability = EXCLUDED.stability,
+    difficulty = EXCLUDED.difficulty,
+    pattern_version = EXCLUDED.pattern_version,
+    slip_count = EXCLUDED.slip_count
+`
+
+type RestoreItemsFromItemOperationSnapshotsParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+// 保存した行で復習物を元に戻す（削除した復習物は作り直す）
+func (q *Queries) RestoreItemsFromItemOperationSnapshots(ctx context.Context, arg RestoreItemsFromItemOperationSnapshotsParams) error {
+	_, err := q.db.Exec(ctx, restoreItemsFromItemOperationSnapshots, arg.OperationID, arg.UserID)
+	return err
+}
+
+const restoreReviewDatesFromItemOperationSnapshots = `-- name: RestoreReviewDatesFromItemOperationSnapshots :exec
+INSERT INTO review_dates
+SELECT
+    d.*
+FROM
+    item_operation_snapshots s
+CROSS JOIN LATERAL
+    jsonb_populate_recordset(NULL::review_dates, s.review_dates_snapshot) d
+WHERE
+    s.operation_id = $1
+AND
+    s.user_id = $2
+AND
+    s.item_snapshot IS NOT NULL
+ON CONFLICT (id) DO UPDATE SET
+    category_id = EXCLUDED.category_id,
+    box_id = EXCLUDED.box_id,
+    step_number = EXCLUDED.step_number,
+    initial_scheduled_date = EXCLUDED.initial_scheduled_date,
+    scheduled_date = EXCLUDED.scheduled_date,
+    is_completed = EXCLUDED.is_completed,
+    completed_date = EXCLUDED.completed_date
+`
+
+type RestoreReviewDatesFromItemOperationSnapshotsParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+// 保存した行で復習日を元に戻す（削除した復習日は作り直す）
+func (q *Queries) RestoreReviewDatesFromItemOperationSnapshots(ctx context.Context, arg RestoreReviewDatesFromItemOperationSnapshotsParams) error {
+	_, err := q.db.Exec(ctx, restoreReviewDatesFromItemOperationSnapshots, arg.OperationID, arg.UserID)
+	return err
+}
+
+const restoreReviewFailuresFromItemOperationSnapshots = `-- name: RestoreReviewFailuresFromItemOperationSnapshots :exec
+INSERT INTO review_failures
+SELECT
+    f.*
+FROM
+    item_operation_snapshots s
+CROSS JOIN LATERAL
+    jsonb_populate_recordset(NULL::review_failures, s.review_failures_snapshot) f
+WHERE
+    s.operation_id = $1
+AND
+    s.user_id = $2
+AND
+    s.item_snapshot IS NOT NULL
+ON CONFLICT (id) DO NOTHING
+`
+
+type RestoreReviewFailuresFromItemOperationSnapshotsParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+// 保存した行で想起失敗を元に戻す（復習物と一緒に削除された想起失敗を作り直す）
+func (q *Queries) RestoreReviewFailuresFromItemOperationSnapshots(ctx context.Context, arg RestoreReviewFailuresFromItemOperationSnapshotsParams) error {
+	_, err := q.db.Exec(ctx, restoreReviewFailuresFromItemOperationSnapshots, arg.OperationID, arg.UserID)
+	return err
+}
+
+const restoreReviewLogsFromItemOperationSnapshots = `-- name: RestoreReviewLogsFromItemOperationSnapshots :exec
+INSERT INTO review_logs
+SELECT
+    l.*
+FROM
+    item_operation_snapshots s
+CROSS JOIN LATERAL
+    jsonb_populate_recordset(NULL::review_logs, s.review_logs_snapshot) l
+WHERE
+    s.operation_id = $1
+AND
+    s.user_id = $2
+AND
+    s.item_snapshot IS NOT NULL
+ON CONFLICT (id) DO UPDATE SET
+    review_date_id = EXCLUDED.review_date_id
+`
+
+type RestoreReviewLogsFromItemOperationSnapshotsParams struct {
+	OperationID pgtype.UUID `json:"operation_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+}
+
+// 保存した行で履歴を元に戻す（復習物と一緒に削除された履歴は作り直し、復習日の作り直しで外れた紐付けは戻す）
+func (q *Queries) RestoreReviewLogsFromItemOperationSnapshots(ctx context.Context, arg RestoreReviewLogsFromItemOperationSnapshotsParams) error {
+	_, err := q.db.Exec(ctx, restoreReviewLogsFromItemOperationSnapshots, arg.OperationID, arg.UserID)
+	return err
+}
+
+const searchItemsByUserID = `-- name: SearchItemsByUserID :many
+SELECT
+    id,
+    user_id,
+    category_id,
+    box_id,
+    pattern_id,
+    pattern_version,
+    name,
+    detail,
+    learned_date,
+    is_finished,
+    registered_at,
+    edited_at,
+    -- LIMITの前に数えるため、候補の上限に関わらず一致した全件数になる
+    COUNT(*) OVER () AS total_count
+FROM
+    review_items
+WHERE
+    user_id = $1
+AND
+    NOT EXISTS (
+        SELECT 1
+        FROM unnest($2::text[]) AS p(pattern)
+        WHERE NOT (name ILIKE p.pattern OR COALESCE(detail, '') ILIKE p.pattern)
+    )
+AND
+    ($3::uuid IS NULL OR category_id = $3)
+AND
+    ($4::uuid IS NULL OR box_id = $4)
+AND
+    ($5::date IS NULL OR learned_date >= $5)
+AND
+    ($6::date IS NULL OR learned_date <= $6)
+AND
+    ($7::boolean IS NULL OR is_finished = $7)
+ORDER BY
+    -- 候補の上限で関連度の高い復習物が漏れないよう、名前の完全一致・前方一致・部分一致・詳細の一致の順に点数を付けて並べる
+    -- 前方一致と完全一致のパターンは、部分一致のパターンの先頭・末尾の%を外して作る
+    (
+        SELECT
+            SUM(
+                CASE
+                    WHEN name ILIKE left(substr(p.pattern, 2), -1) THEN 100
+                    WHEN name ILIKE substr(p.pattern, 2) THEN 60
+                    WHEN name ILIKE p.pattern THEN 40
+                    ELSE 0
+                END
+                + CASE WHEN COALESCE(detail, '') ILIKE p.pattern THEN 10 ELSE 0 END
+            )
+        FROM unnest($2::text[]) AS p(pattern)
+    ) DESC,
+    learned_date DESC,
+    id
+LIMIT
+    $8
+`
+
+type SearchItemsByUserIDParams struct {
+	UserID         pgtype.UUID `json:"user_id"`
+	Patterns       []string    `json:"patterns"`
+	CategoryID     pgtype.UUID `json:"category_id"`
+	BoxID          pgtype.UUID `json:"box_id"`
+	LearnedFrom    pgtype.Date `json:"learned_from"`
+	LearnedTo      pgtype.Date `json:"learned_to"`
+	IsFinished     pgtype.Bool `json:"is_finished"`
+	CandidateLimit int32       `json:"candidate_limit"`
+}
+
+type SearchItemsByUserIDRow struct {
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
+	TotalCount     int64              `json:"total_count"`
+}
+
+// 名前か詳細に全てのパターンを含む復習物を取得（完了済み・未完了の両方が対象。カテゴリー・ボックス・学習日・完了状態で絞り込み可能）
+// パターンはLIKEの特殊文字をエスケープした上で%で囲んだもの
+func (q *Queries) SearchItemsByUserID(ctx context.Context, arg SearchItemsByUserIDParams) ([]SearchItemsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, searchItemsByUserID,
+		arg.UserID,
+		arg.Patterns,
+		arg.CategoryID,
+		arg.BoxID,
+		arg.LearnedFrom,
+		arg.LearnedTo,
+		arg.IsFinished,
+		arg.CandidateLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchItemsByUserIDRow{}
+	for rows.Next() {
+		var i SearchItemsByUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CategoryID,
+			&i.BoxID,
+			&i.PatternID,
+			&i.PatternVersion,
+			&i.Name,
+			&i.Detail,
+			&i.LearnedDate,
+			&i.IsFinished,
+			&i.RegisteredAt,
+			&i.EditedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateItem = `-- name: UpdateItem :exec
 UPDATE
     review_items
@@ -1439,28 +3087,30 @@ SET
     category_id = $1,
     box_id = $2,
     pattern_id = $3,
-    name = $4,
-    detail = $5,
-    learned_date = $6,
-    is_Finished = $7,
-    edited_at = $8
+    pattern_version = $4,
+    name = $5,
+    detail = $6,
+    learned_date = $7,
+    is_Finished = $8,
+    edited_at = $9
 WHERE
-    id = $9
+    id = $10
 AND
-    user_id = $10
+    user_id = $11
 `
 
 type UpdateItemParams struct {
-	CategoryID  pgtype.UUID        `json:"category_id"`
-	BoxID       pgtype.UUID        `json:"box_id"`
-	PatternID   pgtype.UUID        `json:"pattern_id"`
-	Name        string             `json:"name"`
-	Detail      pgtype.Text        `json:"detail"`
-	LearnedDate pgtype.Date        `json:"learned_date"`
-	IsFinished  bool               `json:"is_finished"`
-	EditedAt    pgtype.Timestamptz `json:"edited_at"`
-	ID          pgtype.UUID        `json:"id"`
-	UserID      pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
 }
 
 // 移動、完了、学習日変更、その他編集に使う
@@ -1469,6 +3119,7 @@ func (q *Queries) UpdateItem(ctx context.Context, arg UpdateItemParams) error {
 		arg.CategoryID,
 		arg.BoxID,
 		arg.PatternID,
+		arg.PatternVersion,
 		arg.Name,
 		arg.Detail,
 		arg.LearnedDate,
@@ -1526,24 +3177,84 @@ func (q *Queries) UpdateItemAsUnfinished(ctx context.Context, arg UpdateItemAsUn
 	return err
 }
 
+const updateMemoryState = `-- name: UpdateMemoryState :exec
+UPDATE
+    review_items
+SET
+    ease_factor = $1,
+    stability = $2,
+    difficulty = $3
+WHERE
+    id = $4
+AND
+    user_id = $5
+`
+
+type UpdateMemoryStateParams struct {
+	EaseFactor float64     `json:"ease_factor"`
+	Stability  float64     `json:"stability"`
+	Difficulty float64     `json:"difficulty"`
+	ID         pgtype.UUID `json:"id"`
+	UserID     pgtype.UUID `json:"user_id"`
+}
+
+// 想起度に応じたスケジューリングで使う記憶の状態の更新
+func (q *Queries) UpdateMemoryState(ctx context.Context, arg UpdateMemoryStateParams) error {
+	_, err := q.db.Exec(ctx, updateMemoryState,
+		arg.EaseFactor,
+		arg.Stability,
+		arg.Difficulty,
+		arg.ID,
+		arg.UserID,
+	)
+	return err
+}
+
+const updatePatternVersionOfUnFinishedItems = `-- name: UpdatePatternVersionOfUnFinishedItems :exec
+UPDATE
+    review_items
+SET
+    pattern_version = $1
+WHERE
+    pattern_id = $2
+AND
+    user_id = $3
+AND
+    is_finished = false
+`
+
+type UpdatePatternVersionOfUnFinishedItemsParams struct {
+	PatternVersion pgtype.Int4 `json:"pattern_version"`
+	PatternID      pgtype.UUID `json:"pattern_id"`
+	UserID         pgtype.UUID `json:"user_id"`
+}
+
+// 復習パターンのステップ変更を反映した未完了の復習物を、新しいバージョンに上げる
+func (q *Queries) UpdatePatternVersionOfUnFinishedItems(ctx context.Context, arg UpdatePatternVersionOfUnFinishedItemsParams) error {
+	_, err := q.db.Exec(ctx, updatePatternVersionOfUnFinishedItems, arg.PatternVersion, arg.PatternID, arg.UserID)
+	return err
+}
+
 const updateReviewDateAsCompleted = `-- name: UpdateReviewDateAsCompleted :exec
 UPDATE
     review_dates
 SET
-    is_completed = true
+    is_completed = true,
+    completed_date = $1
 WHERE
-    id = $1
+    id = $2
 AND
-    user_id = $2
+    user_id = $3
 `
 
 type UpdateReviewDateAsCompletedParams struct {
-	ID     pgtype.UUID `json:"id"`
-	UserID pgtype.UUID `json:"user_id"`
+	CompletedDate pgtype.Date `json:"completed_date"`
+	ID            pgtype.UUID `json:"id"`
+	UserID        pgtype.UUID `json:"user_id"`
 }
 
 func (q *Queries) UpdateReviewDateAsCompleted(ctx context.Context, arg UpdateReviewDateAsCompletedParams) error {
-	_, err := q.db.Exec(ctx, updateReviewDateAsCompleted, arg.ID, arg.UserID)
+	_, err := q.db.Exec(ctx, updateReviewDateAsCompleted, arg.CompletedDate, arg.ID, arg.UserID)
 	return err
 }
 
@@ -1551,7 +3262,8 @@ const updateReviewDateAsInCompleted = `-- name: UpdateReviewDateAsInCompleted :e
 UPDATE
     review_dates
 SET
-    is_completed = false
+    is_completed = false,
+    completed_date = NULL
 WHERE
     id = $1
 AND
@@ -1575,7 +3287,12 @@ SET
     box_id = v.box_id,
     initial_scheduled_date = v.initial_scheduled_date,
     scheduled_date = v.scheduled_date,
-    is_completed = v.is_completed
+    is_completed = v.is_completed,
+    -- 未完了から完了になった復習日（期限切れを完了扱いにした場合など）は実際には復習していないので、完了日を記録しない
+    completed_date = CASE
+        WHEN v.is_completed AND r.is_completed THEN r.completed_date
+        ELSE NULL
+    END
 FROM
     UNNEST(
         $2::reviewdate_input[]
@@ -1604,7 +3321,11 @@ SET
     box_id = v.box_id,
     initial_scheduled_date = v.initial_scheduled_date,
     scheduled_date = v.scheduled_date,
-    is_completed = v.is_completed
+    is_completed = v.is_completed,
+    completed_date = CASE
+        WHEN v.is_completed AND r.is_completed THEN r.completed_date
+        ELSE NULL
+    END
 FROM
     UNNEST(
         $2::back_reviewdate_input[]
@@ -11,6 +11,187 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ItemOperationKindEnum string
+
+const (
+	ItemOperationKindEnumCreateItem           ItemOperationKindEnum = "create_item"
+	ItemOperationKindEnumUpdateItem           ItemOperationKindEnum = "update_item"
+	ItemOperationKindEnumDeleteItem           ItemOperationKindEnum = "delete_item"
+	ItemOperationKindEnumUpdateReviewDates    ItemOperationKindEnum = "update_review_dates"
+	ItemOperationKindEnumCompleteReviewDate   ItemOperationKindEnum = "complete_review_date"
+	ItemOperationKindEnumIncompleteReviewDate ItemOperationKindEnum = "incomplete_review_date"
+	ItemOperationKindEnumFailReviewDate       ItemOperationKindEnum = "fail_review_date"
+	ItemOperationKindEnumFinishItem           ItemOperationKindEnum = "finish_item"
+	ItemOperationKindEnumUnfinishItem         ItemOperationKindEnum = "unfinish_item"
+	ItemOperationKindEnumUpgradeItemPattern   ItemOperationKindEnum = "upgrade_item_pattern"
+	ItemOperationKindEnumSnoozeReviewDate     ItemOperationKindEnum = "snooze_review_date"
+)
+
+func (e *ItemOperationKindEnum) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ItemOperationKindEnum(s)
+	case string:
+		*e = ItemOperationKindEnum(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ItemOperationKindEnum: %T", src)
+	}
+	return nil
+}
+
+type NullItemOperationKindEnum struct {
+	ItemOperationKindEnum ItemOperationKindEnum `json:"item_operation_kind_enum"`
+	Valid                 bool                  `json:"valid"` // Valid is true if ItemOperationKindEnum is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullItemOperationKindEnum) Scan(value interface{}) error {
+	if value == nil {
+		ns.ItemOperationKindEnum, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ItemOperationKindEnum.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullItemOperationKindEnum) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ItemOperationKindEnum), nil
+}
+
+type OverduePolicyEnum string
+
+const (
+	OverduePolicyEnumSlideAll     OverduePolicyEnum = "slide_all"
+	OverduePolicyEnumSlideOverdue OverduePolicyEnum = "slide_overdue"
+	OverduePolicyEnumKeep         OverduePolicyEnum = "keep"
+	OverduePolicyEnumSpread       OverduePolicyEnum = "spread"
+)
+
+func (e *OverduePolicyEnum) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OverduePolicyEnum(s)
+	case string:
+		*e = OverduePolicyEnum(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OverduePolicyEnum: %T", src)
+	}
+	return nil
+}
+
+type NullOverduePolicyEnum struct {
+	OverduePolicyEnum OverduePolicyEnum `json:"overdue_policy_enum"`
+	Valid             bool              `json:"valid"` // Valid is true if OverduePolicyEnum is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOverduePolicyEnum) Scan(value interface{}) error {
+	if value == nil {
+		ns.OverduePolicyEnum, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OverduePolicyEnum.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOverduePolicyEnum) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OverduePolicyEnum), nil
+}
+
+type ReviewOutcomeEnum string
+
+const (
+	ReviewOutcomeEnumCompleted   ReviewOutcomeEnum = "completed"
+	ReviewOutcomeEnumUncompleted ReviewOutcomeEnum = "uncompleted"
+)
+
+func (e *ReviewOutcomeEnum) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReviewOutcomeEnum(s)
+	case string:
+		*e = ReviewOutcomeEnum(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReviewOutcomeEnum: %T", src)
+	}
+	return nil
+}
+
+type NullReviewOutcomeEnum struct {
+	ReviewOutcomeEnum ReviewOutcomeEnum `json:"review_outcome_enum"`
+	Valid             bool              `json:"valid"` // Valid is true if ReviewOutcomeEnum is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReviewOutcomeEnum) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReviewOutcomeEnum, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReviewOutcomeEnum.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReviewOutcomeEnum) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReviewOutcomeEnum), nil
+}
+
+type SchedulerKindEnum string
+
+const (
+	SchedulerKindEnumFixedSteps SchedulerKindEnum = "fixed_steps"
+	SchedulerKindEnumAdaptive   SchedulerKindEnum = "adaptive"
+	SchedulerKindEnumFsrs       SchedulerKindEnum = "fsrs"
+	SchedulerKindEnumLeitner    SchedulerKindEnum = "leitner"
+)
+
+func (e *SchedulerKindEnum) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SchedulerKindEnum(s)
+	case string:
+		*e = SchedulerKindEnum(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SchedulerKindEnum: %T", src)
+	}
+	return nil
+}
+
+type NullSchedulerKindEnum struct {
+	SchedulerKindEnum SchedulerKindEnum `json:"scheduler_kind_enum"`
+	Valid             bool              `json:"valid"` // Valid is true if SchedulerKindEnum is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSchedulerKindEnum) Scan(value interface{}) error {
+	if value == nil {
+		ns.SchedulerKindEnum, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SchedulerKindEnum.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSchedulerKindEnum) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SchedulerKindEnum), nil
+}
+
 type TargetWeightEnum string
 
 const (
@@ -116,6 +297,21 @@ type EmailVerification struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type ItemOperationSnapshot struct {
+	ID                     pgtype.UUID           `json:"id"`
+	OperationID            pgtype.UUID           `json:"operation_id"`
+	UserID                 pgtype.UUID           `json:"user_id"`
+	ItemID                 pgtype.UUID           `json:"item_id"`
+	Kind                   ItemOperationKindEnum `json:"kind"`
+	ItemSnapshot           []byte                `json:"item_snapshot"`
+	ReviewDatesSnapshot    []byte                `json:"review_dates_snapshot"`
+	OperatedAt             pgtype.Timestamptz    `json:"operated_at"`
+	CreatedAt              pgtype.Timestamptz    `json:"created_at"`
+	ReviewLogsSnapshot     []byte                `json:"review_logs_snapshot"`
+	ReviewFailuresSnapshot []byte                `json:"review_failures_snapshot"`
+	ItemTagsSnapshot       []byte                `json:"item_tags_snapshot"`
+}
+
 type PatternStep struct {
 	ID           pgtype.UUID        `json:"id"`
 	UserID       pgtype.UUID        `json:"user_id"`
@@ -124,6 +320,7 @@ type PatternStep struct {
 	IntervalDays int16              `json:"interval_days"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	Version      int32              `json:"version"`
 }
 
 type ReviewBox struct {
@@ -139,40 +336,94 @@ type ReviewBox struct {
 }
 
 type ReviewDate struct {
-	ID                   pgtype.UUID        `json:"id"`
-	UserID               pgtype.UUID        `json:"user_id"`
-	CategoryID           pgtype.UUID        `json:"category_id"`
-	BoxID                pgtype.UUID        `json:"box_id"`
-	ItemID               pgtype.UUID        `json:"item_id"`
-	StepNumber           int16              `json:"step_number"`
-	InitialScheduledDate pgtype.Date        `json:"initial_scheduled_date"`
-	ScheduledDate        pgtype.Date        `json:"scheduled_date"`
-	IsCompleted          bool               `json:"is_completed"`
-	CreatedAt            pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	ID                    pgtype.UUID        `json:"id"`
+	UserID                pgtype.UUID        `json:"user_id"`
+	CategoryID            pgtype.UUID        `json:"category_id"`
+	BoxID                 pgtype.UUID        `json:"box_id"`
+	ItemID                pgtype.UUID        `json:"item_id"`
+	StepNumber            int16              `json:"step_number"`
+	InitialScheduledDate  pgtype.Date        `json:"initial_scheduled_date"`
+	ScheduledDate         pgtype.Date        `json:"scheduled_date"`
+	IsCompleted           bool               `json:"is_completed"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	CompletedDate         pgtype.Date        `json:"completed_date"`
+	OriginalScheduledDate pgtype.Date        `json:"original_scheduled_date"`
+}
+
+type ReviewFailure struct {
+	ID            pgtype.UUID        `json:"id"`
+	UserID        pgtype.UUID        `json:"user_id"`
+	ItemID        pgtype.UUID        `json:"item_id"`
+	StepNumber    int16              `json:"step_number"`
+	ScheduledDate pgtype.Date        `json:"scheduled_date"`
+	FailedDate    pgtype.Date        `json:"failed_date"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type ReviewLog struct {
+	ID              pgtype.UUID        `json:"id"`
+	UserID          pgtype.UUID        `json:"user_id"`
+	ItemID          pgtype.UUID        `json:"item_id"`
+	ReviewDateID    pgtype.UUID        `json:"review_date_id"`
+	StepNumber      int16              `json:"step_number"`
+	Outcome         ReviewOutcomeEnum  `json:"outcome"`
+	Grade           pgtype.Int2        `json:"grade"`
+	DurationSeconds pgtype.Int4        `json:"duration_seconds"`
+	ReviewedDate    pgtype.Date        `json:"reviewed_date"`
+	ReviewedAt      pgtype.Timestamptz `json:"reviewed_at"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
 }
 
 type ReviewItem struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	CategoryID   pgtype.UUID        `json:"category_id"`
-	BoxID        pgtype.UUID        `json:"box_id"`
-	PatternID    pgtype.UUID        `json:"pattern_id"`
-	Name         string             `json:"name"`
-	Detail       pgtype.Text        `json:"detail"`
-	LearnedDate  pgtype.Date        `json:"learned_date"`
-	IsFinished   bool               `json:"is_finished"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	BoxID          pgtype.UUID        `json:"box_id"`
+	PatternID      pgtype.UUID        `json:"pattern_id"`
+	Name           string             `json:"name"`
+	Detail         pgtype.Text        `json:"detail"`
+	LearnedDate    pgtype.Date        `json:"learned_date"`
+	IsFinished     bool               `json:"is_finished"`
+	RegisteredAt   pgtype.Timestamptz `json:"registered_at"`
+	EditedAt       pgtype.Timestamptz `json:"edited_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	EaseFactor     float64            `json:"ease_factor"`
+	Stability      float64            `json:"stability"`
+	Difficulty     float64            `json:"difficulty"`
+	PatternVersion pgtype.Int4        `json:"pattern_version"`
+	SlipCount      int32              `json:"slip_count"`
+}
+
+type ReviewItemTag struct {
+	ItemID    pgtype.UUID        `json:"item_id"`
+	TagID     pgtype.UUID        `json:"tag_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
 type ReviewPattern struct {
+	ID                     pgtype.UUID        `json:"id"`
+	UserID                 pgtype.UUID        `json:"user_id"`
+	Name                   string             `json:"name"`
+	TargetWeight           TargetWeightEnum   `json:"target_weight"`
+	RegisteredAt           pgtype.Timestamptz `json:"registered_at"`
+	EditedAt               pgtype.Timestamptz `json:"edited_at"`
+	CreatedAt              pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
+	SchedulerKind          SchedulerKindEnum  `json:"scheduler_kind"`
+	TargetRetention        float64            `json:"target_retention"`
+	IntervalFuzz           bool               `json:"interval_fuzz"`
+	OverduePolicy          OverduePolicyEnum  `json:"overdue_policy"`
+	OverdueSpreadDays      int16              `json:"overdue_spread_days"`
+	Version                int32              `json:"version"`
+	IntervalFromCompletion bool               `json:"interval_from_completion"`
+}
+
+type Tag struct {
 	ID           pgtype.UUID        `json:"id"`
 	UserID       pgtype.UUID        `json:"user_id"`
 	Name         string             `json:"name"`
-	TargetWeight TargetWeightEnum   `json:"target_weight"`
 	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
 	EditedAt     pgtype.Timestamptz `json:"edited_at"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
@@ -180,14 +431,30 @@ type ReviewPattern struct {
 }
 
 type User struct {
-	ID             pgtype.UUID        `json:"id"`
-	EmailSearchKey string             `json:"email_search_key"`
-	Email          string             `json:"email"`
-	Password       string             `json:"password"`
-	Timezone       string             `json:"timezone"`
-	ThemeColor     ThemeColorEnum     `json:"theme_color"`
-	Language       string             `json:"language"`
-	VerifiedAt     pgtype.Timestamptz `json:"verified_at"`
-	CreatedAt      pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	ID               pgtype.UUID        `json:"id"`
+	EmailSearchKey   string             `json:"email_search_key"`
+	Email            string             `json:"email"`
+	Password         string             `json:"password"`
+	Timezone         string             `json:"timezone"`
+	ThemeColor       ThemeColorEnum     `json:"theme_color"`
+	Language         string             `json:"language"`
+	VerifiedAt       pgtype.Timestamptz `json:"verified_at"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	RestWeekdays     []int16            `json:"rest_weekdays"`
+	MaxReviewsPerDay int32              `json:"max_reviews_per_day"`
+}
+
+type UserRestDate struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	RestDate  pgtype.Date        `json:"rest_date"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserVacation struct {
+	ID        pgtype.UUID        `json:"id"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	StartDate pgtype.Date        `json:"start_date"`
+	EndDate   pgtype.Date        `json:"end_date"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
@@ -18,6 +18,13 @@ INSERT INTO
         user_id,
         name,
         target_weight,
+        scheduler_kind,
+        target_retention,
+        interval_fuzz,
+        interval_from_completion,
+        overdue_policy,
+        overdue_spread_days,
+        version,
         registered_at,
         edited_at
     )
@@ -27,17 +34,31 @@ VALUES (
         $3,
         $4,
         $5,
-        $6
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13
     )
 `
 
 type CreatePatternParams struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	Name         string             `json:"name"`
-	TargetWeight TargetWeightEnum   `json:"target_weight"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID                     pgtype.UUID        `json:"id"`
+	UserID                 pgtype.UUID        `json:"user_id"`
+	Name                   string             `json:"name"`
+	TargetWeight           TargetWeightEnum   `json:"target_weight"`
+	SchedulerKind          SchedulerKindEnum  `json:"scheduler_kind"`
+	TargetRetention        float64            `json:"target_retention"`
+	IntervalFuzz           bool               `json:"interval_fuzz"`
+	IntervalFromCompletion bool               `json:"interval_from_completion"`
+	OverduePolicy          OverduePolicyEnum  `json:"overdue_policy"`
+	OverdueSpreadDays      int16              `json:"overdue_spread_days"`
+	Version                int32              `json:"version"`
+	RegisteredAt           pgtype.Timestamptz `json:"registered_at"`
+	EditedAt               pgtype.Timestamptz `json:"edited_at"`
 }
 
 func (q *Queries) CreatePattern(ctx context.Context, arg CreatePatternParams) error {
@@ -46,6 +67,13 @@ func (q *Queries) CreatePattern(ctx context.Context, arg CreatePatternParams) er
 		arg.UserID,
 		arg.Name,
 		arg.TargetWeight,
+		arg.SchedulerKind,
+		arg.TargetRetention,
+		arg.IntervalFuzz,
+		arg.IntervalFromCompletion,
+		arg.OverduePolicy,
+		arg.OverdueSpreadDays,
+		arg.Version,
 		arg.RegisteredAt,
 		arg.EditedAt,
 	)
@@ -58,6 +86,7 @@ type CreatePatternStepsParams struct {
 	PatternID    pgtype.UUID `json:"pattern_id"`
 	StepNumber   int16       `json:"step_number"`
 	IntervalDays int16       `json:"interval_days"`
+	Version      int32       `json:"version"`
 }
 
 const deletePattern = `-- name: DeletePattern :exec
@@ -103,18 +132,21 @@ func (q *Queries) DeletePatternSteps(ctx context.Context, arg DeletePatternSteps
 
 const getAllPatternStepsByUserID = `-- name: GetAllPatternStepsByUserID :many
 SELECT
-    id,
-    user_id,
-    pattern_id,
-    step_number,
-    interval_days
+    ps.id,
+    ps.user_id,
+    ps.pattern_id,
+    ps.step_number,
+    ps.interval_days,
+    ps.version
 FROM
-    pattern_steps
+    pattern_steps ps
+JOIN
+    review_patterns rp ON ps.pattern_id = rp.id AND ps.version = rp.version
 WHERE
-    user_id = $1
+    ps.user_id = $1
 ORDER BY
-    pattern_id,
-    step_number
+    ps.pattern_id,
+    ps.step_number
 `
 
 type GetAllPatternStepsByUserIDRow struct {
@@ -123,6 +155,7 @@ type GetAllPatternStepsByUserIDRow struct {
 	PatternID    pgtype.UUID `json:"pattern_id"`
 	StepNumber   int16       `json:"step_number"`
 	IntervalDays int16       `json:"interval_days"`
+	Version      int32       `json:"version"`
 }
 
 // 　全パターン取得機能（ステップ（子）のみ一覧取得（親は区別しない））
@@ -141,6 +174,7 @@ func (q *Queries) GetAllPatternStepsByUserID(ctx context.Context, userID pgtype.
 			&i.PatternID,
 			&i.StepNumber,
 			&i.IntervalDays,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -158,6 +192,13 @@ SELECT
     user_id,
     name,
     target_weight,
+    scheduler_kind,
+    target_retention,
+    interval_fuzz,
+    interval_from_completion,
+    overdue_policy,
+    overdue_spread_days,
+    version,
     registered_at,
     edited_at
 FROM
@@ -169,12 +210,19 @@ ORDER BY
 `
 
 type GetAllPatternsByUserIDRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	Name         string             `json:"name"`
-	TargetWeight TargetWeightEnum   `json:"target_weight"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID                     pgtype.UUID        `json:"id"`
+	UserID                 pgtype.UUID        `json:"user_id"`
+	Name                   string             `json:"name"`
+	TargetWeight           TargetWeightEnum   `json:"target_weight"`
+	SchedulerKind          SchedulerKindEnum  `json:"scheduler_kind"`
+	TargetRetention        float64            `json:"target_retention"`
+	IntervalFuzz           bool               `json:"interval_fuzz"`
+	IntervalFromCompletion bool               `json:"interval_from_completion"`
+	OverduePolicy          OverduePolicyEnum  `json:"overdue_policy"`
+	OverdueSpreadDays      int16              `json:"overdue_spread_days"`
+	Version                int32              `json:"version"`
+	RegisteredAt           pgtype.Timestamptz `json:"registered_at"`
+	EditedAt               pgtype.Timestamptz `json:"edited_at"`
 }
 
 // 全パターン取得機能（パターン（親）のみ一覧取得）
@@ -192,6 +240,13 @@ func (q *Queries) GetAllPatternsByUserID(ctx context.Context, userID pgtype.UUID
 			&i.UserID,
 			&i.Name,
 			&i.TargetWeight,
+			&i.SchedulerKind,
+			&i.TargetRetention,
+			&i.IntervalFuzz,
+			&i.IntervalFromCompletion,
+			&i.OverduePolicy,
+			&i.OverdueSpreadDays,
+			&i.Version,
 			&i.RegisteredAt,
 			&i.EditedAt,
 		); err != nil {
@@ -211,6 +266,13 @@ SELECT
     user_id,
     name,
     target_weight,
+    scheduler_kind,
+    target_retention,
+    interval_fuzz,
+    interval_from_completion,
+    overdue_policy,
+    overdue_spread_days,
+    version,
     registered_at,
     edited_at
 FROM
@@ -227,12 +289,19 @@ type GetPatternByIDParams struct {
 }
 
 type GetPatternByIDRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
-	Name         string             `json:"name"`
-	TargetWeight TargetWeightEnum   `json:"target_weight"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
+	ID                     pgtype.UUID        `json:"id"`
+	UserID                 pgtype.UUID        `json:"user_id"`
+	Name                   string             `json:"name"`
+	TargetWeight           TargetWeightEnum   `json:"target_weight"`
+	SchedulerKind          SchedulerKindEnum  `json:"scheduler_kind"`
+	TargetRetention        float64            `json:"target_retention"`
+	IntervalFuzz           bool               `json:"interval_fuzz"`
+	IntervalFromCompletion bool               `json:"interval_from_completion"`
+	OverduePolicy          OverduePolicyEnum  `json:"overdue_policy"`
+	OverdueSpreadDays      int16              `json:"overdue_spread_days"`
+	Version                int32              `json:"version"`
+	RegisteredAt           pgtype.Timestamptz `json:"registered_at"`
+	EditedAt               pgtype.Timestamptz `json:"edited_at"`
 }
 
 // 復習パターンそのものが更新対象かどうか判定するために使う
@@ -244,6 +313,13 @@ func (q *Queries) GetPatternByID(ctx context.Context, arg GetPatternByIDParams)
 		&i.UserID,
 		&i.Name,
 		&i.TargetWeight,
+		&i.SchedulerKind,
+		&i.TargetRetention,
+		&i.IntervalFuzz,
+		&i.IntervalFromCompletion,
+		&i.OverduePolicy,
+		&i.OverdueSpreadDays,
+		&i.Version,
 		&i.RegisteredAt,
 		&i.EditedAt,
 	)
@@ -251,51 +327,119 @@ func (q *Queries) GetPatternByID(ctx context.Context, arg GetPatternByIDParams)
 }
 
 const getPatternStepsByPatternID = `-- name: GetPatternStepsByPatternID :many
+SELECT
+    ps.id,
+    ps.user_id,
+    ps.pattern_id,
+    ps.step_number,
+    ps.interval_days,
+    ps.version
+FROM
+    pattern_steps ps
+JOIN
+    review_patterns rp ON ps.pattern_id = rp.id AND ps.version = rp.version
+WHERE
+    ps.pattern_id = $1
+AND
+    ps.user_id = $2
+ORDER BY
+    ps.step_number
+`
+
+type GetPatternStepsByPatternIDParams struct {
+	PatternID pgtype.UUID `json:"pattern_id"`
+	UserID    pgtype.UUID `json:"user_id"`
+}
+
+type GetPatternStepsByPatternIDRow struct {
+	ID           pgtype.UUID `json:"id"`
+	UserID       pgtype.UUID `json:"user_id"`
+	PatternID    pgtype.UUID `json:"pattern_id"`
+	StepNumber   int16       `json:"step_number"`
+	IntervalDays int16       `json:"interval_days"`
+	Version      int32       `json:"version"`
+}
+
+// 復習ステップが更新対象かどうか判定するために使う（最新バージョンのステップのみ）
+func (q *Queries) GetPatternStepsByPatternID(ctx context.Context, arg GetPatternStepsByPatternIDParams) ([]GetPatternStepsByPatternIDRow, error) {
+	rows, err := q.db.Query(ctx, getPatternStepsByPatternID, arg.PatternID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPatternStepsByPatternIDRow{}
+	for rows.Next() {
+		var i GetPatternStepsByPatternIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.PatternID,
+			&i.StepNumber,
+			&i.IntervalDays,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPatternStepsByPatternVersion = `-- name: GetPatternStepsByPatternVersion :many
 SELECT
     id,
     user_id,
     pattern_id,
     step_number,
-    interval_days
+    interval_days,
+    version
 FROM
     pattern_steps
 WHERE
     pattern_id = $1
 AND
-    user_id = $2
+    version = $2
+AND
+    user_id = $3
 ORDER BY
     step_number
 `
 
-type GetPatternStepsByPatternIDParams struct {
+type GetPatternStepsByPatternVersionParams struct {
 	PatternID pgtype.UUID `json:"pattern_id"`
+	Version   int32       `json:"version"`
 	UserID    pgtype.UUID `json:"user_id"`
 }
 
-type GetPatternStepsByPatternIDRow struct {
+type GetPatternStepsByPatternVersionRow struct {
 	ID           pgtype.UUID `json:"id"`
 	UserID       pgtype.UUID `json:"user_id"`
 	PatternID    pgtype.UUID `json:"pattern_id"`
 	StepNumber   int16       `json:"step_number"`
 	IntervalDays int16       `json:"interval_days"`
+	Version      int32       `json:"version"`
 }
 
-// 復習ステップが更新対象かどうか判定するために使う
-func (q *Queries) GetPatternStepsByPatternID(ctx context.Context, arg GetPatternStepsByPatternIDParams) ([]GetPatternStepsByPatternIDRow, error) {
-	rows, err := q.db.Query(ctx, getPatternStepsByPatternID, arg.PatternID, arg.UserID)
+// 復習物が記録している、復習日を計算した時点のバージョンのステップを取得する
+func (q *Queries) GetPatternStepsByPatternVersion(ctx context.Context, arg GetPatternStepsByPatternVersionParams) ([]GetPatternStepsByPatternVersionRow, error) {
+	rows, err := q.db.Query(ctx, getPatternStepsByPatternVersion, arg.PatternID, arg.Version, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetPatternStepsByPatternIDRow{}
+	items := []GetPatternStepsByPatternVersionRow{}
 	for rows.Next() {
-		var i GetPatternStepsByPatternIDRow
+		var i GetPatternStepsByPatternVersionRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
 			&i.PatternID,
 			&i.StepNumber,
 			&i.IntervalDays,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -350,19 +494,33 @@ UPDATE
 SET
     name = $1,
     target_weight = $2,
-    edited_at = $3
+    scheduler_kind = $3,
+    target_retention = $4,
+    interval_fuzz = $5,
+    interval_from_completion = $6,
+    overdue_policy = $7,
+    overdue_spread_days = $8,
+    version = $9,
+    edited_at = $10
 WHERE
-    id = $4
+    id = $11
 AND
-    user_id = $5
+    user_id = $12
 `
 
 type UpdatePatternParams struct {
-	Name         string             `json:"name"`
-	TargetWeight TargetWeightEnum   `json:"target_weight"`
-	EditedAt     pgtype.Timestamptz `json:"edited_at"`
-	ID           pgtype.UUID        `json:"id"`
-	UserID       pgtype.UUID        `json:"user_id"`
+	Name                   string             `json:"name"`
+	TargetWeight           TargetWeightEnum   `json:"target_weight"`
+	SchedulerKind          SchedulerKindEnum  `json:"scheduler_kind"`
+	TargetRetention        float64            `json:"target_retention"`
+	IntervalFuzz           bool               `json:"interval_fuzz"`
+	IntervalFromCompletion bool               `json:"interval_from_completion"`
+	OverduePolicy          OverduePolicyEnum  `json:"overdue_policy"`
+	OverdueSpreadDays      int16              `json:"overdue_spread_days"`
+	Version                int32              `json:"version"`
+	EditedAt               pgtype.Timestamptz `json:"edited_at"`
+	ID                     pgtype.UUID        `json:"id"`
+	UserID                 pgtype.UUID        `json:"user_id"`
 }
 
 // pattern系のリクエストで、更新対象の中に復習パターンそのものが含まれる場合に発行するクエリ
@@ -370,6 +528,13 @@ func (q *Queries) UpdatePattern(ctx context.Context, arg UpdatePatternParams) er
 	_, err := q.db.Exec(ctx, updatePattern,
 		arg.Name,
 		arg.TargetWeight,
+		arg.SchedulerKind,
+		arg.TargetRetention,
+		arg.IntervalFuzz,
+		arg.IntervalFromCompletion,
+		arg.OverduePolicy,
+		arg.OverdueSpreadDays,
+		arg.Version,
 		arg.EditedAt,
 		arg.ID,
 		arg.UserID,
@@ -11,34 +11,77 @@ import (
 )
 
 type Querier interface {
-	// 今日の全復習日数を取得
+	// 今日の全復習日数を取得（期限切れのまま残している復習日を含む。タグで絞り込み可能）
 	CountAllDailyReviewDates(ctx context.Context, arg CountAllDailyReviewDatesParams) (int64, error)
+	// ヒートマップ用に、指定期間の日毎に完了した復習日数を取得（カテゴリー・ボックス・未分類で絞り込み可能）
+	CountCompletedReviewsGroupedByCompletedDate(ctx context.Context, arg CountCompletedReviewsGroupedByCompletedDateParams) ([]CountCompletedReviewsGroupedByCompletedDateRow, error)
 	CountDailyDatesGroupedByBoxByUserID(ctx context.Context, arg CountDailyDatesGroupedByBoxByUserIDParams) ([]CountDailyDatesGroupedByBoxByUserIDRow, error)
 	CountDailyDatesUnclassifiedByUserID(ctx context.Context, arg CountDailyDatesUnclassifiedByUserIDParams) ([]int64, error)
 	CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, arg CountDailyDatesUnclassifiedGroupedByCategoryByUserIDParams) ([]CountDailyDatesUnclassifiedGroupedByCategoryByUserIDRow, error)
+	// 休暇の見積もり用に、指定日以降の未完了の復習日数を日付毎に取得
+	CountIncompleteReviewDatesFromDate(ctx context.Context, arg CountIncompleteReviewDatesFromDateParams) ([]CountIncompleteReviewDatesFromDateRow, error)
+	// 未完了の復習日数を日付毎に取得（復習日の負荷分散で使う。再計算対象の復習物を指定した場合はその復習物自身を除く）
+	CountIncompleteReviewDatesGroupedByScheduledDate(ctx context.Context, arg CountIncompleteReviewDatesGroupedByScheduledDateParams) ([]CountIncompleteReviewDatesGroupedByScheduledDateRow, error)
 	// ここから下は概要表示用の取得クエリ
 	CountItemsGroupedByBoxByUserID(ctx context.Context, userID pgtype.UUID) ([]CountItemsGroupedByBoxByUserIDRow, error)
+	// ヒートマップ用に、指定期間の日毎に学習した復習物数を取得（カテゴリー・ボックス・未分類で絞り込み可能）
+	CountLearnedItemsGroupedByLearnedDate(ctx context.Context, arg CountLearnedItemsGroupedByLearnedDateParams) ([]CountLearnedItemsGroupedByLearnedDateRow, error)
+	// 完了率の計算用に、指定期間の日毎の予定されていた復習日数と完了済みの復習日数を取得（途中完了した復習物の未完了の復習日は数えない）
+	// 期限切れのずらしで動いた日ではなく、作成した時点の予定日で数える
+	CountReviewCompletionGroupedByOriginalScheduledDate(ctx context.Context, arg CountReviewCompletionGroupedByOriginalScheduledDateParams) ([]CountReviewCompletionGroupedByOriginalScheduledDateRow, error)
+	// 指定期間の日毎・カテゴリー毎・ボックス毎の未完了の復習日数を取得（負荷予測用。タグで絞り込み可能。keepの期限切れ分は開始日に計上）
+	CountReviewForecastByUserID(ctx context.Context, arg CountReviewForecastByUserIDParams) ([]CountReviewForecastByUserIDRow, error)
 	CountUnclassifiedItemsByUserID(ctx context.Context, userID pgtype.UUID) ([]int64, error)
 	CountUnclassifiedItemsGroupedByCategoryByUserID(ctx context.Context, userID pgtype.UUID) ([]CountUnclassifiedItemsGroupedByCategoryByUserIDRow, error)
 	CreateBox(ctx context.Context, arg CreateBoxParams) error
 	CreateCategory(ctx context.Context, arg CreateCategoryParams) error
 	CreateEmailVerification(ctx context.Context, arg CreateEmailVerificationParams) error
 	CreateItem(ctx context.Context, arg CreateItemParams) error
+	// 操作の直前の復習物・復習日・履歴・想起失敗・タグの紐付けの行をJSONBでそのまま保存する（復習物がまだない場合はitem_snapshotがNULLになる）
+	CreateItemOperationSnapshot(ctx context.Context, arg CreateItemOperationSnapshotParams) error
+	// 復習物と同じユーザーのタグだけを紐付ける
+	CreateItemTags(ctx context.Context, arg CreateItemTagsParams) error
 	CreatePattern(ctx context.Context, arg CreatePatternParams) error
 	// 新規一括挿入時と、一括更新時に使う
 	CreatePatternSteps(ctx context.Context, arg []CreatePatternStepsParams) (int64, error)
+	CreateRestDates(ctx context.Context, arg CreateRestDatesParams) error
 	// 新規一括挿入時と、一括更新時に使う
 	CreateReviewDates(ctx context.Context, arg []CreateReviewDatesParams) (int64, error)
+	// 想起失敗の記録
+	CreateReviewFailure(ctx context.Context, arg CreateReviewFailureParams) error
+	// 復習日を完了・未完了にした履歴の記録
+	CreateReviewLog(ctx context.Context, arg CreateReviewLogParams) error
+	CreateTag(ctx context.Context, arg CreateTagParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) error
+	// 休暇系
+	CreateVacation(ctx context.Context, arg CreateVacationParams) error
 	DeleteBox(ctx context.Context, arg DeleteBoxParams) error
 	DeleteCategory(ctx context.Context, arg DeleteCategoryParams) error
 	DeleteEmailVerificationByUserID(ctx context.Context, userID pgtype.UUID) error
 	DeleteItem(ctx context.Context, arg DeleteItemParams) error
+	DeleteItemOperationSnapshots(ctx context.Context, arg DeleteItemOperationSnapshotsParams) error
+	// 取り消せる期間を過ぎた操作の保存内容を削除する
+	DeleteItemOperationSnapshotsBefore(ctx context.Context, operatedBefore pgtype.Timestamptz) error
+	DeleteItemTagsByItemID(ctx context.Context, arg DeleteItemTagsByItemIDParams) error
+	// 作成の操作を取り消すため、操作の前になかった復習物を削除する（復習日はカスケードで削除される）
+	DeleteItemsCreatedByItemOperation(ctx context.Context, arg DeleteItemsCreatedByItemOperationParams) error
 	DeletePattern(ctx context.Context, arg DeletePatternParams) error
 	// 復習ステップが更新対象に含まれた場合に発行する一括削除用のクエリ
 	DeletePatternSteps(ctx context.Context, arg DeletePatternStepsParams) error
+	DeleteRestDatesByUserID(ctx context.Context, userID pgtype.UUID) error
 	// 復習日のパターンIDがnilに変更されたとき
 	DeleteReviewDates(ctx context.Context, arg DeleteReviewDatesParams) error
+	// 復習パターンのステップ変更を既存の復習物に反映する時に、未完了の復習日のうち変更後のステップに対応しないものを削除する
+	DeleteReviewDatesByIDs(ctx context.Context, arg DeleteReviewDatesByIDsParams) error
+	// 操作の後に作られた復習日を削除する（同じステップ番号の復習日を戻す前に消しておく）
+	DeleteReviewDatesNotInItemOperationSnapshots(ctx context.Context, arg DeleteReviewDatesNotInItemOperationSnapshotsParams) error
+	// 取り消す操作とその後の操作で記録された想起失敗を削除する
+	DeleteReviewFailuresRecordedSinceItemOperation(ctx context.Context, arg DeleteReviewFailuresRecordedSinceItemOperationParams) error
+	// 取り消す操作とその後の操作で記録された復習の履歴を削除する（スナップショットと同じトランザクションで記録されるため作成日時で判定する）
+	DeleteReviewLogsRecordedSinceItemOperation(ctx context.Context, arg DeleteReviewLogsRecordedSinceItemOperationParams) error
+	DeleteTag(ctx context.Context, arg DeleteTagParams) error
+	// 復習物とタグの紐付け
+	ExistsItemByID(ctx context.Context, arg ExistsItemByIDParams) (bool, error)
 	FindEmailVerificationByUserID(ctx context.Context, userID pgtype.UUID) (FindEmailVerificationByUserIDRow, error)
 	FindUserByEmailSearchKey(ctx context.Context, emailSearchKey string) (FindUserByEmailSearchKeyRow, error)
 	GetAllBoxesByCategoryID(ctx context.Context, arg GetAllBoxesByCategoryIDParams) ([]GetAllBoxesByCategoryIDRow, error)
@@ -53,6 +96,7 @@ type Querier interface {
 	GetAllPatternsByUserID(ctx context.Context, userID pgtype.UUID) ([]GetAllPatternsByUserIDRow, error)
 	//　ボックス内画面用の全復習物一覧取得機能（復習日（子）のみ一覧取得（親は区別しない。親が未完了復習物かどうかも区別しない））。
 	GetAllReviewDatesByBoxID(ctx context.Context, arg GetAllReviewDatesByBoxIDParams) ([]GetAllReviewDatesByBoxIDRow, error)
+	GetAllTagsByUserID(ctx context.Context, userID pgtype.UUID) ([]GetAllTagsByUserIDRow, error)
 	// ボックス内画面用の未完了の全復習物一覧取得機能（復習物（親）のみ一覧取得）
 	GetAllUnFinishedItemsByBoxID(ctx context.Context, arg GetAllUnFinishedItemsByBoxIDParams) ([]GetAllUnFinishedItemsByBoxIDRow, error)
 	GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx context.Context, arg GetAllUnFinishedUnclassifiedItemsByCategoryIDParams) ([]GetAllUnFinishedUnclassifiedItemsByCategoryIDRow, error)
@@ -60,6 +104,8 @@ type Querier interface {
 	GetAllUnFinishedUnclassifiedItemsByUserID(ctx context.Context, userID pgtype.UUID) ([]GetAllUnFinishedUnclassifiedItemsByUserIDRow, error)
 	GetAllUnclassifiedReviewDatesByCategoryID(ctx context.Context, arg GetAllUnclassifiedReviewDatesByCategoryIDParams) ([]GetAllUnclassifiedReviewDatesByCategoryIDRow, error)
 	GetAllUnclassifiedReviewDatesByUserID(ctx context.Context, userID pgtype.UUID) ([]GetAllUnclassifiedReviewDatesByUserIDRow, error)
+	// 作成した時点の予定日から実際に完了した日までの平均の日数を取得（完了した日を記録していない復習日は除く）
+	GetAverageReviewDelayByUserID(ctx context.Context, userID pgtype.UUID) (pgtype.Float8, error)
 	GetBoxByID(ctx context.Context, arg GetBoxByIDParams) (GetBoxByIDRow, error)
 	// item_usecaseで使うクエリ。
 	// args: box_ids uuid[]
@@ -68,29 +114,73 @@ type Querier interface {
 	// item_usecaseで使うクエリ
 	// args: category_ids uuid[]
 	GetCategoryNamesByCategoryIDs(ctx context.Context, categoryIds []pgtype.UUID) ([]GetCategoryNamesByCategoryIDsRow, error)
+	// 連続学習日数の計算用に、復習日を完了した日を重複なく古い順に取得
+	GetCompletedDatesByUserID(ctx context.Context, arg GetCompletedDatesByUserIDParams) ([]pgtype.Date, error)
 	// EditedAt取得専用
 	GetEditedAtByItemID(ctx context.Context, arg GetEditedAtByItemIDParams) (pgtype.Timestamptz, error)
 	// ボックス内画面用の完了の全復習物一覧取得系（復習物（親）のみ一覧取得）
 	GetFinishedItemsByBoxID(ctx context.Context, arg GetFinishedItemsByBoxIDParams) ([]GetFinishedItemsByBoxIDRow, error)
 	// 学習日変更など、どういうリクエストなのかを判定するために使う
 	GetItemByID(ctx context.Context, arg GetItemByIDParams) (GetItemByIDRow, error)
+	// 一覧・今日の復習日をタグで絞り込むために使う
+	GetItemIDsByTagID(ctx context.Context, arg GetItemIDsByTagIDParams) ([]pgtype.UUID, error)
+	// 指定日時以降の操作を新しい順に取得する
+	GetItemOperationsByUserID(ctx context.Context, arg GetItemOperationsByUserIDParams) ([]GetItemOperationsByUserIDRow, error)
+	// ずらされた回数か想起に失敗した回数が基準以上の、完了していない復習物を取得（回数の多い順）
+	GetLeechItemsByUserID(ctx context.Context, arg GetLeechItemsByUserIDParams) ([]GetLeechItemsByUserIDRow, error)
+	// 1日の最大復習数系
+	GetMaxReviewsPerDayByUserID(ctx context.Context, id pgtype.UUID) (int32, error)
+	// 想起度に応じたスケジューリングで使う記憶の状態の取得
+	GetMemoryStateByItemID(ctx context.Context, arg GetMemoryStateByItemIDParams) (GetMemoryStateByItemIDRow, error)
 	// 復習パターンそのものが更新対象かどうか判定するために使う
 	GetPatternByID(ctx context.Context, arg GetPatternByIDParams) (GetPatternByIDRow, error)
-	// 復習ステップが更新対象かどうか判定するために使う
+	// 復習ステップが更新対象かどうか判定するために使う（最新バージョンのステップのみ）
 	GetPatternStepsByPatternID(ctx context.Context, arg GetPatternStepsByPatternIDParams) ([]GetPatternStepsByPatternIDRow, error)
+	// 復習物が記録している、復習日を計算した時点のバージョンのステップを取得する
+	GetPatternStepsByPatternVersion(ctx context.Context, arg GetPatternStepsByPatternVersionParams) ([]GetPatternStepsByPatternVersionRow, error)
 	// item_usecaseで使うクエリ。
 	// args: pattern_ids uuid[]
 	GetPatternTargetWeightsByPatternIDs(ctx context.Context, patternIds []pgtype.UUID) ([]GetPatternTargetWeightsByPatternIDsRow, error)
+	GetRestDatesByUserID(ctx context.Context, userID pgtype.UUID) ([]pgtype.Date, error)
+	// 休息日系
+	GetRestWeekdaysByUserID(ctx context.Context, id pgtype.UUID) ([]int16, error)
 	// 復習日Upate処理用。ReviewDateIDを使い回すために使う
 	GetReviewDateIDsByItemID(ctx context.Context, arg GetReviewDateIDsByItemIDParams) ([]pgtype.UUID, error)
 	GetReviewDatesByItemID(ctx context.Context, arg GetReviewDatesByItemIDParams) ([]GetReviewDatesByItemIDRow, error)
+	// 復習パターンのステップ変更を反映する対象の、パターンに紐づく未完了の復習物が持つ復習日を取得
+	GetReviewDatesOfUnFinishedItemsByPatternID(ctx context.Context, arg GetReviewDatesOfUnFinishedItemsByPatternIDParams) ([]GetReviewDatesOfUnFinishedItemsByPatternIDRow, error)
+	// 復習物の履歴を新しい順に取得
+	GetReviewLogsByItemID(ctx context.Context, arg GetReviewLogsByItemIDParams) ([]GetReviewLogsByItemIDRow, error)
+	GetTagsByItemID(ctx context.Context, arg GetTagsByItemIDParams) ([]GetTagsByItemIDRow, error)
+	// 復習パターンのステップ変更を反映する対象の、パターンに紐づく未完了の復習物を取得
+	GetUnFinishedItemsByPatternID(ctx context.Context, arg GetUnFinishedItemsByPatternIDParams) ([]GetUnFinishedItemsByPatternIDRow, error)
 	GetUnclassfiedFinishedItemsByCategoryID(ctx context.Context, arg GetUnclassfiedFinishedItemsByCategoryIDParams) ([]GetUnclassfiedFinishedItemsByCategoryIDRow, error)
 	GetUnclassfiedFinishedItemsByUserID(ctx context.Context, userID pgtype.UUID) ([]GetUnclassfiedFinishedItemsByUserIDRow, error)
 	GetUserSettingByID(ctx context.Context, id pgtype.UUID) (GetUserSettingByIDRow, error)
 	// 完了済みの復習日がないか判別するためのクエリ
 	HasCompletedReviewDateByItemID(ctx context.Context, arg HasCompletedReviewDateByItemIDParams) (bool, error)
+	// 保存した復習物が参照するカテゴリー・ボックス・復習パターンのうち、操作の後に削除されたものがあるか判別する
+	HasDeletedReferenceInItemOperationSnapshots(ctx context.Context, arg HasDeletedReferenceInItemOperationSnapshotsParams) (bool, error)
+	HasOverlappingVacation(ctx context.Context, arg HasOverlappingVacationParams) (bool, error)
+	// 復習日を手動で先送りした回数をずらされた回数に加える
+	IncrementItemSlipCount(ctx context.Context, arg IncrementItemSlipCountParams) error
 	// patternパッケージで使う
 	IsPatternRelatedToItemByPatternID(ctx context.Context, arg IsPatternRelatedToItemByPatternIDParams) (bool, error)
+	// 保存した行でタグの紐付けを元に戻す（操作の後に削除されたタグは紐付けない）
+	RestoreItemTagsFromItemOperationSnapshots(ctx context.Context, arg RestoreItemTagsFromItemOperationSnapshotsParams) error
+	// 保存した行で復習物を元に戻す（削除した復習物は作り直す）
+	RestoreItemsFromItemOperationSnapshots(ctx context.Context, arg RestoreItemsFromItemOperationSnapshotsParams) error
+	// 保存した行で復習日を元に戻す（削除した復習日は作り直す）
+	RestoreReviewDatesFromItemOperationSnapshots(ctx context.Context, arg RestoreReviewDatesFromItemOperationSnapshotsParams) error
+	// 保存した行で想起失敗を元に戻す（復習物と一緒に削除された想起失敗を作り直す）
+	RestoreReviewFailuresFromItemOperationSnapshots(ctx context.Context, arg RestoreReviewFailuresFromItemOperationSnapshotsParams) error
+	// 保存した行で履歴を元に戻す（復習物と一緒に削除された履歴は作り直し、復習日の作り直しで外れた紐付けは戻す）
+	RestoreReviewLogsFromItemOperationSnapshots(ctx context.Context, arg RestoreReviewLogsFromItemOperationSnapshotsParams) error
+	// 名前か詳細に全てのパターンを含む復習物を取得（完了済み・未完了の両方が対象。カテゴリー・ボックス・学習日・完了状態で絞り込み可能）
+	// パターンはLIKEの特殊文字をエスケープした上で%で囲んだもの
+	SearchItemsByUserID(ctx context.Context, arg SearchItemsByUserIDParams) ([]SearchItemsByUserIDRow, error)
+	// 指定日以降の未完了の復習日を指定日数だけ後ろにずらす（ずらした先が休息日なら、休息日でない次の日にする）
+	ShiftIncompleteReviewDatesFromDate(ctx context.Context, arg ShiftIncompleteReviewDatesFromDateParams) error
 	UpdateBox(ctx context.Context, arg UpdateBoxParams) error
 	UpdateBoxIfNoReviewItems(ctx context.Context, arg UpdateBoxIfNoReviewItemsParams) (int64, error)
 	UpdateCategory(ctx context.Context, arg UpdateCategoryParams) error
@@ -98,15 +188,22 @@ type Querier interface {
 	UpdateItem(ctx context.Context, arg UpdateItemParams) error
 	UpdateItemAsFinished(ctx context.Context, arg UpdateItemAsFinishedParams) error
 	UpdateItemAsUnfinished(ctx context.Context, arg UpdateItemAsUnfinishedParams) error
+	UpdateMaxReviewsPerDay(ctx context.Context, arg UpdateMaxReviewsPerDayParams) error
+	// 想起度に応じたスケジューリングで使う記憶の状態の更新
+	UpdateMemoryState(ctx context.Context, arg UpdateMemoryStateParams) error
 	UpdateOverdueScheduledDatesAndSlideFutureDates(ctx context.Context) error
 	// pattern系のリクエストで、更新対象の中に復習パターンそのものが含まれる場合に発行するクエリ
 	UpdatePattern(ctx context.Context, arg UpdatePatternParams) error
+	// 復習パターンのステップ変更を反映した未完了の復習物を、新しいバージョンに上げる
+	UpdatePatternVersionOfUnFinishedItems(ctx context.Context, arg UpdatePatternVersionOfUnFinishedItemsParams) error
+	UpdateRestWeekdays(ctx context.Context, arg UpdateRestWeekdaysParams) error
 	UpdateReviewDateAsCompleted(ctx context.Context, arg UpdateReviewDateAsCompletedParams) error
 	UpdateReviewDateAsInCompleted(ctx context.Context, arg UpdateReviewDateAsInCompletedParams) error
 	// 復習日手動変更、完了、学習日変更機能の副次的な変更に使う
 	UpdateReviewDates(ctx context.Context, arg UpdateReviewDatesParams) error
 	// 復習日手動変更機能の副次的な変更に使う
 	UpdateReviewDatesBack(ctx context.Context, arg UpdateReviewDatesBackParams) error
+	UpdateTag(ctx context.Context, arg UpdateTagParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
 	UpdateVerifiedAt(ctx context.Context, arg UpdateVerifiedAtParams) error
@@ -7,15 +7,34 @@ package dbgen
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const deleteItemOperationSnapshotsBefore = `-- name: DeleteItemOperationSnapshotsBefore :exec
+DELETE FROM
+    item_operation_snapshots
+WHERE
+    operated_at < $1
+`
+
+// 取り消せる期間を過ぎた操作の保存内容を削除する
+func (q *Queries) DeleteItemOperationSnapshotsBefore(ctx context.Context, operatedBefore pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteItemOperationSnapshotsBefore, operatedBefore)
+	return err
+}
+
 const updateOverdueScheduledDatesAndSlideFutureDates = `-- name: UpdateOverdueScheduledDatesAndSlideFutureDates :exec
-WITH c AS (
+WITH overdue AS (
     SELECT
         ri.id AS item_id,
+        u.id AS user_id,
+        ri.pattern_id,
+        -- 復習パターンが外れた復習物は従来通り全ての復習日をずらす
+        COALESCE(rp.overdue_policy, 'slide_all') AS overdue_policy,
+        COALESCE(rp.overdue_spread_days, 1) AS overdue_spread_days,
     MIN(rd.scheduled_date) AS old_date,
-    (now() AT TIME ZONE u.timezone)::date AS today_local,
-    ((now() AT TIME ZONE u.timezone)::date - MIN(rd.scheduled_date)) AS delta_days
+    (now() AT TIME ZONE u.timezone)::date AS today_local
     FROM 
         review_dates rd
     JOIN 
@@ -26,26 +45,85 @@ WITH c AS (
         users u
     ON
         u.id  = ri.user_id
+    LEFT JOIN
+        review_patterns rp
+    ON
+        rp.id = ri.pattern_id
     WHERE
         rd.is_completed = FALSE
     AND 
         rd.scheduled_date < (now() AT TIME ZONE u.timezone)::date
+    AND
+        -- keepは期限切れのまま残すのでずらさない
+        COALESCE(rp.overdue_policy, 'slide_all') <> 'keep'
+    AND
+        -- 休暇中のユーザーの復習日はずらさない
+        NOT EXISTS (
+            SELECT
+                1
+            FROM
+                user_vacations v
+            WHERE
+                v.user_id = u.id
+            AND
+                (now() AT TIME ZONE u.timezone)::date BETWEEN v.start_date AND v.end_date
+        )
     GROUP BY 
-        ri.id, u.timezone
+        ri.id, u.id, u.timezone, rp.overdue_policy, rp.overdue_spread_days
+),
+c AS (
+    SELECT
+        item_id,
+        user_id,
+        overdue_policy,
+        old_date,
+        today_local,
+        CASE
+            -- spreadは期限切れの古い順に、今日からN日間へ1件ずつ順番に振り分ける
+            WHEN overdue_policy = 'spread' THEN
+                today_local + ((ROW_NUMBER() OVER (PARTITION BY pattern_id ORDER BY old_date, item_id) - 1) % overdue_spread_days)::int
+            ELSE
+                today_local
+        END AS target_date
+    FROM
+        overdue
+),
+-- ずらした復習日の復習物IDを返し、後続で復習物毎のずらされた回数を数える
+slid AS (
+    UPDATE review_dates rd
+        SET 
+            -- ずらした先がユーザーの休息日なら、休息日でない次の日にする
+            scheduled_date = next_available_review_date(
+                c.user_id,
+                CASE
+                    -- slide_overdueは期限切れの復習日だけを今日にする
+                    WHEN c.overdue_policy = 'slide_overdue' THEN c.target_date
+                    -- slide_all, spreadは期限切れの復習日以降を同じ日数だけずらす
+                    ELSE rd.scheduled_date + (c.target_date - c.old_date)
+                END
+            )
+        FROM 
+            c
+        WHERE
+            rd.item_id = c.item_id
+        AND 
+            rd.scheduled_date >= c.old_date
+        AND
+            rd.is_completed = FALSE
+        AND
+            (c.overdue_policy <> 'slide_overdue' OR rd.scheduled_date < c.today_local)
+    RETURNING
+        rd.item_id
 )
-UPDATE review_dates rd
-    SET 
-        scheduled_date = rd.scheduled_date + c.delta_days
-    FROM 
-        c
-    WHERE
-        rd.item_id = c.item_id
-    AND 
-        rd.scheduled_date >= c.old_date
-    AND
-        rd.is_completed = FALSE
+-- 同じ復習物の復習日を複数ずらしても、1回の実行では1回として数える
+UPDATE review_items ri
+    SET
+        slip_count = ri.slip_count + 1
+WHERE
+    ri.id IN (SELECT DISTINCT item_id FROM slid)
 `
 
+// 期限切れの復習日を、復習パターン毎の扱い方（overdue_policy）に従ってずらし、ずらした復習物のslip_countを増やす
 func (q *Queries) UpdateOverdueScheduledDatesAndSlideFutureDates(ctx context.Context) error {
 	_, err := q.db.Exec(ctx, updateOverdueScheduledDatesAndSlideFutureDates)
 	return err
@@ -11,6 +11,74 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countIncompleteReviewDatesFromDate = `-- name: CountIncompleteReviewDatesFromDate :many
+SELECT
+    scheduled_date,
+    COUNT(*) AS count
+FROM
+    review_dates
+WHERE
+    user_id = $1
+AND
+    is_completed = false
+AND
+    scheduled_date >= $2
+GROUP BY
+    scheduled_date
+`
+
+type CountIncompleteReviewDatesFromDateParams struct {
+	UserID   pgtype.UUID `json:"user_id"`
+	FromDate pgtype.Date `json:"from_date"`
+}
+
+type CountIncompleteReviewDatesFromDateRow struct {
+	ScheduledDate pgtype.Date `json:"scheduled_date"`
+	Count         int64       `json:"count"`
+}
+
+// 休暇の見積もり用に、指定日以降の未完了の復習日数を日付毎に取得
+func (q *Queries) CountIncompleteReviewDatesFromDate(ctx context.Context, arg CountIncompleteReviewDatesFromDateParams) ([]CountIncompleteReviewDatesFromDateRow, error) {
+	rows, err := q.db.Query(ctx, countIncompleteReviewDatesFromDate, arg.UserID, arg.FromDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountIncompleteReviewDatesFromDateRow{}
+	for rows.Next() {
+		var i CountIncompleteReviewDatesFromDateRow
+		if err := rows.Scan(&i.ScheduledDate, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createRestDates = `-- name: CreateRestDates :exec
+INSERT INTO
+    user_rest_dates (
+        user_id,
+        rest_date
+    )
+SELECT
+    $1,
+    UNNEST($2::date[])
+`
+
+type CreateRestDatesParams struct {
+	UserID    pgtype.UUID   `json:"user_id"`
+	RestDates []pgtype.Date `json:"rest_dates"`
+}
+
+func (q *Queries) CreateRestDates(ctx context.Context, arg CreateRestDatesParams) error {
+	_, err := q.db.Exec(ctx, createRestDates, arg.UserID, arg.RestDates)
+	return err
+}
+
 const createUser = `-- name: CreateUser :exec
 INSERT INTO 
     users (
@@ -55,6 +123,54 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 	return err
 }
 
+const createVacation = `-- name: CreateVacation :exec
+
+INSERT INTO
+    user_vacations (
+        id,
+        user_id,
+        start_date,
+        end_date
+    )
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+)
+`
+
+type CreateVacationParams struct {
+	ID        pgtype.UUID `json:"id"`
+	UserID    pgtype.UUID `json:"user_id"`
+	StartDate pgtype.Date `json:"start_date"`
+	EndDate   pgtype.Date `json:"end_date"`
+}
+
+// 休暇系
+func (q *Queries) CreateVacation(ctx context.Context, arg CreateVacationParams) error {
+	_, err := q.db.Exec(ctx, createVacation,
+		arg.ID,
+		arg.UserID,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	return err
+}
+
+const deleteRestDatesByUserID = `-- name: DeleteRestDatesByUserID :exec
+DELETE
+FROM
+    user_rest_dates
+WHERE
+    user_id = $1
+`
+
+func (q *Queries) DeleteRestDatesByUserID(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRestDatesByUserID, userID)
+	return err
+}
+
 const findUserByEmailSearchKey = `-- name: FindUserByEmailSearchKey :one
 SELECT
     id,
@@ -92,6 +208,73 @@ func (q *Queries) FindUserByEmailSearchKey(ctx context.Context, emailSearchKey s
 	return i, err
 }
 
+const getMaxReviewsPerDayByUserID = `-- name: GetMaxReviewsPerDayByUserID :one
+
+SELECT
+    max_reviews_per_day
+FROM
+    users
+WHERE
+    id = $1
+`
+
+// 1日の最大復習数系
+func (q *Queries) GetMaxReviewsPerDayByUserID(ctx context.Context, id pgtype.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, getMaxReviewsPerDayByUserID, id)
+	var max_reviews_per_day int32
+	err := row.Scan(&max_reviews_per_day)
+	return max_reviews_per_day, err
+}
+
+const getRestDatesByUserID = `-- name: GetRestDatesByUserID :many
+SELECT
+    rest_date
+FROM
+    user_rest_dates
+WHERE
+    user_id = $1
+ORDER BY
+    rest_date
+`
+
+func (q *Queries) GetRestDatesByUserID(ctx context.Context, userID pgtype.UUID) ([]pgtype.Date, error) {
+	rows, err := q.db.Query(ctx, getRestDatesByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.Date{}
+	for rows.Next() {
+		var rest_date pgtype.Date
+		if err := rows.Scan(&rest_date); err != nil {
+			return nil, err
+		}
+		items = append(items, rest_date)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRestWeekdaysByUserID = `-- name: GetRestWeekdaysByUserID :one
+
+SELECT
+    rest_weekdays
+FROM
+    users
+WHERE
+    id = $1
+`
+
+// 休息日系
+func (q *Queries) GetRestWeekdaysByUserID(ctx context.Context, id pgtype.UUID) ([]int16, error) {
+	row := q.db.QueryRow(ctx, getRestWeekdaysByUserID, id)
+	var rest_weekdays []int16
+	err := row.Scan(&rest_weekdays)
+	return rest_weekdays, err
+}
+
 const getUserSettingByID = `-- name: GetUserSettingByID :one
 SELECT
     email,
@@ -123,6 +306,97 @@ func (q *Queries) GetUserSettingByID(ctx context.Context, id pgtype.UUID) (GetUs
 	return i, err
 }
 
+const hasOverlappingVacation = `-- name: HasOverlappingVacation :one
+SELECT EXISTS (
+    SELECT
+        1
+    FROM
+        user_vacations
+    WHERE
+        user_id = $1
+    AND
+        start_date <= $2
+    AND
+        end_date >= $3
+)
+`
+
+type HasOverlappingVacationParams struct {
+	UserID    pgtype.UUID `json:"user_id"`
+	EndDate   pgtype.Date `json:"end_date"`
+	StartDate pgtype.Date `json:"start_date"`
+}
+
+func (q *Queries) HasOverlappingVacation(ctx context.Context, arg HasOverlappingVacationParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasOverlappingVacation, arg.UserID, arg.EndDate, arg.StartDate)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const shiftIncompleteReviewDatesFromDate = `-- name: ShiftIncompleteReviewDatesFromDate :exec
+UPDATE
+    review_dates
+SET
+    scheduled_date = next_available_review_date(user_id, scheduled_date + $1::int)
+WHERE
+    user_id = $2
+AND
+    is_completed = false
+AND
+    scheduled_date >= $3
+`
+
+type ShiftIncompleteReviewDatesFromDateParams struct {
+	Days     int32       `json:"days"`
+	UserID   pgtype.UUID `json:"user_id"`
+	FromDate pgtype.Date `json:"from_date"`
+}
+
+// 指定日以降の未完了の復習日を指定日数だけ後ろにずらす（ずらした先が休息日なら、休息日でない次の日にする）
+func (q *Queries) ShiftIncompleteReviewDatesFromDate(ctx context.Context, arg ShiftIncompleteReviewDatesFromDateParams) error {
+	_, err := q.db.Exec(ctx, shiftIncompleteReviewDatesFromDate, arg.Days, arg.UserID, arg.FromDate)
+	return err
+}
+
+const updateMaxReviewsPerDay = `-- name: UpdateMaxReviewsPerDay :exec
+UPDATE
+    users
+SET
+    max_reviews_per_day = $1
+WHERE
+    id = $2
+`
+
+type UpdateMaxReviewsPerDayParams struct {
+	MaxReviewsPerDay int32       `json:"max_reviews_per_day"`
+	ID               pgtype.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateMaxReviewsPerDay(ctx context.Context, arg UpdateMaxReviewsPerDayParams) error {
+	_, err := q.db.Exec(ctx, updateMaxReviewsPerDay, arg.MaxReviewsPerDay, arg.ID)
+	return err
+}
+
+const updateRestWeekdays = `-- name: UpdateRestWeekdays :exec
+UPDATE
+    users
+SET
+    rest_weekdays = $1
+WHERE
+    id = $2
+`
+
+type UpdateRestWeekdaysParams struct {
+	RestWeekdays []int16     `json:"rest_weekdays"`
+	ID           pgtype.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateRestWeekdays(ctx context.Context, arg UpdateRestWeekdaysParams) error {
+	_, err := q.db.Exec(ctx, updateRestWeekdays, arg.RestWeekdays, arg.ID)
+	return err
+}
+
 const updateUser = `-- name: UpdateUser :exec
 UPDATE
     users
@@ -6,6 +6,7 @@ INSERT INTO
         category_id,
         box_id,
         pattern_id,
+        pattern_version,
         name,
         detail,
         learned_date,
@@ -19,6 +20,7 @@ VALUES (
     sqlc.arg(category_id),
     sqlc.arg(box_id),
     sqlc.arg(pattern_id),
+    sqlc.arg(pattern_version),
     sqlc.arg(name),
     sqlc.arg(detail),
     sqlc.arg(learned_date),
@@ -38,8 +40,10 @@ INSERT INTO
         item_id,
         step_number,
         initial_scheduled_date,
+        original_scheduled_date,
         scheduled_date,
-        is_completed
+        is_completed,
+        completed_date
     ) VALUES (
         sqlc.arg(id),
         sqlc.arg(user_id),
@@ -48,8 +52,10 @@ INSERT INTO
         sqlc.arg(item_id),
         sqlc.arg(step_number),
         sqlc.arg(initial_scheduled_date),
+        sqlc.arg(original_scheduled_date),
         sqlc.arg(scheduled_date),
-        sqlc.arg(is_completed)
+        sqlc.arg(is_completed),
+        sqlc.narg(completed_date)
     );
 
 
@@ -61,6 +67,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -109,6 +116,7 @@ SET
     category_id = sqlc.arg(category_id),
     box_id = sqlc.arg(box_id),
     pattern_id = sqlc.arg(pattern_id),
+    pattern_version = sqlc.arg(pattern_version),
     name = sqlc.arg(name),
     detail = sqlc.arg(detail),
     learned_date = sqlc.arg(learned_date),
@@ -127,7 +135,12 @@ SET
     box_id = v.box_id,
     initial_scheduled_date = v.initial_scheduled_date,
     scheduled_date = v.scheduled_date,
-    is_completed = v.is_completed
+    is_completed = v.is_completed,
+    -- 未完了から完了になった復習日（期限切れを完了扱いにした場合など）は実際には復習していないので、完了日を記録しない
+    completed_date = CASE
+        WHEN v.is_completed AND r.is_completed THEN r.completed_date
+        ELSE NULL
+    END
 FROM
     UNNEST(
         sqlc.arg(input)::reviewdate_input[]
@@ -145,7 +158,11 @@ SET
     box_id = v.box_id,
     initial_scheduled_date = v.initial_scheduled_date,
     scheduled_date = v.scheduled_date,
-    is_completed = v.is_completed
+    is_completed = v.is_completed,
+    completed_date = CASE
+        WHEN v.is_completed AND r.is_completed THEN r.completed_date
+        ELSE NULL
+    END
 FROM
     UNNEST(
         sqlc.arg(input)::back_reviewdate_input[]
@@ -166,6 +183,17 @@ WHERE
 AND
     user_id = sqlc.arg(user_id);
 
+-- 復習日を手動で先送りした回数をずらされた回数に加える
+-- name: IncrementItemSlipCount :exec
+UPDATE
+    review_items
+SET
+    slip_count = slip_count + 1
+WHERE
+    id = sqlc.arg(id)
+AND
+    user_id = sqlc.arg(user_id);
+
 -- name: UpdateItemAsUnfinished :exec
 UPDATE
     review_items
@@ -181,7 +209,8 @@ AND
 UPDATE
     review_dates
 SET
-    is_completed = true
+    is_completed = true,
+    completed_date = sqlc.arg(completed_date)
 WHERE
     id = sqlc.arg(id)
 AND
@@ -191,7 +220,8 @@ AND
 UPDATE
     review_dates
 SET
-    is_completed = false
+    is_completed = false,
+    completed_date = NULL
 WHERE
     id = sqlc.arg(id)
 AND
@@ -207,7 +237,8 @@ SELECT
     step_number,
     initial_scheduled_date,
     scheduled_date,
-    is_completed
+    is_completed,
+    completed_date
 FROM
     review_dates
 WHERE
@@ -237,6 +268,82 @@ AND
     user_id = sqlc.arg(user_id);
 
 
+-- 復習パターンのステップ変更を既存の復習物に反映する時に、未完了の復習日のうち変更後のステップに対応しないものを削除する
+-- name: DeleteReviewDatesByIDs :exec
+DELETE
+FROM
+    review_dates
+WHERE
+    id = ANY(sqlc.arg(ids)::uuid[])
+AND
+    user_id = sqlc.arg(user_id);
+
+-- 復習パターンのステップ変更を反映する対象の、パターンに紐づく未完了の復習物を取得
+-- name: GetUnFinishedItemsByPatternID :many
+SELECT
+    id,
+    user_id,
+    category_id,
+    box_id,
+    pattern_id,
+    pattern_version,
+    name,
+    detail,
+    learned_date,
+    is_finished,
+    registered_at,
+    edited_at
+FROM
+    review_items
+WHERE
+    pattern_id = sqlc.arg(pattern_id)
+AND
+    user_id = sqlc.arg(user_id)
+AND
+    is_finished = false
+ORDER BY
+    registered_at;
+
+-- 復習パターンのステップ変更を反映する対象の、パターンに紐づく未完了の復習物が持つ復習日を取得
+-- name: GetReviewDatesOfUnFinishedItemsByPatternID :many
+SELECT
+    rd.id,
+    rd.user_id,
+    rd.category_id,
+    rd.box_id,
+    rd.item_id,
+    rd.step_number,
+    rd.initial_scheduled_date,
+    rd.scheduled_date,
+    rd.is_completed,
+    rd.completed_date
+FROM
+    review_dates rd
+JOIN
+    review_items ri ON rd.item_id = ri.id
+WHERE
+    ri.pattern_id = sqlc.arg(pattern_id)
+AND
+    ri.user_id = sqlc.arg(user_id)
+AND
+    ri.is_finished = false
+ORDER BY
+    rd.item_id,
+    rd.step_number;
+
+-- 復習パターンのステップ変更を反映した未完了の復習物を、新しいバージョンに上げる
+-- name: UpdatePatternVersionOfUnFinishedItems :exec
+UPDATE
+    review_items
+SET
+    pattern_version = sqlc.arg(pattern_version)
+WHERE
+    pattern_id = sqlc.arg(pattern_id)
+AND
+    user_id = sqlc.arg(user_id)
+AND
+    is_finished = false;
+
 -- ボックス内画面用の未完了の全復習物一覧取得機能（復習物（親）のみ一覧取得）
 -- name: GetAllUnFinishedItemsByBoxID :many
 SELECT
@@ -245,6 +352,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -273,7 +381,8 @@ SELECT
     step_number,
     initial_scheduled_date,
     scheduled_date,
-    is_completed
+    is_completed,
+    completed_date
 FROM
     review_dates
 WHERE
@@ -292,6 +401,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -321,7 +431,8 @@ SELECT
     step_number,
     initial_scheduled_date,
     scheduled_date,
-    is_completed
+    is_completed,
+    completed_date
 FROM
     review_dates
 WHERE
@@ -341,6 +452,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -370,7 +482,8 @@ SELECT
     step_number,
     initial_scheduled_date,
     scheduled_date,
-    is_completed
+    is_completed,
+    completed_date
 FROM
     review_dates
 WHERE
@@ -383,6 +496,19 @@ ORDER BY
     item_id,
     step_number;
 
+-- 一覧・今日の復習日をタグで絞り込むために使う
+-- name: GetItemIDsByTagID :many
+SELECT
+    it.item_id
+FROM
+    review_item_tags it
+JOIN
+    tags t ON t.id = it.tag_id
+WHERE
+    it.tag_id = sqlc.arg(tag_id)
+AND
+    t.user_id = sqlc.arg(user_id);
+
 
 -- ここから下は概要表示用の取得クエリ
 
@@ -433,53 +559,134 @@ AND
 
 -- name: CountDailyDatesGroupedByBoxByUserID :many
 SELECT
-    category_id,
-    box_id,
+    rd.category_id,
+    rd.box_id,
     COUNT(*) AS count
 FROM
-    review_dates
+    review_dates rd
+JOIN
+    review_items ri
+ON
+    ri.id = rd.item_id
+LEFT JOIN
+    review_patterns rp
+ON
+    rp.id = ri.pattern_id
 WHERE
-    user_id = sqlc.arg(user_id)
+    rd.user_id = sqlc.arg(user_id)
 AND
-    scheduled_date = sqlc.arg(target_date)
+    rd.is_completed = false
+AND (
+    rd.scheduled_date = sqlc.arg(target_date)
+OR (
+    -- 期限切れのまま残す復習パターンでは、期限切れの復習日も今日の復習に含める（CountAllDailyReviewDatesと同じ条件）
+    rp.overdue_policy = 'keep'
+    AND rd.scheduled_date < sqlc.arg(target_date)
+))
 AND
-    is_completed = false
+    rd.box_id IS NOT NULL
 AND
-    box_id IS NOT NULL
+    (sqlc.narg(tag_id)::uuid IS NULL OR EXISTS (SELECT 1 FROM review_item_tags rit WHERE rit.item_id = rd.item_id AND rit.tag_id = sqlc.narg(tag_id)))
 GROUP BY
-    category_id,
-    box_id;
+    rd.category_id,
+    rd.box_id;
 
 -- name: CountDailyDatesUnclassifiedGroupedByCategoryByUserID :many
 SELECT
-    category_id,
+    rd.category_id,
     COUNT(*) AS count
 FROM
-    review_dates
+    review_dates rd
+JOIN
+    review_items ri
+ON
+    ri.id = rd.item_id
+LEFT JOIN
+    review_patterns rp
+ON
+    rp.id = ri.pattern_id
 WHERE
-    user_id = sqlc.arg(user_id)
+    rd.user_id = sqlc.arg(user_id)
 AND
-    scheduled_date = sqlc.arg(target_date)
+    rd.is_completed = false
+AND (
+    rd.scheduled_date = sqlc.arg(target_date)
+OR (
+    rp.overdue_policy = 'keep'
+    AND rd.scheduled_date < sqlc.arg(target_date)
+))
 AND
-    is_completed = false
+    rd.box_id IS NULL
 AND
-    box_id IS NULL
+    (sqlc.narg(tag_id)::uuid IS NULL OR EXISTS (SELECT 1 FROM review_item_tags rit WHERE rit.item_id = rd.item_id AND rit.tag_id = sqlc.narg(tag_id)))
 GROUP BY
-    category_id;
+    rd.category_id;
 
 -- name: CountDailyDatesUnclassifiedByUserID :many
 SELECT
     COUNT(*) AS count
 FROM
-    review_dates
+    review_dates rd
+JOIN
+    review_items ri
+ON
+    ri.id = rd.item_id
+LEFT JOIN
+    review_patterns rp
+ON
+    rp.id = ri.pattern_id
 WHERE
-    user_id = sqlc.arg(user_id)
+    rd.user_id = sqlc.arg(user_id)
 AND
-    scheduled_date = sqlc.arg(target_date)
+    rd.is_completed = false
+AND (
+    rd.scheduled_date = sqlc.arg(target_date)
+OR (
+    rp.overdue_policy = 'keep'
+    AND rd.scheduled_date < sqlc.arg(target_date)
+))
 AND
-    is_completed = false
+    rd.box_id IS NULL
 AND
-    box_id IS NULL;
+    (sqlc.narg(tag_id)::uuid IS NULL OR EXISTS (SELECT 1 FROM review_item_tags rit WHERE rit.item_id = rd.item_id AND rit.tag_id = sqlc.narg(tag_id)));
+
+-- 指定期間の日毎・カテゴリー毎・ボックス毎の未完了の復習日数を取得（負荷予測用。タグで絞り込み可能。keepの期限切れ分は開始日に計上）
+-- name: CountReviewForecastByUserID :many
+SELECT
+    GREATEST(rd.scheduled_date, sqlc.arg(from_date)::date)::date AS scheduled_date,
+    rd.category_id,
+    rd.box_id,
+    COUNT(*) AS count
+FROM
+    review_dates rd
+JOIN
+    review_items ri
+ON
+    ri.id = rd.item_id
+LEFT JOIN
+    review_patterns rp
+ON
+    rp.id = ri.pattern_id
+WHERE
+    rd.user_id = sqlc.arg(user_id)
+AND
+    rd.is_completed = false
+AND (
+    rd.scheduled_date BETWEEN sqlc.arg(from_date) AND sqlc.arg(to_date)
+OR (
+    rp.overdue_policy = 'keep'
+    AND rd.scheduled_date < sqlc.arg(from_date)
+))
+AND
+    (sqlc.narg(tag_id)::uuid IS NULL OR EXISTS (SELECT 1 FROM review_item_tags rit WHERE rit.item_id = rd.item_id AND rit.tag_id = sqlc.narg(tag_id)))
+GROUP BY
+    GREATEST(rd.scheduled_date, sqlc.arg(from_date)::date),
+    rd.category_id,
+    rd.box_id
+ORDER BY
+    GREATEST(rd.scheduled_date, sqlc.arg(from_date)::date),
+    rd.category_id,
+    rd.box_id;
 
 -- EditedAt取得専用
 -- name: GetEditedAtByItemID :one
@@ -492,6 +699,32 @@ WHERE
 AND
     user_id = sqlc.arg(user_id);
 
+-- 想起度に応じたスケジューリングで使う記憶の状態の取得
+-- name: GetMemoryStateByItemID :one
+SELECT
+    ease_factor,
+    stability,
+    difficulty
+FROM
+    review_items
+WHERE
+    id = sqlc.arg(id)
+AND
+    user_id = sqlc.arg(user_id);
+
+-- 想起度に応じたスケジューリングで使う記憶の状態の更新
+-- name: UpdateMemoryState :exec
+UPDATE
+    review_items
+SET
+    ease_factor = sqlc.arg(ease_factor),
+    stability = sqlc.arg(stability),
+    difficulty = sqlc.arg(difficulty)
+WHERE
+    id = sqlc.arg(id)
+AND
+    user_id = sqlc.arg(user_id);
+
 -- patternパッケージで使う
 -- name: IsPatternRelatedToItemByPatternID :one
 SELECT EXISTS (
@@ -505,20 +738,52 @@ SELECT EXISTS (
         user_id = sqlc.arg(user_id)
 );
 
--- 今日の全復習日数を取得
+-- 今日の全復習日数を取得（期限切れのまま残している復習日を含む。タグで絞り込み可能）
 -- name: CountAllDailyReviewDates :one
 SELECT
     COUNT(*) AS count
+FROM
+    review_dates rd
+JOIN
+    review_items ri
+ON
+    ri.id = rd.item_id
+LEFT JOIN
+    review_patterns rp
+ON
+    rp.id = ri.pattern_id
+WHERE
+    rd.user_id = sqlc.arg(user_id)
+AND (
+    rd.scheduled_date = sqlc.arg(target_date)
+OR (
+    -- 期限切れのまま残す復習パターンでは、未完了の期限切れの復習日も今日の復習に含める
+    rp.overdue_policy = 'keep'
+    AND rd.is_completed = false
+    AND rd.scheduled_date < sqlc.arg(target_date)
+))
+AND
+    (sqlc.narg(tag_id)::uuid IS NULL OR EXISTS (SELECT 1 FROM review_item_tags rit WHERE rit.item_id = rd.item_id AND rit.tag_id = sqlc.narg(tag_id)));
+
+-- 未完了の復習日数を日付毎に取得（復習日の負荷分散で使う。再計算対象の復習物を指定した場合はその復習物自身を除く）
+-- name: CountIncompleteReviewDatesGroupedByScheduledDate :many
+SELECT
+    scheduled_date,
+    COUNT(*) AS count
 FROM
     review_dates
 WHERE
     user_id = sqlc.arg(user_id)
 AND
-    scheduled_date = sqlc.arg(target_date);
+    (sqlc.narg(excluded_item_id)::uuid IS NULL OR item_id <> sqlc.narg(excluded_item_id))
+AND
+    is_completed = false
+GROUP BY
+    scheduled_date;
 
 -- LAG→item_idごとにstep_numberの昇順で並べた時、scheduled_dateが持つstep_numberより一個前のstep_numberのscheduled_dateを取得
 -- LEAD→item_idごとにstep_numberの昇順で並べた時、scheduled_dateが持つstep_numberより一個後のstep_numberのscheduled_dateを取得
--- 今日の復習日を取得するクエリ
+-- 今日の復習日を取得するクエリ（期限切れのまま残している復習日を含む）
 -- name: GetAllDailyReviewDates :many
 SELECT
     rd.id,
@@ -535,7 +800,8 @@ SELECT
     ri.detail,
     ri.learned_date,
     ri.registered_at,
-    ri.edited_at
+    ri.edited_at,
+    rp.target_weight
 FROM (
     SELECT
         id,
@@ -567,8 +833,18 @@ JOIN
     review_items AS ri
 ON
     ri.id = rd.item_id
+LEFT JOIN
+    review_patterns AS rp
+ON
+    rp.id = ri.pattern_id
 WHERE
     rd.scheduled_date = sqlc.arg(today)::date
+OR (
+    -- 期限切れのまま残す復習パターンでは、未完了の期限切れの復習日も今日の復習に含める
+    rp.overdue_policy = 'keep'
+    AND rd.is_completed = false
+    AND rd.scheduled_date < sqlc.arg(today)::date
+)
 ORDER BY
     rd.category_id    NULLS LAST,
     rd.box_id         NULLS LAST,
@@ -584,6 +860,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -609,6 +886,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -635,6 +913,7 @@ SELECT
     category_id,
     box_id,
     pattern_id,
+    pattern_version,
     name,
     detail,
     learned_date,
@@ -652,4 +931,588 @@ AND
 AND
     is_Finished = true
 ORDER BY
-    registered_at;
\ No newline at end of file
+    registered_at;
+-- 想起失敗の記録
+-- name: CreateReviewFailure :exec
+INSERT INTO
+    review_failures (
+        id,
+        user_id,
+        item_id,
+        step_number,
+        scheduled_date,
+        failed_date
+    )
+VALUES (
+    sqlc.arg(id),
+    sqlc.arg(user_id),
+    sqlc.arg(item_id),
+    sqlc.arg(step_number),
+    sqlc.arg(scheduled_date),
+    sqlc.arg(failed_date)
+    );
+
+-- 復習日を完了・未完了にした履歴の記録
+-- name: CreateReviewLog :exec
+INSERT INTO
+    review_logs (
+        id,
+        user_id,
+        item_id,
+        review_date_id,
+        step_number,
+        outcome,
+        grade,
+        duration_seconds,
+        reviewed_date,
+        reviewed_at
+    )
+VALUES (
+    sqlc.arg(id),
+    sqlc.arg(user_id),
+    sqlc.arg(item_id),
+    sqlc.narg(review_date_id),
+    sqlc.arg(step_number),
+    sqlc.arg(outcome),
+    sqlc.narg(grade),
+    sqlc.narg(duration_seconds),
+    sqlc.arg(reviewed_date),
+    sqlc.arg(reviewed_at)
+    );
+
+-- 復習物の履歴を新しい順に取得
+-- name: GetReviewLogsByItemID :many
+SELECT
+    id,
+    user_id,
+    item_id,
+    review_date_id,
+    step_number,
+    outcome,
+    grade,
+    duration_seconds,
+    reviewed_date,
+    reviewed_at
+FROM
+    review_logs
+WHERE
+    item_id = sqlc.arg(item_id)
+AND
+    user_id = sqlc.arg(user_id)
+ORDER BY
+    reviewed_at DESC,
+    created_at DESC;
+
+-- 連続学習日数の計算用に、復習日を完了した日を重複なく古い順に取得
+-- name: GetCompletedDatesByUserID :many
+SELECT DISTINCT
+    completed_date
+FROM
+    review_dates
+WHERE
+    user_id = sqlc.arg(user_id)
+AND
+    is_completed = true
+AND
+    completed_date IS NOT NULL
+AND
+    completed_date <= sqlc.arg(to_date)
+ORDER BY
+    completed_date;
+
+-- 完了率の計算用に、指定期間の日毎の予定されていた復習日数と完了済みの復習日数を取得（途中完了した復習物の未完了の復習日は数えない）
+-- 期限切れのずらしで動いた日ではなく、作成した時点の予定日で数える
+-- name: CountReviewCompletionGroupedByOriginalScheduledDate :many
+SELECT
+    rd.original_scheduled_date,
+    COUNT(*) AS due_count,
+    COUNT(*) FILTER (WHERE rd.is_completed = true) AS completed_count
+FROM
+    review_dates rd
+JOIN
+    review_items ri ON rd.item_id = ri.id
+WHERE
+    rd.user_id = sqlc.arg(user_id)
+AND
+    rd.original_scheduled_date BETWEEN sqlc.arg(from_date) AND sqlc.arg(to_date)
+AND
+    (rd.is_completed = true OR ri.is_finished = false)
+GROUP BY
+    rd.original_scheduled_date
+ORDER BY
+    rd.original_scheduled_date;
+
+-- 作成した時点の予定日から実際に完了した日までの平均の日数を取得（完了した日を記録していない復習日は除く）
+-- name: GetAverageReviewDelayByUserID :one
+SELECT
+    AVG(completed_date - original_scheduled_date)::float8 AS average_delay_days
+FROM
+    review_dates
+WHERE
+    user_id = sqlc.arg(user_id)
+AND
+    is_completed = true
+AND
+    completed_date IS NOT NULL;
+
+-- ヒートマップ用に、指定期間の日毎に完了した復習日数を取得（カテゴリー・ボックス・未分類で絞り込み可能）
+-- name: CountCompletedReviewsGroupedByCompletedDate :many
+SELECT
+    completed_date,
+    COUNT(*) AS count
+FROM
+    review_dates
+WHERE
+    user_id = sqlc.arg(user_id)
+AND
+    is_completed = true
+AND
+    completed_date BETWEEN sqlc.arg(from_date) AND sqlc.arg(to_date)
+AND
+    (sqlc.narg(category_id)::uuid IS NULL OR category_id = sqlc.narg(category_id))
+AND
+    (sqlc.narg(box_id)::uuid IS NULL OR box_id = sqlc.narg(box_id))
+AND
+    (NOT sqlc.arg(unclassified)::boolean OR (box_id IS NULL AND (sqlc.narg(category_id)::uuid IS NOT NULL OR category_id IS NULL)))
+GROUP BY
+    completed_date
+ORDER BY
+    completed_date;
+
+-- ヒートマップ用に、指定期間の日毎に学習した復習物数を取得（カテゴリー・ボックス・未分類で絞り込み可能）
+-- name: CountLearnedItemsGroupedByLearnedDate :many
+SELECT
+    learned_date,
+    COUNT(*) AS count
+FROM
+    review_items
+WHERE
+    user_id = sqlc.arg(user_id)
+AND
+    learned_date BETWEEN sqlc.arg(from_date) AND sqlc.arg(to_date)
+AND
+    (sqlc.narg(category_id)::uuid IS NULL OR category_id = sqlc.narg(category_id))
+AND
+    (sqlc.narg(box_id)::uuid IS NULL OR box_id = sqlc.narg(box_id))
+AND
+    (NOT sqlc.arg(unclassified)::boolean OR (box_id IS NULL AND (sqlc.narg(category_id)::uuid IS NOT NULL OR category_id IS NULL)))
+GROUP BY
+    learned_date
+ORDER BY
+    learned_date;
+
+-- ずらされた回数か想起に失敗した回数が基準以上の、完了していない復習物を取得（回数の多い順）
+-- name: GetLeechItemsByUserID :many
+SELECT
+    ri.id,
+    ri.category_id,
+    ri.box_id,
+    ri.name,
+    ri.slip_count,
+    COUNT(rf.id) AS failure_count
+FROM
+    review_items ri
+LEFT JOIN
+    review_failures rf
+ON
+    rf.item_id = ri.id
+WHERE
+    ri.user_id = sqlc.arg(user_id)
+AND
+    ri.is_finished = false
+GROUP BY
+    ri.id
+HAVING
+    ri.slip_count >= sqlc.arg(slip_threshold)
+OR
+    COUNT(rf.id) >= sqlc.arg(failure_threshold)::bigint
+ORDER BY
+    ri.slip_count + COUNT(rf.id) DESC,
+    ri.registered_at;
+
+-- 取り消し系
+-- 操作の直前の復習物・復習日・履歴・想起失敗・タグの紐付けの行をJSONBでそのまま保存する（復習物がまだない場合はitem_snapshotがNULLになる）
+-- name: CreateItemOperationSnapshot :exec
+INSERT INTO item_operation_snapshots (
+    operation_id,
+    user_id,
+    item_id,
+    kind,
+    item_snapshot,
+    review_dates_snapshot,
+    review_logs_snapshot,
+    review_failures_snapshot,
+    item_tags_snapshot,
+    operated_at
+)
+SELECT
+    sqlc.arg(operation_id)::uuid,
+    sqlc.arg(user_id)::uuid,
+    sqlc.arg(item_id)::uuid,
+    sqlc.arg(kind)::item_operation_kind_enum,
+    (
+        SELECT
+            to_jsonb(ri)
+        FROM
+            review_items ri
+        WHERE
+            ri.id = sqlc.arg(item_id)
+        AND
+            ri.user_id = sqlc.arg(user_id)
+    ),
+    COALESCE((
+        SELECT
+            jsonb_agg(to_jsonb(rd) ORDER BY rd.step_number)
+        FROM
+            review_dates rd
+        WHERE
+            rd.item_id = sqlc.arg(item_id)
+        AND
+            rd.user_id = sqlc.arg(user_id)
+    ), '[]'::jsonb),
+    COALESCE((
+        SELECT
+            jsonb_agg(to_jsonb(rl) ORDER BY rl.reviewed_at, rl.id)
+        FROM
+            review_logs rl
+        WHERE
+            rl.item_id = sqlc.arg(item_id)
+        AND
+            rl.user_id = sqlc.arg(user_id)
+    ), '[]'::jsonb),
+    COALESCE((
+        SELECT
+            jsonb_agg(to_jsonb(rf) ORDER BY rf.created_at, rf.id)
+        FROM
+            review_failures rf
+        WHERE
+            rf.item_id = sqlc.arg(item_id)
+        AND
+            rf.user_id = sqlc.arg(user_id)
+    ), '[]'::jsonb),
+    COALESCE((
+        SELECT
+            jsonb_agg(to_jsonb(rit) ORDER BY rit.tag_id)
+        FROM
+            review_item_tags rit
+        JOIN
+            tags t ON t.id = rit.tag_id
+        WHERE
+            rit.item_id = sqlc.arg(item_id)
+        AND
+            t.user_id = sqlc.arg(user_id)
+    ), '[]'::jsonb),
+    sqlc.arg(operated_at)::timestamptz;
+
+-- 指定日時以降の操作を新しい順に取得する
+-- name: GetItemOperationsByUserID :many
+SELECT
+    operation_id,
+    kind,
+    array_agg(item_id ORDER BY created_at, id)::uuid[] AS item_ids,
+    operated_at
+FROM
+    item_operation_snapshots
+WHERE
+    user_id = sqlc.arg(user_id)
+AND
+    operated_at >= sqlc.arg(operated_from)
+GROUP BY
+    operation_id,
+    kind,
+    operated_at
+ORDER BY
+    operated_at DESC,
+    operation_id DESC
+LIMIT sqlc.arg(max_operations);
+
+-- 保存した復習物が参照するカテゴリー・ボックス・復習パターンのうち、操作の後に削除されたものがあるか判別する
+-- name: HasDeletedReferenceInItemOperationSnapshots :one
+SELECT EXISTS (
+    SELECT
+        1
+    FROM
+        item_operation_snapshots s
+    CROSS JOIN LATERAL
+        jsonb_populate_record(NULL::review_items, s.item_snapshot) r
+    WHERE
+        s.operation_id = sqlc.arg(operation_id)
+    AND
+        s.user_id = sqlc.arg(user_id)
+    AND
+        s.item_snapshot IS NOT NULL
+    AND (
+        (r.category_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM categories c WHERE c.id = r.category_id))
+        OR
+        (r.box_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM review_boxes b WHERE b.id = r.box_id))
+        OR
+        (r.pattern_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM review_patterns p WHERE p.id = r.pattern_id))
+    ));
+
+-- 取り消す操作とその後の操作で記録された復習の履歴を削除する（スナップショットと同じトランザクションで記録されるため作成日時で判定する）
+-- name: DeleteReviewLogsRecordedSinceItemOperation :exec
+DELETE FROM
+    review_logs rl
+USING
+    item_operation_snapshots s
+WHERE
+    s.operation_id = sqlc.arg(operation_id)
+AND
+    s.user_id = sqlc.arg(user_id)
+AND
+    rl.item_id = s.item_id
+AND
+    rl.user_id = s.user_id
+AND
+    rl.created_at >= s.created_at;
+
+-- 取り消す操作とその後の操作で記録された想起失敗を削除する
+-- name: DeleteReviewFailuresRecordedSinceItemOperation :exec
+DELETE FROM
+    review_failures rf
+USING
+    item_operation_snapshots s
+WHERE
+    s.operation_id = sqlc.arg(operation_id)
+AND
+    s.user_id = sqlc.arg(user_id)
+AND
+    rf.item_id = s.item_id
+AND
+    rf.user_id = s.user_id
+AND
+    rf.created_at >= s.created_at;
+
+-- 作成の操作を取り消すため、操作の前になかった復習物を削除する（復習日はカスケードで削除される）
+-- name: DeleteItemsCreatedByItemOperation :exec
+DELETE FROM
+    review_items ri
+USING
+    item_operation_snapshots s
+WHERE
+    s.operation_id = sqlc.arg(operation_id)
+AND
+    s.user_id = sqlc.arg(user_id)
+AND
+    s.item_snapshot IS NULL
+AND
+    ri.id = s.item_id
+AND
+    ri.user_id = s.user_id;
+
+-- 保存した行で復習物を元に戻す（削除した復習物は作り直す）
+-- name: RestoreItemsFromItemOperationSnapshots :exec
+INSERT INTO review_items
+SELECT
+    r.*
+FROM
+    item_operation_snapshots s
+CROSS JOIN LATERAL
+    jsonb_populate_record(NULL::review_items, s.item_snapshot) r
+WHERE
+    s.operation_id = sqlc.arg(operation_id)
+AND
+    s.user_id = sqlc.arg(user_id)
+AND
+    s.item_snapshot IS NOT NULL
+ON CONFLICT (id) DO UPDATE SET
+    category_id = EXCLUDED.category_id,
+    box_id = EXCLUDED.box_id,
+    pattern_id = EXCLUDED.pattern_id,
+    name = EXCLUDED.name,
+    detail = EXCLUDED.detail,
+    learned_date = EXCLUDED.learned_date,
+    is_finished = EXCLUDED.is_finished,
+    registered_at = EXCLUDED.registered_at,
+    edited_at = EXCLUDED.edited_at,
+    ease_factor = EXCLUDED.ease_factor,
+    stability = EXCLUDED.stability,
+    difficulty = EXCLUDED.difficulty,
+    pattern_version = EXCLUDED.pattern_version,
+    slip_count = EXCLUDED.slip_count;
+
+-- 操作の後に作られた復習日を削除する（同じステップ番号の復習日を戻す前に消しておく）
+-- name: DeleteReviewDatesNotInItemOperationSnapshots :exec
+DELETE FROM
+    review_dates rd
+USING
+    item_operation_snapshots s
+WHERE
+    s.operation_id = sqlc.arg(operation_id)
+AND
+    s.user_id = sqlc.arg(user_id)
+AND
+    s.item_snapshot IS NOT NULL
+AND
+    rd.item_id = s.item_id
+AND
+    rd.user_id = s.user_id
+AND
+    NOT EXISTS (
+        SELECT
+            1
+        FROM
+            jsonb_populate_recordset(NULL::review_dates, s.review_dates_snapshot) d
+        WHERE
+            d.id = rd.id
+    );
+
+-- 保存した行で復習日を元に戻す（削除した復習日は作り直す）
+-- name: RestoreReviewDatesFromItemOperationSnapshots :exec
+INSERT INTO review_dates
+SELECT
+    d.*
+FROM
+    item_operation_snapshots s
+CROSS JOIN LATERAL
+    jsonb_populate_recordset(NULL::review_dates, s.review_dates_snapshot) d
+WHERE
+    s.operation_id = sqlc.arg(operation_id)
+AND
+    s.user_id = sqlc.arg(user_id)
+AND
+    s.item_snapshot IS NOT NULL
+ON CONFLICT (id) DO UPDATE SET
+    category_id = EXCLUDED.category_id,
+    box_id = EXCLUDED.box_id,
+    step_number = EXCLUDED.step_number,
+    initial_scheduled_date = EXCLUDED.initial_scheduled_date,
+    scheduled_date = EXCLUDED.scheduled_date,
+    is_completed = EXCLUDED.is_completed,
+    completed_date = EXCLUDED.completed_date;
+
+-- 保存した行で履歴を元に戻す（復習物と一緒に削除された履歴は作り直し、復習日の作り直しで外れた紐付けは戻す）
+-- name: RestoreReviewLogsFromItemOperationSnapshots :exec
+INSERT INTO review_logs
+SELECT
+    l.*
+FROM
+    item_operation_snapshots s
+CROSS JOIN LATERAL
+    jsonb_populate_recordset(NULL::review_logs, s.review_logs_snapshot) l
+WHERE
+    s.operation_id = sqlc.arg(operation_id)
+AND
+    s.user_id = sqlc.arg(user_id)
+AND
+    s.item_snapshot IS NOT NULL
+ON CONFLICT (id) DO UPDATE SET
+    review_date_id = EXCLUDED.review_date_id;
+
+-- 保存した行で想起失敗を元に戻す（復習物と一緒に削除された想起失敗を作り直す）
+-- name: RestoreReviewFailuresFromItemOperationSnapshots :exec
+INSERT INTO review_failures
+SELECT
+    f.*
+FROM
+    item_operation_snapshots s
+CROSS JOIN LATERAL
+    jsonb_populate_recordset(NULL::review_failures, s.review_failures_snapshot) f
+WHERE
+    s.operation_id = sqlc.arg(operation_id)
+AND
+    s.user_id = sqlc.arg(user_id)
+AND
+    s.item_snapshot IS NOT NULL
+ON CONFLICT (id) DO NOTHING;
+
+-- 保存した行でタグの紐付けを元に戻す（操作の後に削除されたタグは紐付けない）
+-- name: RestoreItemTagsFromItemOperationSnapshots :exec
+INSERT INTO review_item_tags (
+    item_id,
+    tag_id,
+    created_at
+)
+SELECT
+    t.item_id,
+    t.tag_id,
+    t.created_at
+FROM
+    item_operation_snapshots s
+CROSS JOIN LATERAL
+    jsonb_populate_recordset(NULL::review_item_tags, s.item_tags_snapshot) t
+WHERE
+    s.operation_id = sqlc.arg(operation_id)
+AND
+    s.user_id = sqlc.arg(user_id)
+AND
+    s.item_snapshot IS NOT NULL
+AND
+    EXISTS (
+        SELECT
+            1
+        FROM
+            tags tg
+        WHERE
+            tg.id = t.tag_id
+        AND
+            tg.user_id = s.user_id
+    )
+ON CONFLICT (item_id, tag_id) DO NOTHING;
+
+-- name: DeleteItemOperationSnapshots :exec
+DELETE FROM
+    item_operation_snapshots
+WHERE
+    operation_id = sqlc.arg(operation_id)
+AND
+    user_id = sqlc.arg(user_id);
+
+-- 名前か詳細に全てのパターンを含む復習物を取得（完了済み・未完了の両方が対象。カテゴリー・ボックス・学習日・完了状態で絞り込み可能）
+-- パターンはLIKEの特殊文字をエスケープした上で%で囲んだもの
+-- name: SearchItemsByUserID :many
+SELECT
+    id,
+    user_id,
+    category_id,
+    box_id,
+    pattern_id,
+    pattern_version,
+    name,
+    detail,
+    learned_date,
+    is_finished,
+    registered_at,
+    edited_at,
+    -- LIMITの前に数えるため、候補の上限に関わらず一致した全件数になる
+    COUNT(*) OVER () AS total_count
+FROM
+    review_items
+WHERE
+    user_id = sqlc.arg(user_id)
+AND
+    NOT EXISTS (
+        SELECT 1
+        FROM unnest(sqlc.arg(patterns)::text[]) AS p(pattern)
+        WHERE NOT (name ILIKE p.pattern OR COALESCE(detail, '') ILIKE p.pattern)
+    )
+AND
+    (sqlc.narg(category_id)::uuid IS NULL OR category_id = sqlc.narg(category_id))
+AND
+    (sqlc.narg(box_id)::uuid IS NULL OR box_id = sqlc.narg(box_id))
+AND
+    (sqlc.narg(learned_from)::date IS NULL OR learned_date >= sqlc.narg(learned_from))
+AND
+    (sqlc.narg(learned_to)::date IS NULL OR learned_date <= sqlc.narg(learned_to))
+AND
+    (sqlc.narg(is_finished)::boolean IS NULL OR is_finished = sqlc.narg(is_finished))
+ORDER BY
+    -- 候補の上限で関連度の高い復習物が漏れないよう、名前の完全一致・前方一致・部分一致・詳細の一致の順に点数を付けて並べる
+    -- 前方一致と完全一致のパターンは、部分一致のパターンの先頭・末尾の%を外して作る
+    (
+        SELECT
+            SUM(
+                CASE
+                    WHEN name ILIKE left(substr(p.pattern, 2), -1) THEN 100
+                    WHEN name ILIKE substr(p.pattern, 2) THEN 60
+                    WHEN name ILIKE p.pattern THEN 40
+                    ELSE 0
+                END
+                + CASE WHEN COALESCE(detail, '') ILIKE p.pattern THEN 10 ELSE 0 END
+            )
+        FROM unnest(sqlc.arg(patterns)::text[]) AS p(pattern)
+    ) DESC,
+    learned_date DESC,
+    id
+LIMIT
+    sqlc.arg(candidate_limit);
@@ -5,6 +5,13 @@ INSERT INTO
         user_id,
         name,
         target_weight,
+        scheduler_kind,
+        target_retention,
+        interval_fuzz,
+        interval_from_completion,
+        overdue_policy,
+        overdue_spread_days,
+        version,
         registered_at,
         edited_at
     )
@@ -13,6 +20,13 @@ VALUES (
         sqlc.arg(user_id),
         sqlc.arg(name),
         sqlc.arg(target_weight),
+        sqlc.arg(scheduler_kind),
+        sqlc.arg(target_retention),
+        sqlc.arg(interval_fuzz),
+        sqlc.arg(interval_from_completion),
+        sqlc.arg(overdue_policy),
+        sqlc.arg(overdue_spread_days),
+        sqlc.arg(version),
         sqlc.arg(registered_at),
         sqlc.arg(edited_at)
     );
@@ -25,13 +39,15 @@ INSERT INTO
         user_id,
         pattern_id,
         step_number,
-        interval_days
+        interval_days,
+        version
     ) VALUES (
         sqlc.arg(id),
         sqlc.arg(user_id),
         sqlc.arg(pattern_id),
         sqlc.arg(step_number),
-        sqlc.arg(interval_days)
+        sqlc.arg(interval_days),
+        sqlc.arg(version)
     );
 
 
@@ -42,6 +58,13 @@ SELECT
     user_id,
     name,
     target_weight,
+    scheduler_kind,
+    target_retention,
+    interval_fuzz,
+    interval_from_completion,
+    overdue_policy,
+    overdue_spread_days,
+    version,
     registered_at,
     edited_at
 FROM
@@ -51,18 +74,41 @@ WHERE
 AND
     user_id = sqlc.arg(user_id);
 
--- 復習ステップが更新対象かどうか判定するために使う
+-- 復習ステップが更新対象かどうか判定するために使う（最新バージョンのステップのみ）
 -- name: GetPatternStepsByPatternID :many
+SELECT
+    ps.id,
+    ps.user_id,
+    ps.pattern_id,
+    ps.step_number,
+    ps.interval_days,
+    ps.version
+FROM
+    pattern_steps ps
+JOIN
+    review_patterns rp ON ps.pattern_id = rp.id AND ps.version = rp.version
+WHERE
+    ps.pattern_id = sqlc.arg(pattern_id)
+AND
+    ps.user_id = sqlc.arg(user_id)
+ORDER BY
+    ps.step_number;
+
+-- 復習物が記録している、復習日を計算した時点のバージョンのステップを取得する
+-- name: GetPatternStepsByPatternVersion :many
 SELECT
     id,
     user_id,
     pattern_id,
     step_number,
-    interval_days
+    interval_days,
+    version
 FROM
     pattern_steps
 WHERE
     pattern_id = sqlc.arg(pattern_id)
+AND
+    version = sqlc.arg(version)
 AND
     user_id = sqlc.arg(user_id)
 ORDER BY
@@ -75,6 +121,13 @@ UPDATE
 SET
     name = sqlc.arg(name),
     target_weight = sqlc.arg(target_weight),
+    scheduler_kind = sqlc.arg(scheduler_kind),
+    target_retention = sqlc.arg(target_retention),
+    interval_fuzz = sqlc.arg(interval_fuzz),
+    interval_from_completion = sqlc.arg(interval_from_completion),
+    overdue_policy = sqlc.arg(overdue_policy),
+    overdue_spread_days = sqlc.arg(overdue_spread_days),
+    version = sqlc.arg(version),
     edited_at = sqlc.arg(edited_at)
 WHERE
     id = sqlc.arg(id)
@@ -110,6 +163,13 @@ SELECT
     user_id,
     name,
     target_weight,
+    scheduler_kind,
+    target_retention,
+    interval_fuzz,
+    interval_from_completion,
+    overdue_policy,
+    overdue_spread_days,
+    version,
     registered_at,
     edited_at
 FROM
@@ -122,18 +182,21 @@ ORDER BY
 --　全パターン取得機能（ステップ（子）のみ一覧取得（親は区別しない））
 -- name: GetAllPatternStepsByUserID :many
 SELECT
-    id,
-    user_id,
-    pattern_id,
-    step_number,
-    interval_days
+    ps.id,
+    ps.user_id,
+    ps.pattern_id,
+    ps.step_number,
+    ps.interval_days,
+    ps.version
 FROM
-    pattern_steps
+    pattern_steps ps
+JOIN
+    review_patterns rp ON ps.pattern_id = rp.id AND ps.version = rp.version
 WHERE
-    user_id = sqlc.arg(user_id)
+    ps.user_id = sqlc.arg(user_id)
 ORDER BY
-    pattern_id,
-    step_number;
+    ps.pattern_id,
+    ps.step_number;
 
 -- item_usecaseで使うクエリ。
 -- name: GetPatternTargetWeightsByPatternIDs :many
@@ -0,0 +1,110 @@
+-- name: CreateTag :exec
+INSERT INTO
+    tags (
+        id,
+        user_id,
+        name,
+        registered_at,
+        edited_at
+    ) VALUES (
+        sqlc.arg(id),
+        sqlc.arg(user_id),
+        sqlc.arg(name),
+        sqlc.arg(registered_at),
+        sqlc.arg(edited_at)
+    );
+
+-- name: GetAllTagsByUserID :many
+SELECT
+    id,
+    user_id,
+    name,
+    registered_at,
+    edited_at
+FROM
+    tags
+WHERE
+    user_id = sqlc.arg(user_id)
+ORDER BY
+    registered_at;
+
+-- name: UpdateTag :exec
+UPDATE
+    tags
+SET
+    name = sqlc.arg(name),
+    edited_at = sqlc.arg(edited_at)
+WHERE
+    id = sqlc.arg(id) AND user_id = sqlc.arg(user_id);
+
+-- name: DeleteTag :exec
+DELETE
+FROM
+    tags
+WHERE
+    id = sqlc.arg(id) AND user_id = sqlc.arg(user_id);
+
+-- 復習物とタグの紐付け
+-- name: ExistsItemByID :one
+SELECT EXISTS (
+    SELECT
+        1
+    FROM
+        review_items
+    WHERE
+        id = sqlc.arg(item_id)
+    AND
+        user_id = sqlc.arg(user_id)
+);
+
+-- name: GetTagsByItemID :many
+SELECT
+    t.id,
+    t.user_id,
+    t.name,
+    t.registered_at,
+    t.edited_at
+FROM
+    review_item_tags it
+JOIN
+    tags t ON t.id = it.tag_id
+WHERE
+    it.item_id = sqlc.arg(item_id)
+AND
+    t.user_id = sqlc.arg(user_id)
+ORDER BY
+    t.registered_at;
+
+-- name: DeleteItemTagsByItemID :exec
+DELETE
+FROM
+    review_item_tags it
+USING
+    review_items i
+WHERE
+    it.item_id = i.id
+AND
+    i.id = sqlc.arg(item_id)
+AND
+    i.user_id = sqlc.arg(user_id);
+
+-- 復習物と同じユーザーのタグだけを紐付ける
+-- name: CreateItemTags :exec
+INSERT INTO
+    review_item_tags (
+        item_id,
+        tag_id
+    )
+SELECT
+    i.id,
+    t.id
+FROM
+    review_items i
+JOIN
+    tags t ON t.user_id = i.user_id
+WHERE
+    i.id = sqlc.arg(item_id)
+AND
+    i.user_id = sqlc.arg(user_id)
+AND
+    t.id = ANY(sqlc.arg(tag_ids)::uuid[]);
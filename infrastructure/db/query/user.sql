@@ -68,4 +68,124 @@ UPDATE
 SET
     verified_at = sqlc.arg(verified_at)
 WHERE
-    id = sqlc.arg(id);
\ No newline at end of file
+    id = sqlc.arg(id);
+
+-- 休息日系
+-- name: GetRestWeekdaysByUserID :one
+SELECT
+    rest_weekdays
+FROM
+    users
+WHERE
+    id = sqlc.arg(id);
+
+-- name: GetRestDatesByUserID :many
+SELECT
+    rest_date
+FROM
+    user_rest_dates
+WHERE
+    user_id = sqlc.arg(user_id)
+ORDER BY
+    rest_date;
+
+-- name: UpdateRestWeekdays :exec
+UPDATE
+    users
+SET
+    rest_weekdays = sqlc.arg(rest_weekdays)
+WHERE
+    id = sqlc.arg(id);
+
+-- name: DeleteRestDatesByUserID :exec
+DELETE
+FROM
+    user_rest_dates
+WHERE
+    user_id = sqlc.arg(user_id);
+
+-- name: CreateRestDates :exec
+INSERT INTO
+    user_rest_dates (
+        user_id,
+        rest_date
+    )
+SELECT
+    sqlc.arg(user_id),
+    UNNEST(sqlc.arg(rest_dates)::date[]);
+
+-- 1日の最大復習数系
+-- name: GetMaxReviewsPerDayByUserID :one
+SELECT
+    max_reviews_per_day
+FROM
+    users
+WHERE
+    id = sqlc.arg(id);
+
+-- name: UpdateMaxReviewsPerDay :exec
+UPDATE
+    users
+SET
+    max_reviews_per_day = sqlc.arg(max_reviews_per_day)
+WHERE
+    id = sqlc.arg(id);
+
+-- 休暇系
+-- name: CreateVacation :exec
+INSERT INTO
+    user_vacations (
+        id,
+        user_id,
+        start_date,
+        end_date
+    )
+VALUES (
+    sqlc.arg(id),
+    sqlc.arg(user_id),
+    sqlc.arg(start_date),
+    sqlc.arg(end_date)
+);
+
+-- name: HasOverlappingVacation :one
+SELECT EXISTS (
+    SELECT
+        1
+    FROM
+        user_vacations
+    WHERE
+        user_id = sqlc.arg(user_id)
+    AND
+        start_date <= sqlc.arg(end_date)
+    AND
+        end_date >= sqlc.arg(start_date)
+);
+
+-- 休暇の見積もり用に、指定日以降の未完了の復習日数を日付毎に取得
+-- name: CountIncompleteReviewDatesFromDate :many
+SELECT
+    scheduled_date,
+    COUNT(*) AS count
+FROM
+    review_dates
+WHERE
+    user_id = sqlc.arg(user_id)
+AND
+    is_completed = false
+AND
+    scheduled_date >= sqlc.arg(from_date)
+GROUP BY
+    scheduled_date;
+
+-- 指定日以降の未完了の復習日を指定日数だけ後ろにずらす（ずらした先が休息日なら、休息日でない次の日にする）
+-- name: ShiftIncompleteReviewDatesFromDate :exec
+UPDATE
+    review_dates
+SET
+    scheduled_date = next_available_review_date(user_id, scheduled_date + sqlc.arg(days)::int)
+WHERE
+    user_id = sqlc.arg(user_id)
+AND
+    is_completed = false
+AND
+    scheduled_date >= sqlc.arg(from_date);
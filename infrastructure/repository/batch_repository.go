@@ -2,12 +2,16 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/minminseo/recall-setter/infrastructure/db"
 )
 
 type IBatchRepository interface {
 	ExecuteUpdateOverdueScheduledDates(ctx context.Context) error
+	ExecuteDeleteExpiredItemOperations(ctx context.Context, operatedBefore time.Time) error
 }
 
 type batchRepository struct{}
@@ -20,3 +24,8 @@ func (r *batchRepository) ExecuteUpdateOverdueScheduledDates(ctx context.Context
 	q := db.GetQuery(ctx)
 	return q.UpdateOverdueScheduledDatesAndSlideFutureDates(ctx)
 }
+
+func (r *batchRepository) ExecuteDeleteExpiredItemOperations(ctx context.Context, operatedBefore time.Time) error {
+	q := db.GetQuery(ctx)
+	return q.DeleteItemOperationSnapshotsBefore(ctx, pgtype.Timestamptz{Time: operatedBefore, Valid: true})
+}
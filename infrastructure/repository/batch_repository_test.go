@@ -2,6 +2,10 @@ package repository
 
 import (
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	itemDomain "github.com/minminseo/recall-setter/domain/item"
 )
 
 func TestBatchRepository_ExecuteUpdateOverdueScheduledDates(t *testing.T) {
@@ -43,3 +47,41 @@ func TestBatchRepository_ExecuteUpdateOverdueScheduledDates(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchRepository_ExecuteDeleteExpiredItemOperations(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	ctx := GetTestContext()
+	itemRepo := NewItemRepository()
+	repo := NewBatchRepository()
+
+	userID := "550e8400-e29b-41d4-a716-446655440001"
+	itemID := "a50e8400-e29b-41d4-a716-446655440001"
+	now := time.Now().UTC()
+
+	expired := &itemDomain.ItemOperation{OperationID: uuid.NewString(), UserID: userID, Kind: itemDomain.ItemOperationKindUpdateItem, ItemIDs: []string{itemID}, OperatedAt: now.Add(-time.Hour)}
+	recent := &itemDomain.ItemOperation{OperationID: uuid.NewString(), UserID: userID, Kind: itemDomain.ItemOperationKindUpdateItem, ItemIDs: []string{itemID}, OperatedAt: now.Add(-time.Minute)}
+	for _, op := range []*itemDomain.ItemOperation{expired, recent} {
+		if err := itemRepo.SaveItemOperation(ctx, op); err != nil {
+			t.Fatalf("SaveItemOperation() error = %v", err)
+		}
+	}
+
+	if err := repo.ExecuteDeleteExpiredItemOperations(ctx, now.Add(-30*time.Minute)); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	// 期限内の操作だけが残る
+	operations, err := itemRepo.GetItemOperationsByUserID(ctx, userID, now.Add(-2*time.Hour), 10)
+	if err != nil {
+		t.Fatalf("GetItemOperationsByUserID() error = %v", err)
+	}
+	if len(operations) != 1 || operations[0].OperationID != recent.OperationID {
+		t.Errorf("残った操作 = %+v, want %s のみ", operations, recent.OperationID)
+	}
+}
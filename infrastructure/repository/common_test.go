@@ -109,12 +109,19 @@ func CleanupTestDatabase(t *testing.T) {
 
 	tables := []string{
 		"email_verifications",
+		"item_operation_snapshots",
+		"review_item_tags",
+		"review_failures",
+		"review_logs",
 		"review_dates",
 		"review_items",
 		"review_boxes",
 		"pattern_steps",
 		"review_patterns",
 		"categories",
+		"tags",
+		"user_rest_dates",
+		"user_vacations",
 		"users",
 	}
 
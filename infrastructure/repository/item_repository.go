@@ -2,11 +2,14 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	itemDomain "github.com/minminseo/recall-setter/domain/item"
@@ -28,6 +31,20 @@ func toNullableUUID(s *string) (pgtype.UUID, error) {
 	return toUUID(*s)
 }
 
+// 復習パターンのバージョンをpgtype.Int4に変換するヘルパー関数。復習パターンがない場合（0）はNULLにする。
+func toNullablePatternVersion(version int) pgtype.Int4 {
+	if version == 0 {
+		return pgtype.Int4{Valid: false}
+	}
+	return pgtype.Int4{Int32: int32(version), Valid: true} // #nosec G115
+}
+
+// LIKEのパターンで特殊な意味を持つ文字（\ % _）をエスケープし、部分一致のパターンにする
+func toContainsPattern(term string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(term)
+	return "%" + escaped + "%"
+}
+
 func (r *itemRepository) CreateItem(ctx context.Context, item *itemDomain.Item) error {
 	q := db.GetQuery(ctx)
 
@@ -53,19 +70,28 @@ func (r *itemRepository) CreateItem(ctx context.Context, item *itemDomain.Item)
 	}
 
 	params := dbgen.CreateItemParams{
-		ID:           pgID,
-		UserID:       pgUserID,
-		CategoryID:   pgCategoryID,
-		BoxID:        pgBoxID,
-		PatternID:    pgPatternID,
-		Name:         item.Name,
-		Detail:       pgtype.Text{String: item.Detail, Valid: true},
-		LearnedDate:  pgtype.Date{Time: item.LearnedDate, Valid: true},
-		IsFinished:   item.IsFinished,
-		RegisteredAt: pgtype.Timestamptz{Time: item.RegisteredAt, Valid: true},
-		EditedAt:     pgtype.Timestamptz{Time: item.EditedAt, Valid: true},
-	}
-	return q.CreateItem(ctx, params)
+		ID:             pgID,
+		UserID:         pgUserID,
+		CategoryID:     pgCategoryID,
+		BoxID:          pgBoxID,
+		PatternID:      pgPatternID,
+		PatternVersion: toNullablePatternVersion(item.PatternVersion),
+		Name:           item.Name,
+		Detail:         pgtype.Text{String: item.Detail, Valid: true},
+		LearnedDate:    pgtype.Date{Time: item.LearnedDate, Valid: true},
+		IsFinished:     item.IsFinished,
+		RegisteredAt:   pgtype.Timestamptz{Time: item.RegisteredAt, Valid: true},
+		EditedAt:       pgtype.Timestamptz{Time: item.EditedAt, Valid: true},
+	}
+	// 復習物IDはクライアントが指定できるため、主キーの重複（unique_violation）をドメインのエラーに変換する
+	var pgErr *pgconn.PgError
+	if err := q.CreateItem(ctx, params); err != nil {
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return itemDomain.ErrItemAlreadyExists
+		}
+		return err
+	}
+	return nil
 }
 
 func (r *itemRepository) CreateReviewdates(ctx context.Context, reviewdates []*itemDomain.Reviewdate) (int64, error) {
@@ -104,8 +130,12 @@ func (r *itemRepository) CreateReviewdates(ctx context.Context, reviewdates []*i
 			ItemID:               pgItemID,
 			StepNumber:           int16(rd.StepNumber), // #nosec G115
 			InitialScheduledDate: pgtype.Date{Time: rd.InitialScheduledDate, Valid: true},
-			ScheduledDate:        pgtype.Date{Time: rd.ScheduledDate, Valid: true},
-			IsCompleted:          rd.IsCompleted,
+			// 作成した時点の予定日として残し、以降は更新しない
+			OriginalScheduledDate: pgtype.Date{Time: rd.InitialScheduledDate, Valid: true},
+			ScheduledDate:         pgtype.Date{Time: rd.ScheduledDate, Valid: true},
+			IsCompleted:           rd.IsCompleted,
+			// 自動で完了扱いにした復習日は継続日数などの集計に含めないよう、完了日をNULLのままにする
+			CompletedDate: pgtype.Date{Valid: false},
 		}
 
 		rows[i] = []interface{}{
@@ -116,12 +146,14 @@ func (r *itemRepository) CreateReviewdates(ctx context.Context, reviewdates []*i
 			params[i].ItemID,
 			params[i].StepNumber,
 			params[i].InitialScheduledDate,
+			params[i].OriginalScheduledDate,
 			params[i].ScheduledDate,
 			params[i].IsCompleted,
+			params[i].CompletedDate,
 		}
 	}
 
-	columns := []string{"id", "user_id", "category_id", "box_id", "item_id", "step_number", "initial_scheduled_date", "scheduled_date", "is_completed"}
+	columns := []string{"id", "user_id", "category_id", "box_id", "item_id", "step_number", "initial_scheduled_date", "original_scheduled_date", "scheduled_date", "is_completed", "completed_date"}
 	return q.CopyFrom(
 		ctx,
 		pgx.Identifier{"review_dates"},
@@ -169,6 +201,7 @@ func (r *itemRepository) GetItemByID(ctx context.Context, itemID string, userID
 		categoryID,
 		boxID,
 		patternID,
+		int(row.PatternVersion.Int32),
 		row.Name,
 		row.Detail.String,
 		row.LearnedDate.Time,
@@ -247,16 +280,17 @@ func (r *itemRepository) UpdateItem(ctx context.Context, item *itemDomain.Item)
 	}
 
 	params := dbgen.UpdateItemParams{
-		ID:          pgID,
-		UserID:      pgUserID,
-		CategoryID:  pgCategoryID,
-		BoxID:       pgBoxID,
-		PatternID:   pgPatternID,
-		Name:        item.Name,
-		Detail:      pgtype.Text{String: item.Detail, Valid: true},
-		LearnedDate: pgtype.Date{Time: item.LearnedDate, Valid: true},
-		IsFinished:  item.IsFinished,
-		EditedAt:    pgtype.Timestamptz{Time: item.EditedAt, Valid: true},
+		ID:             pgID,
+		UserID:         pgUserID,
+		CategoryID:     pgCategoryID,
+		BoxID:          pgBoxID,
+		PatternID:      pgPatternID,
+		PatternVersion: toNullablePatternVersion(item.PatternVersion),
+		Name:           item.Name,
+		Detail:         pgtype.Text{String: item.Detail, Valid: true},
+		LearnedDate:    pgtype.Date{Time: item.LearnedDate, Valid: true},
+		IsFinished:     item.IsFinished,
+		EditedAt:       pgtype.Timestamptz{Time: item.EditedAt, Valid: true},
 	}
 	return q.UpdateItem(ctx, params)
 }
@@ -355,7 +389,7 @@ func (r *itemRepository) UpdateItemAsUnFinished(ctx context.Context, itemID stri
 	return q.UpdateItemAsUnfinished(ctx, params)
 }
 
-func (r *itemRepository) UpdateReviewDateAsCompleted(ctx context.Context, reviewdateID string, userID string) error {
+func (r *itemRepository) UpdateReviewDateAsCompleted(ctx context.Context, reviewdateID string, userID string, completedDate time.Time) error {
 	q := db.GetQuery(ctx)
 	pgID, err := toUUID(reviewdateID)
 	if err != nil {
@@ -366,8 +400,9 @@ func (r *itemRepository) UpdateReviewDateAsCompleted(ctx context.Context, review
 		return err
 	}
 	params := dbgen.UpdateReviewDateAsCompletedParams{
-		ID:     pgID,
-		UserID: pgUserID,
+		CompletedDate: pgtype.Date{Time: completedDate, Valid: true},
+		ID:            pgID,
+		UserID:        pgUserID,
 	}
 	return q.UpdateReviewDateAsCompleted(ctx, params)
 }
@@ -389,6 +424,174 @@ func (r *itemRepository) UpdateReviewDateAsInCompleted(ctx context.Context, revi
 	return q.UpdateReviewDateAsInCompleted(ctx, params)
 }
 
+func (r *itemRepository) GetMemoryStateByItemID(ctx context.Context, itemID string, userID string) (*itemDomain.MemoryState, error) {
+	q := db.GetQuery(ctx)
+	pgItemID, err := toUUID(itemID)
+	if err != nil {
+		return nil, err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	params := dbgen.GetMemoryStateByItemIDParams{
+		ID:     pgItemID,
+		UserID: pgUserID,
+	}
+	row, err := q.GetMemoryStateByItemID(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &itemDomain.MemoryState{
+		EaseFactor: row.EaseFactor,
+		Stability:  row.Stability,
+		Difficulty: row.Difficulty,
+	}, nil
+}
+
+func (r *itemRepository) UpdateMemoryState(ctx context.Context, itemID string, userID string, state itemDomain.MemoryState) error {
+	q := db.GetQuery(ctx)
+	pgItemID, err := toUUID(itemID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return err
+	}
+	params := dbgen.UpdateMemoryStateParams{
+		EaseFactor: state.EaseFactor,
+		Stability:  state.Stability,
+		Difficulty: state.Difficulty,
+		ID:         pgItemID,
+		UserID:     pgUserID,
+	}
+	return q.UpdateMemoryState(ctx, params)
+}
+
+func (r *itemRepository) CreateReviewFailure(ctx context.Context, failure *itemDomain.ReviewFailure) error {
+	q := db.GetQuery(ctx)
+	pgID, err := toUUID(failure.ReviewFailureID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(failure.UserID)
+	if err != nil {
+		return err
+	}
+	pgItemID, err := toUUID(failure.ItemID)
+	if err != nil {
+		return err
+	}
+	params := dbgen.CreateReviewFailureParams{
+		ID:            pgID,
+		UserID:        pgUserID,
+		ItemID:        pgItemID,
+		StepNumber:    int16(failure.StepNumber),
+		ScheduledDate: pgtype.Date{Time: failure.ScheduledDate, Valid: true},
+		FailedDate:    pgtype.Date{Time: failure.FailedDate, Valid: true},
+	}
+	return q.CreateReviewFailure(ctx, params)
+}
+
+func (r *itemRepository) CreateReviewLog(ctx context.Context, log *itemDomain.ReviewLog) error {
+	q := db.GetQuery(ctx)
+	pgID, err := toUUID(log.ReviewLogID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(log.UserID)
+	if err != nil {
+		return err
+	}
+	pgItemID, err := toUUID(log.ItemID)
+	if err != nil {
+		return err
+	}
+	pgReviewDateID, err := toNullableUUID(log.ReviewDateID)
+	if err != nil {
+		return err
+	}
+	grade := pgtype.Int2{Valid: false}
+	if log.Grade != nil {
+		grade = pgtype.Int2{Int16: int16(*log.Grade), Valid: true} // #nosec G115
+	}
+	durationSeconds := pgtype.Int4{Valid: false}
+	if log.DurationSeconds != nil {
+		durationSeconds = pgtype.Int4{Int32: int32(*log.DurationSeconds), Valid: true} // #nosec G115
+	}
+	params := dbgen.CreateReviewLogParams{
+		ID:              pgID,
+		UserID:          pgUserID,
+		ItemID:          pgItemID,
+		ReviewDateID:    pgReviewDateID,
+		StepNumber:      int16(log.StepNumber),
+		Outcome:         dbgen.ReviewOutcomeEnum(log.Outcome),
+		Grade:           grade,
+		DurationSeconds: durationSeconds,
+		ReviewedDate:    pgtype.Date{Time: log.ReviewedDate, Valid: true},
+		ReviewedAt:      pgtype.Timestamptz{Time: log.ReviewedAt, Valid: true},
+	}
+	return q.CreateReviewLog(ctx, params)
+}
+
+func (r *itemRepository) GetReviewLogsByItemID(ctx context.Context, itemID string, userID string) ([]*itemDomain.ReviewLog, error) {
+	q := db.GetQuery(ctx)
+	pgItemID, err := toUUID(itemID)
+	if err != nil {
+		return nil, err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := dbgen.GetReviewLogsByItemIDParams{
+		ItemID: pgItemID,
+		UserID: pgUserID,
+	}
+	rows, err := q.GetReviewLogsByItemID(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*itemDomain.ReviewLog, len(rows))
+	for i, row := range rows {
+		var reviewDateID *string
+		if row.ReviewDateID.Valid {
+			idStr := uuid.UUID(row.ReviewDateID.Bytes).String()
+			reviewDateID = &idStr
+		}
+		var grade *int
+		if row.Grade.Valid {
+			g := int(row.Grade.Int16)
+			grade = &g
+		}
+		var durationSeconds *int
+		if row.DurationSeconds.Valid {
+			d := int(row.DurationSeconds.Int32)
+			durationSeconds = &d
+		}
+		log, err := itemDomain.ReconstructReviewLog(
+			uuid.UUID(row.ID.Bytes).String(),
+			uuid.UUID(row.UserID.Bytes).String(),
+			uuid.UUID(row.ItemID.Bytes).String(),
+			reviewDateID,
+			int(row.StepNumber),
+			string(row.Outcome),
+			grade,
+			durationSeconds,
+			row.ReviewedDate.Time,
+			row.ReviewedAt.Time,
+		)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = log
+	}
+	return results, nil
+}
+
 func (r *itemRepository) GetReviewDatesByItemID(ctx context.Context, itemID string, userID string) ([]*itemDomain.Reviewdate, error) {
 	q := db.GetQuery(ctx)
 	pgItemID, err := toUUID(itemID)
@@ -430,6 +633,7 @@ func (r *itemRepository) GetReviewDatesByItemID(ctx context.Context, itemID stri
 			row.InitialScheduledDate.Time,
 			row.ScheduledDate.Time,
 			row.IsCompleted,
+			fromNullableDate(row.CompletedDate),
 		)
 		if err != nil {
 			return nil, err
@@ -472,6 +676,149 @@ func (r *itemRepository) DeleteReviewDates(ctx context.Context, itemID string, u
 	return q.DeleteReviewDates(ctx, params)
 }
 
+func (r *itemRepository) GetUnFinishedItemsByPatternID(ctx context.Context, patternID string, userID string) ([]*itemDomain.Item, error) {
+	q := db.GetQuery(ctx)
+	pgPatternID, err := toUUID(patternID)
+	if err != nil {
+		return nil, err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	params := dbgen.GetUnFinishedItemsByPatternIDParams{
+		PatternID: pgPatternID,
+		UserID:    pgUserID,
+	}
+	rows, err := q.GetUnFinishedItemsByPatternID(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*itemDomain.Item, len(rows))
+	for i, row := range rows {
+		var categoryID, boxID, patternID *string
+		if row.CategoryID.Valid {
+			idStr := uuid.UUID(row.CategoryID.Bytes).String()
+			categoryID = &idStr
+		}
+		if row.BoxID.Valid {
+			idStr := uuid.UUID(row.BoxID.Bytes).String()
+			boxID = &idStr
+		}
+		if row.PatternID.Valid {
+			idStr := uuid.UUID(row.PatternID.Bytes).String()
+			patternID = &idStr
+		}
+		results[i], err = itemDomain.ReconstructItem(
+			uuid.UUID(row.ID.Bytes).String(),
+			uuid.UUID(row.UserID.Bytes).String(),
+			categoryID,
+			boxID,
+			patternID,
+			int(row.PatternVersion.Int32),
+			row.Name,
+			row.Detail.String,
+			row.LearnedDate.Time,
+			row.IsFinished,
+			row.RegisteredAt.Time,
+			row.EditedAt.Time,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func (r *itemRepository) GetReviewDatesOfUnFinishedItemsByPatternID(ctx context.Context, patternID string, userID string) ([]*itemDomain.Reviewdate, error) {
+	q := db.GetQuery(ctx)
+	pgPatternID, err := toUUID(patternID)
+	if err != nil {
+		return nil, err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	params := dbgen.GetReviewDatesOfUnFinishedItemsByPatternIDParams{
+		PatternID: pgPatternID,
+		UserID:    pgUserID,
+	}
+	rows, err := q.GetReviewDatesOfUnFinishedItemsByPatternID(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*itemDomain.Reviewdate, len(rows))
+	for i, row := range rows {
+		var categoryID, boxID *string
+		if row.CategoryID.Valid {
+			idStr := uuid.UUID(row.CategoryID.Bytes).String()
+			categoryID = &idStr
+		}
+		if row.BoxID.Valid {
+			idStr := uuid.UUID(row.BoxID.Bytes).String()
+			boxID = &idStr
+		}
+		results[i], err = itemDomain.ReconstructReviewdate(
+			uuid.UUID(row.ID.Bytes).String(),
+			uuid.UUID(row.UserID.Bytes).String(),
+			categoryID,
+			boxID,
+			uuid.UUID(row.ItemID.Bytes).String(),
+			int(row.StepNumber),
+			row.InitialScheduledDate.Time,
+			row.ScheduledDate.Time,
+			row.IsCompleted,
+			fromNullableDate(row.CompletedDate),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func (r *itemRepository) DeleteReviewDatesByIDs(ctx context.Context, reviewdateIDs []string, userID string) error {
+	q := db.GetQuery(ctx)
+	pgIDs := make([]pgtype.UUID, len(reviewdateIDs))
+	for i, id := range reviewdateIDs {
+		pgID, err := toUUID(id)
+		if err != nil {
+			return err
+		}
+		pgIDs[i] = pgID
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return err
+	}
+	params := dbgen.DeleteReviewDatesByIDsParams{
+		Ids:    pgIDs,
+		UserID: pgUserID,
+	}
+	return q.DeleteReviewDatesByIDs(ctx, params)
+}
+
+func (r *itemRepository) UpdatePatternVersionOfUnFinishedItems(ctx context.Context, patternID string, patternVersion int, userID string) error {
+	q := db.GetQuery(ctx)
+	pgPatternID, err := toUUID(patternID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return err
+	}
+	params := dbgen.UpdatePatternVersionOfUnFinishedItemsParams{
+		PatternVersion: toNullablePatternVersion(patternVersion),
+		PatternID:      pgPatternID,
+		UserID:         pgUserID,
+	}
+	return q.UpdatePatternVersionOfUnFinishedItems(ctx, params)
+}
+
 func (r *itemRepository) GetAllUnFinishedItemsByBoxID(ctx context.Context, boxID string, userID string) ([]*itemDomain.Item, error) {
 	q := db.GetQuery(ctx)
 	pgBoxID, err := toUUID(boxID)
@@ -512,6 +859,7 @@ func (r *itemRepository) GetAllUnFinishedItemsByBoxID(ctx context.Context, boxID
 			categoryID,
 			boxID,
 			patternID,
+			int(row.PatternVersion.Int32),
 			row.Name,
 			row.Detail.String,
 			row.LearnedDate.Time,
@@ -565,6 +913,7 @@ func (r *itemRepository) GetAllReviewDatesByBoxID(ctx context.Context, boxID str
 			row.InitialScheduledDate.Time,
 			row.ScheduledDate.Time,
 			row.IsCompleted,
+			fromNullableDate(row.CompletedDate),
 		)
 		if err != nil {
 			return nil, err
@@ -596,6 +945,7 @@ func (r *itemRepository) GetAllUnFinishedUnclassifiedItemsByUserID(ctx context.C
 			nil, // Unclassified
 			nil, // Unclassified
 			patternID,
+			int(row.PatternVersion.Int32),
 			row.Name,
 			row.Detail.String,
 			row.LearnedDate.Time,
@@ -632,6 +982,7 @@ func (r *itemRepository) GetAllUnclassifiedReviewDatesByUserID(ctx context.Conte
 			row.InitialScheduledDate.Time,
 			row.ScheduledDate.Time,
 			row.IsCompleted,
+			fromNullableDate(row.CompletedDate),
 		)
 		if err != nil {
 			return nil, err
@@ -674,6 +1025,7 @@ func (r *itemRepository) GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx conte
 			&catIDStr,
 			nil, // Unclassified
 			patternID,
+			int(row.PatternVersion.Int32),
 			row.Name,
 			row.Detail.String,
 			row.LearnedDate.Time,
@@ -719,6 +1071,7 @@ func (r *itemRepository) GetAllUnclassifiedReviewDatesByCategoryID(ctx context.C
 			row.InitialScheduledDate.Time,
 			row.ScheduledDate.Time,
 			row.IsCompleted,
+			fromNullableDate(row.CompletedDate),
 		)
 		if err != nil {
 			return nil, err
@@ -727,6 +1080,33 @@ func (r *itemRepository) GetAllUnclassifiedReviewDatesByCategoryID(ctx context.C
 	return results, nil
 }
 
+func (r *itemRepository) GetItemIDsByTagID(ctx context.Context, tagID string, userID string) ([]string, error) {
+	q := db.GetQuery(ctx)
+	pgTagID, err := toUUID(tagID)
+	if err != nil {
+		return nil, err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := dbgen.GetItemIDsByTagIDParams{
+		TagID:  pgTagID,
+		UserID: pgUserID,
+	}
+	rows, err := q.GetItemIDsByTagID(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	itemIDs := make([]string, len(rows))
+	for i, row := range rows {
+		itemIDs[i] = uuid.UUID(row.Bytes).String()
+	}
+	return itemIDs, nil
+}
+
 func (r *itemRepository) CountItemsGroupedByBoxByUserID(ctx context.Context, userID string) ([]*itemDomain.ItemCountGroupedByBox, error) {
 	q := db.GetQuery(ctx)
 	pgUserID, err := toUUID(userID)
@@ -784,15 +1164,20 @@ func (r *itemRepository) CountUnclassifiedItemsByUserID(ctx context.Context, use
 	return int(counts[0]), nil
 }
 
-func (r *itemRepository) CountDailyDatesGroupedByBoxByUserID(ctx context.Context, userID string, targetDate time.Time) ([]*itemDomain.DailyCountGroupedByBox, error) {
+func (r *itemRepository) CountDailyDatesGroupedByBoxByUserID(ctx context.Context, userID string, targetDate time.Time, tagID *string) ([]*itemDomain.DailyCountGroupedByBox, error) {
 	q := db.GetQuery(ctx)
 	pgUserID, err := toUUID(userID)
 	if err != nil {
 		return nil, err
 	}
+	pgTagID, err := toNullableUUID(tagID)
+	if err != nil {
+		return nil, err
+	}
 	params := dbgen.CountDailyDatesGroupedByBoxByUserIDParams{
 		UserID:     pgUserID,
 		TargetDate: pgtype.Date{Time: targetDate, Valid: true},
+		TagID:      pgTagID,
 	}
 	rows, err := q.CountDailyDatesGroupedByBoxByUserID(ctx, params)
 	if err != nil {
@@ -809,15 +1194,20 @@ func (r *itemRepository) CountDailyDatesGroupedByBoxByUserID(ctx context.Context
 	return results, nil
 }
 
-func (r *itemRepository) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, userID string, targetDate time.Time) ([]*itemDomain.UnclassifiedDailyDatesCountGroupedByCategory, error) {
+func (r *itemRepository) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, userID string, targetDate time.Time, tagID *string) ([]*itemDomain.UnclassifiedDailyDatesCountGroupedByCategory, error) {
 	q := db.GetQuery(ctx)
 	pgUserID, err := toUUID(userID)
 	if err != nil {
 		return nil, err
 	}
+	pgTagID, err := toNullableUUID(tagID)
+	if err != nil {
+		return nil, err
+	}
 	params := dbgen.CountDailyDatesUnclassifiedGroupedByCategoryByUserIDParams{
 		UserID:     pgUserID,
 		TargetDate: pgtype.Date{Time: targetDate, Valid: true},
+		TagID:      pgTagID,
 	}
 	rows, err := q.CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx, params)
 	if err != nil {
@@ -833,15 +1223,20 @@ func (r *itemRepository) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ct
 	return results, nil
 }
 
-func (r *itemRepository) CountDailyDatesUnclassifiedByUserID(ctx context.Context, userID string, targetDate time.Time) (int, error) {
+func (r *itemRepository) CountDailyDatesUnclassifiedByUserID(ctx context.Context, userID string, targetDate time.Time, tagID *string) (int, error) {
 	q := db.GetQuery(ctx)
 	pgUserID, err := toUUID(userID)
 	if err != nil {
 		return 0, err
 	}
+	pgTagID, err := toNullableUUID(tagID)
+	if err != nil {
+		return 0, err
+	}
 	params := dbgen.CountDailyDatesUnclassifiedByUserIDParams{
 		UserID:     pgUserID,
 		TargetDate: pgtype.Date{Time: targetDate, Valid: true},
+		TagID:      pgTagID,
 	}
 	counts, err := q.CountDailyDatesUnclassifiedByUserID(ctx, params)
 	if err != nil {
@@ -870,10 +1265,447 @@ func (r *itemRepository) IsPatternRelatedToItemByPatternID(ctx context.Context,
 	return q.IsPatternRelatedToItemByPatternID(ctx, params)
 }
 
-// EditedAtの取得専用
-func (r *itemRepository) GetEditedAtByItemID(ctx context.Context, itemID string, userID string) (time.Time, error) {
+func (r *itemRepository) CountIncompleteReviewDatesByUserID(ctx context.Context, userID string, excludedItemID *string) (map[string]int, error) {
 	q := db.GetQuery(ctx)
-	pgItemID, err := toUUID(itemID)
+
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	pgItemID, err := toNullableUUID(excludedItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.CountIncompleteReviewDatesGroupedByScheduledDate(ctx, dbgen.CountIncompleteReviewDatesGroupedByScheduledDateParams{
+		UserID:         pgUserID,
+		ExcludedItemID: pgItemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		if !row.ScheduledDate.Valid {
+			continue
+		}
+		counts[row.ScheduledDate.Time.Format("2006-01-02")] = int(row.Count)
+	}
+	return counts, nil
+}
+
+func (r *itemRepository) GetCompletedDatesByUserID(ctx context.Context, userID string, toDate time.Time) ([]time.Time, error) {
+	q := db.GetQuery(ctx)
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.GetCompletedDatesByUserID(ctx, dbgen.GetCompletedDatesByUserIDParams{
+		UserID: pgUserID,
+		ToDate: pgtype.Date{Time: toDate, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]time.Time, 0, len(rows))
+	for _, row := range rows {
+		if !row.Valid {
+			continue
+		}
+		results = append(results, row.Time)
+	}
+	return results, nil
+}
+
+func (r *itemRepository) CountReviewCompletionByUserID(ctx context.Context, userID string, fromDate time.Time, toDate time.Time) ([]*itemDomain.ReviewCompletionCount, error) {
+	q := db.GetQuery(ctx)
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.CountReviewCompletionGroupedByOriginalScheduledDate(ctx, dbgen.CountReviewCompletionGroupedByOriginalScheduledDateParams{
+		UserID:   pgUserID,
+		FromDate: pgtype.Date{Time: fromDate, Valid: true},
+		ToDate:   pgtype.Date{Time: toDate, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*itemDomain.ReviewCompletionCount, len(rows))
+	for i, row := range rows {
+		results[i] = &itemDomain.ReviewCompletionCount{
+			ScheduledDate:  row.OriginalScheduledDate.Time,
+			DueCount:       int(row.DueCount),
+			CompletedCount: int(row.CompletedCount),
+		}
+	}
+	return results, nil
+}
+
+func (r *itemRepository) GetAverageReviewDelayByUserID(ctx context.Context, userID string) (*float64, error) {
+	q := db.GetQuery(ctx)
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := q.GetAverageReviewDelayByUserID(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !row.Valid {
+		return nil, nil
+	}
+	return &row.Float64, nil
+}
+
+func (r *itemRepository) CountCompletedReviewsByDate(ctx context.Context, userID string, fromDate time.Time, toDate time.Time, filter *itemDomain.HeatmapFilter) ([]*itemDomain.DailyActivityCount, error) {
+	q := db.GetQuery(ctx)
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	pgCategoryID, err := toNullableUUID(filter.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	pgBoxID, err := toNullableUUID(filter.BoxID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.CountCompletedReviewsGroupedByCompletedDate(ctx, dbgen.CountCompletedReviewsGroupedByCompletedDateParams{
+		UserID:       pgUserID,
+		FromDate:     pgtype.Date{Time: fromDate, Valid: true},
+		ToDate:       pgtype.Date{Time: toDate, Valid: true},
+		CategoryID:   pgCategoryID,
+		BoxID:        pgBoxID,
+		Unclassified: filter.Unclassified,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*itemDomain.DailyActivityCount, len(rows))
+	for i, row := range rows {
+		results[i] = &itemDomain.DailyActivityCount{
+			Date:  row.CompletedDate.Time,
+			Count: int(row.Count),
+		}
+	}
+	return results, nil
+}
+
+func (r *itemRepository) CountLearnedItemsByDate(ctx context.Context, userID string, fromDate time.Time, toDate time.Time, filter *itemDomain.HeatmapFilter) ([]*itemDomain.DailyActivityCount, error) {
+	q := db.GetQuery(ctx)
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	pgCategoryID, err := toNullableUUID(filter.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	pgBoxID, err := toNullableUUID(filter.BoxID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.CountLearnedItemsGroupedByLearnedDate(ctx, dbgen.CountLearnedItemsGroupedByLearnedDateParams{
+		UserID:       pgUserID,
+		FromDate:     pgtype.Date{Time: fromDate, Valid: true},
+		ToDate:       pgtype.Date{Time: toDate, Valid: true},
+		CategoryID:   pgCategoryID,
+		BoxID:        pgBoxID,
+		Unclassified: filter.Unclassified,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*itemDomain.DailyActivityCount, len(rows))
+	for i, row := range rows {
+		results[i] = &itemDomain.DailyActivityCount{
+			Date:  row.LearnedDate.Time,
+			Count: int(row.Count),
+		}
+	}
+	return results, nil
+}
+
+func (r *itemRepository) GetLeechItemsByUserID(ctx context.Context, userID string, slipThreshold int, failureThreshold int) ([]*itemDomain.LeechItem, error) {
+	q := db.GetQuery(ctx)
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.GetLeechItemsByUserID(ctx, dbgen.GetLeechItemsByUserIDParams{
+		UserID:           pgUserID,
+		SlipThreshold:    int32(slipThreshold), // #nosec G115
+		FailureThreshold: int64(failureThreshold),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*itemDomain.LeechItem, len(rows))
+	for i, row := range rows {
+		var categoryID, boxID *string
+		if row.CategoryID.Valid {
+			idStr := uuid.UUID(row.CategoryID.Bytes).String()
+			categoryID = &idStr
+		}
+		if row.BoxID.Valid {
+			idStr := uuid.UUID(row.BoxID.Bytes).String()
+			boxID = &idStr
+		}
+		results[i] = &itemDomain.LeechItem{
+			ItemID:       uuid.UUID(row.ID.Bytes).String(),
+			CategoryID:   categoryID,
+			BoxID:        boxID,
+			Name:         row.Name,
+			SlipCount:    int(row.SlipCount),
+			FailureCount: int(row.FailureCount),
+		}
+	}
+	return results, nil
+}
+
+func (r *itemRepository) IncrementSlipCount(ctx context.Context, itemID string, userID string) error {
+	q := db.GetQuery(ctx)
+	pgItemID, err := toUUID(itemID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return err
+	}
+	return q.IncrementItemSlipCount(ctx, dbgen.IncrementItemSlipCountParams{
+		ID:     pgItemID,
+		UserID: pgUserID,
+	})
+}
+
+func (r *itemRepository) SaveItemOperation(ctx context.Context, operation *itemDomain.ItemOperation) error {
+	q := db.GetQuery(ctx)
+	pgOperationID, err := toUUID(operation.OperationID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(operation.UserID)
+	if err != nil {
+		return err
+	}
+
+	// 変更する復習物毎に、操作の直前の行を保存する
+	for _, itemID := range operation.ItemIDs {
+		pgItemID, err := toUUID(itemID)
+		if err != nil {
+			return err
+		}
+		err = q.CreateItemOperationSnapshot(ctx, dbgen.CreateItemOperationSnapshotParams{
+			OperationID: pgOperationID,
+			UserID:      pgUserID,
+			ItemID:      pgItemID,
+			Kind:        dbgen.ItemOperationKindEnum(operation.Kind),
+			OperatedAt:  pgtype.Timestamptz{Time: operation.OperatedAt, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *itemRepository) GetItemOperationsByUserID(ctx context.Context, userID string, operatedFrom time.Time, limit int) ([]*itemDomain.ItemOperation, error) {
+	q := db.GetQuery(ctx)
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.GetItemOperationsByUserID(ctx, dbgen.GetItemOperationsByUserIDParams{
+		UserID:        pgUserID,
+		OperatedFrom:  pgtype.Timestamptz{Time: operatedFrom, Valid: true},
+		MaxOperations: int32(limit), // #nosec G115
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*itemDomain.ItemOperation, len(rows))
+	for i, row := range rows {
+		itemIDs := make([]string, len(row.ItemIds))
+		for j, id := range row.ItemIds {
+			itemIDs[j] = uuid.UUID(id.Bytes).String()
+		}
+		results[i], err = itemDomain.ReconstructItemOperation(
+			uuid.UUID(row.OperationID.Bytes).String(),
+			userID,
+			string(row.Kind),
+			itemIDs,
+			row.OperatedAt.Time,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func (r *itemRepository) RestoreItemOperation(ctx context.Context, operationID string, userID string) error {
+	q := db.GetQuery(ctx)
+	pgOperationID, err := toUUID(operationID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return err
+	}
+
+	// 戻す復習物が参照する行が削除されていると外部キー制約で戻せないため、先に判別する
+	hasDeletedReference, err := q.HasDeletedReferenceInItemOperationSnapshots(ctx, dbgen.HasDeletedReferenceInItemOperationSnapshotsParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+	if hasDeletedReference {
+		return itemDomain.ErrItemOperationReferenceDeleted
+	}
+
+	// 操作で記録された履歴・想起失敗は、復習物・復習日と一緒に取り消す
+	err = q.DeleteReviewLogsRecordedSinceItemOperation(ctx, dbgen.DeleteReviewLogsRecordedSinceItemOperationParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+	err = q.DeleteReviewFailuresRecordedSinceItemOperation(ctx, dbgen.DeleteReviewFailuresRecordedSinceItemOperationParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 作成した復習物の削除 → 復習物の復元 → 後から作られた復習日の削除 → 復習日の復元の順に行う
+	// （復習日は復習物に、同じステップ番号の復習日の復元は古い復習日の削除に依存するため）
+	err = q.DeleteItemsCreatedByItemOperation(ctx, dbgen.DeleteItemsCreatedByItemOperationParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+	err = q.RestoreItemsFromItemOperationSnapshots(ctx, dbgen.RestoreItemsFromItemOperationSnapshotsParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+	err = q.DeleteReviewDatesNotInItemOperationSnapshots(ctx, dbgen.DeleteReviewDatesNotInItemOperationSnapshotsParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+	err = q.RestoreReviewDatesFromItemOperationSnapshots(ctx, dbgen.RestoreReviewDatesFromItemOperationSnapshotsParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 復習物の削除でカスケードして消えた行を作り直す（履歴は復習日を参照するため復習日の後に戻す）
+	err = q.RestoreReviewLogsFromItemOperationSnapshots(ctx, dbgen.RestoreReviewLogsFromItemOperationSnapshotsParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+	err = q.RestoreReviewFailuresFromItemOperationSnapshots(ctx, dbgen.RestoreReviewFailuresFromItemOperationSnapshotsParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+	err = q.RestoreItemTagsFromItemOperationSnapshots(ctx, dbgen.RestoreItemTagsFromItemOperationSnapshotsParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+	return q.DeleteItemOperationSnapshots(ctx, dbgen.DeleteItemOperationSnapshotsParams{
+		OperationID: pgOperationID,
+		UserID:      pgUserID,
+	})
+}
+
+// EditedAtの取得専用
+func (r *itemRepository) CountReviewForecastByUserID(ctx context.Context, userID string, fromDate time.Time, toDate time.Time, tagID *string) ([]*itemDomain.ReviewForecastCount, error) {
+	q := db.GetQuery(ctx)
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	pgTagID, err := toNullableUUID(tagID)
+	if err != nil {
+		return nil, err
+	}
+	params := dbgen.CountReviewForecastByUserIDParams{
+		UserID:   pgUserID,
+		FromDate: pgtype.Date{Time: fromDate, Valid: true},
+		ToDate:   pgtype.Date{Time: toDate, Valid: true},
+		TagID:    pgTagID,
+	}
+	rows, err := q.CountReviewForecastByUserID(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*itemDomain.ReviewForecastCount, len(rows))
+	for i, row := range rows {
+		var categoryID *string
+		if row.CategoryID.Valid {
+			s := uuid.UUID(row.CategoryID.Bytes).String()
+			categoryID = &s
+		}
+
+		var boxID *string
+		if row.BoxID.Valid {
+			s := uuid.UUID(row.BoxID.Bytes).String()
+			boxID = &s
+		}
+
+		results[i] = &itemDomain.ReviewForecastCount{
+			ScheduledDate: row.ScheduledDate.Time,
+			CategoryID:    categoryID,
+			BoxID:         boxID,
+			Count:         int(row.Count),
+		}
+	}
+	return results, nil
+}
+
+func (r *itemRepository) GetEditedAtByItemID(ctx context.Context, itemID string, userID string) (time.Time, error) {
+	q := db.GetQuery(ctx)
+	pgItemID, err := toUUID(itemID)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -893,18 +1725,23 @@ func (r *itemRepository) GetEditedAtByItemID(ctx context.Context, itemID string,
 }
 
 // 今日の全復習日数を取得
-func (r *itemRepository) CountAllDailyReviewDates(ctx context.Context, userID string, targetDate time.Time) (int, error) {
+func (r *itemRepository) CountAllDailyReviewDates(ctx context.Context, userID string, targetDate time.Time, tagID *string) (int, error) {
 	q := db.GetQuery(ctx)
 	pgUserID, err := toUUID(userID)
 	if err != nil {
 		return 0, err
 	}
+	pgTagID, err := toNullableUUID(tagID)
+	if err != nil {
+		return 0, err
+	}
 
 	pgToday := pgtype.Date{Time: targetDate, Valid: true}
 
 	params := dbgen.CountAllDailyReviewDatesParams{
 		UserID:     pgUserID,
 		TargetDate: pgToday,
+		TagID:      pgTagID,
 	}
 
 	counts, err := q.CountAllDailyReviewDates(ctx, params)
@@ -988,6 +1825,7 @@ func (r *itemRepository) GetAllDailyReviewDates(ctx context.Context, userID stri
 			LearnedDate:          learnedDate,
 			RegisteredAt:         row.RegisteredAt.Time,
 			EditedAt:             row.EditedAt.Time,
+			TargetWeight:         string(row.TargetWeight.TargetWeightEnum),
 		}
 	}
 
@@ -1034,6 +1872,7 @@ func (r *itemRepository) GetFinishedItemsByBoxID(ctx context.Context, boxID stri
 			categoryID,
 			boxID,
 			patternID,
+			int(row.PatternVersion.Int32),
 			row.Name,
 			row.Detail.String,
 			row.LearnedDate.Time,
@@ -1080,6 +1919,7 @@ func (r *itemRepository) GetUnclassfiedFinishedItemsByCategoryID(ctx context.Con
 			&catIDStr,
 			nil, // Unclassified
 			patternID,
+			int(row.PatternVersion.Int32),
 			row.Name,
 			row.Detail.String,
 			row.LearnedDate.Time,
@@ -1117,6 +1957,7 @@ func (r *itemRepository) GetUnclassfiedFinishedItemsByUserID(ctx context.Context
 			nil, // Unclassified
 			nil, // Unclassified
 			patternID,
+			int(row.PatternVersion.Int32),
 			row.Name,
 			row.Detail.String,
 			row.LearnedDate.Time,
@@ -1130,3 +1971,82 @@ func (r *itemRepository) GetUnclassfiedFinishedItemsByUserID(ctx context.Context
 	}
 	return results, nil
 }
+
+func (r *itemRepository) SearchItems(ctx context.Context, userID string, terms []string, filter *itemDomain.ItemSearchFilter, limit int) ([]*itemDomain.Item, int, error) {
+	q := db.GetQuery(ctx)
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	pgCategoryID, err := toNullableUUID(filter.CategoryID)
+	if err != nil {
+		return nil, 0, err
+	}
+	pgBoxID, err := toNullableUUID(filter.BoxID)
+	if err != nil {
+		return nil, 0, err
+	}
+	patterns := make([]string, len(terms))
+	for i, term := range terms {
+		patterns[i] = toContainsPattern(term)
+	}
+	params := dbgen.SearchItemsByUserIDParams{
+		UserID:         pgUserID,
+		Patterns:       patterns,
+		CategoryID:     pgCategoryID,
+		BoxID:          pgBoxID,
+		CandidateLimit: int32(limit), // #nosec G115
+	}
+	if filter.LearnedFrom != nil {
+		params.LearnedFrom = pgtype.Date{Time: *filter.LearnedFrom, Valid: true}
+	}
+	if filter.LearnedTo != nil {
+		params.LearnedTo = pgtype.Date{Time: *filter.LearnedTo, Valid: true}
+	}
+	if filter.IsFinished != nil {
+		params.IsFinished = pgtype.Bool{Bool: *filter.IsFinished, Valid: true}
+	}
+
+	rows, err := q.SearchItemsByUserID(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+	total := 0
+	if len(rows) > 0 {
+		total = int(rows[0].TotalCount)
+	}
+	results := make([]*itemDomain.Item, len(rows))
+	for i, row := range rows {
+		var categoryID, boxID, patternID *string
+		if row.CategoryID.Valid {
+			idStr := uuid.UUID(row.CategoryID.Bytes).String()
+			categoryID = &idStr
+		}
+		if row.BoxID.Valid {
+			idStr := uuid.UUID(row.BoxID.Bytes).String()
+			boxID = &idStr
+		}
+		if row.PatternID.Valid {
+			idStr := uuid.UUID(row.PatternID.Bytes).String()
+			patternID = &idStr
+		}
+		results[i], err = itemDomain.ReconstructItem(
+			uuid.UUID(row.ID.Bytes).String(),
+			uuid.UUID(row.UserID.Bytes).String(),
+			categoryID,
+			boxID,
+			patternID,
+			int(row.PatternVersion.Int32),
+			row.Name,
+			row.Detail.String,
+			row.LearnedDate.Time,
+			row.IsFinished,
+			row.RegisteredAt.Time,
+			row.EditedAt.Time,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return results, total, nil
+}
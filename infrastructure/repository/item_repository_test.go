@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"database/sql"
+	"errors"
+	"sort"
 	"testing"
 	"time"
 
@@ -27,9 +30,10 @@ func TestItemRepository_CreateItem(t *testing.T) {
 
 	now := time.Now()
 	tests := []struct {
-		name    string
-		item    *itemDomain.Item
-		wantErr bool
+		name      string
+		item      *itemDomain.Item
+		wantErr   bool
+		wantErrIs error
 	}{
 		{
 			name: "ボックス・パターンありで復習物作成に成功する場合",
@@ -82,6 +86,19 @@ func TestItemRepository_CreateItem(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "既に存在する復習物IDの場合",
+			item: &itemDomain.Item{
+				ItemID:       "a50e8400-e29b-41d4-a716-446655440004", // 他のユーザーの復習物
+				UserID:       "550e8400-e29b-41d4-a716-446655440001",
+				Name:         "重複したIDの問題",
+				LearnedDate:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				RegisteredAt: now,
+				EditedAt:     now,
+			},
+			wantErr:   true,
+			wantErrIs: itemDomain.ErrItemAlreadyExists,
+		},
 	}
 
 	for _, tc := range tests {
@@ -96,6 +113,9 @@ func TestItemRepository_CreateItem(t *testing.T) {
 				if err == nil {
 					t.Error("expected error but got none")
 				}
+				if tc.wantErrIs != nil && !errors.Is(err, tc.wantErrIs) {
+					t.Errorf("error = %v, want %v", err, tc.wantErrIs)
+				}
 				return
 			}
 
@@ -174,6 +194,37 @@ func TestItemRepository_CreateReviewdates(t *testing.T) {
 			wantCount: 2,
 			wantErr:   false,
 		},
+		{
+			name: "期限切れを完了扱いにした復習日を作成する場合",
+			reviewdates: []*itemDomain.Reviewdate{
+				{
+					ReviewdateID:         "c50e8400-e29b-41d4-a716-446655440003",
+					UserID:               "550e8400-e29b-41d4-a716-446655440001",
+					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
+					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
+					StepNumber:           5,
+					InitialScheduledDate: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+					ScheduledDate:        time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+					IsCompleted:          true,
+				},
+			},
+			want: []*itemDomain.Reviewdate{
+				{
+					ReviewdateID:         "c50e8400-e29b-41d4-a716-446655440003",
+					UserID:               "550e8400-e29b-41d4-a716-446655440001",
+					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
+					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
+					StepNumber:           5,
+					InitialScheduledDate: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+					ScheduledDate:        time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+					IsCompleted:          true,
+				},
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -222,6 +273,17 @@ func TestItemRepository_CreateReviewdates(t *testing.T) {
 			if diff := cmp.Diff(tc.want, actualReviewdates); diff != "" {
 				t.Errorf("CreateReviewdates() mismatch (-want +got):\n%s", diff)
 			}
+
+			// 完了扱いで作成した復習日は実際には復習していないので、完了日はNULLのまま
+			for _, rd := range tc.reviewdates {
+				var completedDate sql.NullTime
+				if err := testDB.QueryRow("SELECT completed_date FROM review_dates WHERE id = $1", rd.ReviewdateID).Scan(&completedDate); err != nil {
+					t.Fatalf("完了日の取得に失敗: %v", err)
+				}
+				if completedDate.Valid {
+					t.Errorf("completed_date = %v, want NULL", completedDate.Time)
+				}
+			}
 		})
 	}
 }
@@ -521,7 +583,9 @@ func TestItemRepository_UpdateReviewDates(t *testing.T) {
 		reviewdates []*itemDomain.Reviewdate
 		userID      string
 		want        []*itemDomain.Reviewdate
-		wantErr     bool
+		// 完了日がNULLのままで、完了した日の集計に含まれないことを検証する
+		wantNoCompletedDate bool
+		wantErr             bool
 	}{
 		{
 			name: "復習日の更新に成功する場合",
@@ -554,6 +618,25 @@ func TestItemRepository_UpdateReviewDates(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "未完了の復習日を完了扱いにした場合は完了日を記録しない",
+			reviewdates: []*itemDomain.Reviewdate{
+				{
+					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440002",
+					UserID:               "550e8400-e29b-41d4-a716-446655440001",
+					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
+					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
+					StepNumber:           2,
+					InitialScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+					ScheduledDate:        time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+					IsCompleted:          true,
+				},
+			},
+			userID:              "550e8400-e29b-41d4-a716-446655440001",
+			wantNoCompletedDate: true,
+			wantErr:             false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -601,6 +684,26 @@ func TestItemRepository_UpdateReviewDates(t *testing.T) {
 					}
 				}
 			}
+
+			if tc.wantNoCompletedDate {
+				var completedDate sql.NullTime
+				if err := testDB.QueryRow("SELECT completed_date FROM review_dates WHERE id = $1", tc.reviewdates[0].ReviewdateID).Scan(&completedDate); err != nil {
+					t.Fatalf("完了日の取得に失敗: %v", err)
+				}
+				if completedDate.Valid {
+					t.Errorf("completed_date = %v, want NULL", completedDate.Time)
+				}
+
+				completedDates, err := repo.GetCompletedDatesByUserID(ctx, tc.userID, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+				if err != nil {
+					t.Fatalf("完了した日の取得に失敗: %v", err)
+				}
+				for _, d := range completedDates {
+					if d.Equal(tc.reviewdates[0].ScheduledDate) {
+						t.Errorf("完了扱いにしただけの復習日が完了した日に含まれています: %v", d)
+					}
+				}
+			}
 		})
 	}
 }
@@ -882,16 +985,18 @@ func TestItemRepository_UpdateReviewDateAsCompleted(t *testing.T) {
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name         string
-		reviewdateID string
-		userID       string
-		want         *itemDomain.Reviewdate
-		wantErr      bool
+		name          string
+		reviewdateID  string
+		userID        string
+		completedDate time.Time
+		want          *itemDomain.Reviewdate
+		wantErr       bool
 	}{
 		{
-			name:         "復習日を完了状態に更新する場合",
-			reviewdateID: "b50e8400-e29b-41d4-a716-446655440001", // フィクスチャでは未完了
-			userID:       "550e8400-e29b-41d4-a716-446655440001",
+			name:          "復習日を完了状態に更新する場合",
+			reviewdateID:  "b50e8400-e29b-41d4-a716-446655440001", // フィクスチャでは未完了
+			userID:        "550e8400-e29b-41d4-a716-446655440001",
+			completedDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), // 予定日より2日遅れて完了
 			want: &itemDomain.Reviewdate{
 				ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440001",
 				UserID:               "550e8400-e29b-41d4-a716-446655440001",
@@ -902,6 +1007,7 @@ func TestItemRepository_UpdateReviewDateAsCompleted(t *testing.T) {
 				InitialScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
 				ScheduledDate:        time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
 				IsCompleted:          true, // 完了状態に更新される
+				CompletedDate:        timePtr(time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)),
 			},
 			wantErr: false,
 		},
@@ -913,7 +1019,7 @@ func TestItemRepository_UpdateReviewDateAsCompleted(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			err := repo.UpdateReviewDateAsCompleted(ctx, tc.reviewdateID, tc.userID)
+			err := repo.UpdateReviewDateAsCompleted(ctx, tc.reviewdateID, tc.userID, tc.completedDate)
 
 			if tc.wantErr {
 				if err == nil {
@@ -952,6 +1058,15 @@ func TestItemRepository_UpdateReviewDateAsCompleted(t *testing.T) {
 				if diff := cmp.Diff(tc.want, updatedReviewDate); diff != "" {
 					t.Errorf("UpdateReviewDateAsCompleted() mismatch (-want +got):\n%s", diff)
 				}
+
+				// 完了した日が記録されていること
+				var completedDate sql.NullTime
+				if err := testDB.QueryRow("SELECT completed_date FROM review_dates WHERE id = $1", tc.reviewdateID).Scan(&completedDate); err != nil {
+					t.Fatalf("完了日の取得に失敗: %v", err)
+				}
+				if !completedDate.Valid || !completedDate.Time.Equal(tc.completedDate) {
+					t.Errorf("completed_date = %v, want %v", completedDate, tc.completedDate)
+				}
 			}
 		})
 	}
@@ -1036,12 +1151,21 @@ func TestItemRepository_UpdateReviewDateAsInCompleted(t *testing.T) {
 				if diff := cmp.Diff(tc.want, updatedReviewDate); diff != "" {
 					t.Errorf("UpdateReviewDateAsInCompleted() mismatch (-want +got):\n%s", diff)
 				}
+
+				// 記録した完了日が消えていること
+				var completedDate sql.NullTime
+				if err := testDB.QueryRow("SELECT completed_date FROM review_dates WHERE id = $1", tc.reviewdateID).Scan(&completedDate); err != nil {
+					t.Fatalf("完了日の取得に失敗: %v", err)
+				}
+				if completedDate.Valid {
+					t.Errorf("completed_date = %v, want NULL", completedDate.Time)
+				}
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetReviewDatesByItemID(t *testing.T) {
+func TestItemRepository_UpdateMemoryState(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1050,41 +1174,20 @@ func TestItemRepository_GetReviewDatesByItemID(t *testing.T) {
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name    string
-		itemID  string
-		userID  string
-		want    []*itemDomain.Reviewdate
-		wantErr bool
+		name       string
+		itemID     string
+		userID     string
+		state      itemDomain.MemoryState
+		wantBefore *itemDomain.MemoryState
+		wantErr    bool
 	}{
 		{
-			name:   "復習物に関連する復習日を取得する場合",
-			itemID: "a50e8400-e29b-41d4-a716-446655440001",
-			userID: "550e8400-e29b-41d4-a716-446655440001",
-			want: []*itemDomain.Reviewdate{
-				{
-					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440001",
-					UserID:               "550e8400-e29b-41d4-a716-446655440001",
-					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
-					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
-					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
-					StepNumber:           1,
-					InitialScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
-					ScheduledDate:        time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
-					IsCompleted:          false,
-				},
-				{
-					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440002",
-					UserID:               "550e8400-e29b-41d4-a716-446655440001",
-					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
-					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
-					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
-					StepNumber:           2,
-					InitialScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
-					ScheduledDate:        time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
-					IsCompleted:          false,
-				},
-			},
-			wantErr: false,
+			name:       "復習物の記憶の状態を更新する場合",
+			itemID:     "a50e8400-e29b-41d4-a716-446655440001",
+			userID:     "550e8400-e29b-41d4-a716-446655440001",
+			state:      itemDomain.MemoryState{EaseFactor: 2.6, Stability: 3.7, Difficulty: 5.2},
+			wantBefore: &itemDomain.MemoryState{EaseFactor: 2.5, Stability: 0, Difficulty: 0}, // フィクスチャでは未指定なので初期値
+			wantErr:    false,
 		},
 	}
 
@@ -1094,7 +1197,16 @@ func TestItemRepository_GetReviewDatesByItemID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			reviewdates, err := repo.GetReviewDatesByItemID(ctx, tc.itemID, tc.userID)
+			before, err := repo.GetMemoryStateByItemID(ctx, tc.itemID, tc.userID)
+			if err != nil {
+				t.Errorf("記憶の状態の取得に失敗: %v", err)
+				return
+			}
+			if diff := cmp.Diff(tc.wantBefore, before); diff != "" {
+				t.Errorf("更新前の記憶の状態が一致しません (-want +got):\n%s", diff)
+			}
+
+			err = repo.UpdateMemoryState(ctx, tc.itemID, tc.userID, tc.state)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1108,14 +1220,19 @@ func TestItemRepository_GetReviewDatesByItemID(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, reviewdates); diff != "" {
-				t.Errorf("GetReviewDatesByItemID() mismatch (-want +got):\n%s", diff)
+			after, err := repo.GetMemoryStateByItemID(ctx, tc.itemID, tc.userID)
+			if err != nil {
+				t.Errorf("更新された記憶の状態の取得に失敗: %v", err)
+				return
+			}
+			if diff := cmp.Diff(&tc.state, after); diff != "" {
+				t.Errorf("更新後の記憶の状態が一致しません (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_DeleteItem(t *testing.T) {
+func TestItemRepository_CreateReviewFailure(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1124,20 +1241,33 @@ func TestItemRepository_DeleteItem(t *testing.T) {
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name             string
-		itemID           string
-		userID           string
-		want             *itemDomain.Item // 削除後の取得結果（nilなら削除成功）
-		wantErr          bool
-		wantGetItemError bool // GetItemByIDでエラーが発生するか
+		name    string
+		failure *itemDomain.ReviewFailure
+		wantErr bool
 	}{
 		{
-			name:             "復習物削除に成功する場合",
-			itemID:           "a50e8400-e29b-41d4-a716-446655440005", // 明治維新 - user2の復習物
-			userID:           "550e8400-e29b-41d4-a716-446655440002",
-			want:             nil,
-			wantErr:          false,
-			wantGetItemError: true, // 削除後は取得できない
+			name: "想起失敗を記録する場合",
+			failure: &itemDomain.ReviewFailure{
+				ReviewFailureID: "f50e8400-e29b-41d4-a716-446655440001",
+				UserID:          "550e8400-e29b-41d4-a716-446655440001",
+				ItemID:          "a50e8400-e29b-41d4-a716-446655440001",
+				StepNumber:      1,
+				ScheduledDate:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				FailedDate:      time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+			wantErr: false,
+		},
+		{
+			name: "存在しない復習物の場合",
+			failure: &itemDomain.ReviewFailure{
+				ReviewFailureID: "f50e8400-e29b-41d4-a716-446655440002",
+				UserID:          "550e8400-e29b-41d4-a716-446655440001",
+				ItemID:          "a50e8400-e29b-41d4-a716-999999999999",
+				StepNumber:      1,
+				ScheduledDate:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				FailedDate:      time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+			wantErr: true,
 		},
 	}
 
@@ -1147,7 +1277,7 @@ func TestItemRepository_DeleteItem(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			err := repo.DeleteItem(ctx, tc.itemID, tc.userID)
+			err := repo.CreateReviewFailure(ctx, tc.failure)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1161,29 +1291,27 @@ func TestItemRepository_DeleteItem(t *testing.T) {
 				return
 			}
 
-			// 削除後の状態を確認
-			actualItem, err := repo.GetItemByID(ctx, tc.itemID, tc.userID)
-
-			if tc.wantGetItemError {
-				if err == nil {
-					t.Error("GetItemByIDでエラーが発生するはずですが、発生しませんでした")
-				}
-				return
-			}
-
+			var stepNumber int
+			var failedDate time.Time
+			err = GetTestDB().QueryRow(
+				"SELECT step_number, failed_date FROM review_failures WHERE id = $1",
+				tc.failure.ReviewFailureID,
+			).Scan(&stepNumber, &failedDate)
 			if err != nil {
-				t.Errorf("GetItemByIDで予期しないエラー: %v", err)
+				t.Errorf("記録された想起失敗の取得に失敗: %v", err)
 				return
 			}
-
-			if diff := cmp.Diff(tc.want, actualItem); diff != "" {
-				t.Errorf("DeleteItem() 削除後の状態 mismatch (-want +got):\n%s", diff)
+			if stepNumber != tc.failure.StepNumber {
+				t.Errorf("step_number = %d, want %d", stepNumber, tc.failure.StepNumber)
+			}
+			if !failedDate.Equal(tc.failure.FailedDate) {
+				t.Errorf("failed_date = %v, want %v", failedDate, tc.failure.FailedDate)
 			}
 		})
 	}
 }
 
-func TestItemRepository_DeleteReviewDates(t *testing.T) {
+func TestItemRepository_CreateReviewLog(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1191,20 +1319,57 @@ func TestItemRepository_DeleteReviewDates(t *testing.T) {
 	PrepareTestDatabase(t)
 	defer CleanupTestDatabase(t)
 
+	reviewDateID := "b50e8400-e29b-41d4-a716-446655440001"
+	grade := 3
+	durationSeconds := 45
+
 	tests := []struct {
 		name    string
-		itemID  string
-		userID  string
-		want    []*itemDomain.Reviewdate // 削除後の期待結果
+		log     *itemDomain.ReviewLog
 		wantErr bool
 	}{
 		{
-			name:    "復習日の削除に成功する場合",
-			itemID:  "a50e8400-e29b-41d4-a716-446655440001", // 二次方程式の復習物
-			userID:  "550e8400-e29b-41d4-a716-446655440001",
-			want:    []*itemDomain.Reviewdate{}, // 削除後は空のスライス
+			name: "想起度と所要時間を含めて記録する場合",
+			log: &itemDomain.ReviewLog{
+				ReviewLogID:     "c50e8400-e29b-41d4-a716-446655440101",
+				UserID:          "550e8400-e29b-41d4-a716-446655440001",
+				ItemID:          "a50e8400-e29b-41d4-a716-446655440001",
+				ReviewDateID:    &reviewDateID,
+				StepNumber:      1,
+				Outcome:         itemDomain.ReviewOutcomeCompleted,
+				Grade:           &grade,
+				DurationSeconds: &durationSeconds,
+				ReviewedDate:    time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				ReviewedAt:      time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC),
+			},
+			wantErr: false,
+		},
+		{
+			name: "復習日・想起度・所要時間なしで記録する場合",
+			log: &itemDomain.ReviewLog{
+				ReviewLogID:  "c50e8400-e29b-41d4-a716-446655440102",
+				UserID:       "550e8400-e29b-41d4-a716-446655440001",
+				ItemID:       "a50e8400-e29b-41d4-a716-446655440001",
+				StepNumber:   1,
+				Outcome:      itemDomain.ReviewOutcomeUncompleted,
+				ReviewedDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				ReviewedAt:   time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+			},
 			wantErr: false,
 		},
+		{
+			name: "存在しない復習物の場合",
+			log: &itemDomain.ReviewLog{
+				ReviewLogID:  "c50e8400-e29b-41d4-a716-446655440103",
+				UserID:       "550e8400-e29b-41d4-a716-446655440001",
+				ItemID:       "a50e8400-e29b-41d4-a716-999999999999",
+				StepNumber:   1,
+				Outcome:      itemDomain.ReviewOutcomeCompleted,
+				ReviewedDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				ReviewedAt:   time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1213,8 +1378,7 @@ func TestItemRepository_DeleteReviewDates(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			// 削除を実行
-			err := repo.DeleteReviewDates(ctx, tc.itemID, tc.userID)
+			err := repo.CreateReviewLog(ctx, tc.log)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1228,21 +1392,30 @@ func TestItemRepository_DeleteReviewDates(t *testing.T) {
 				return
 			}
 
-			// 削除後の状態を確認
-			actualReviewDates, err := repo.GetReviewDatesByItemID(ctx, tc.itemID, tc.userID)
+			logs, err := repo.GetReviewLogsByItemID(ctx, tc.log.ItemID, tc.log.UserID)
 			if err != nil {
-				t.Errorf("削除後の復習日取得に失敗: %v", err)
+				t.Errorf("記録された履歴の取得に失敗: %v", err)
 				return
 			}
-
-			if diff := cmp.Diff(tc.want, actualReviewDates); diff != "" {
-				t.Errorf("DeleteReviewDates() 削除後の状態 mismatch (-want +got):\n%s", diff)
+			var got *itemDomain.ReviewLog
+			for _, l := range logs {
+				if l.ReviewLogID == tc.log.ReviewLogID {
+					got = l
+					break
+				}
+			}
+			if got == nil {
+				t.Errorf("記録された履歴が見つかりません: %s", tc.log.ReviewLogID)
+				return
+			}
+			if diff := cmp.Diff(tc.log, got); diff != "" {
+				t.Errorf("CreateReviewLog() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetAllUnFinishedItemsByBoxID(t *testing.T) {
+func TestItemRepository_GetReviewLogsByItemID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1252,38 +1425,38 @@ func TestItemRepository_GetAllUnFinishedItemsByBoxID(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		boxID   string
+		itemID  string
 		userID  string
-		want    []*itemDomain.Item
+		wantIDs []string
 		wantErr bool
 	}{
 		{
-			name:   "ボックス内の未完了復習物を取得する場合",
-			boxID:  "950e8400-e29b-41d4-a716-446655440001",
+			name:   "履歴を新しい順に取得する場合",
+			itemID: "a50e8400-e29b-41d4-a716-446655440002",
 			userID: "550e8400-e29b-41d4-a716-446655440001",
-			want: []*itemDomain.Item{
-				{
-					ItemID:       "a50e8400-e29b-41d4-a716-446655440001",
-					UserID:       "550e8400-e29b-41d4-a716-446655440001",
-					CategoryID:   stringPtr("650e8400-e29b-41d4-a716-446655440001"),
-					BoxID:        stringPtr("950e8400-e29b-41d4-a716-446655440001"),
-					PatternID:    stringPtr("750e8400-e29b-41d4-a716-446655440001"),
-					Name:         "二次方程式",
-					Detail:       "ax^2 + bx + c = 0の解の公式を覚える",
-					LearnedDate:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
-					IsFinished:   false,
-					RegisteredAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
-					EditedAt:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
-				},
+			wantIDs: []string{
+				"c50e8400-e29b-41d4-a716-446655440003",
+				"c50e8400-e29b-41d4-a716-446655440002",
+				"c50e8400-e29b-41d4-a716-446655440001",
 			},
-			wantErr: false,
 		},
 		{
-			name:    "完了済み復習物のみのボックスの場合（空の結果）",
-			boxID:   "950e8400-e29b-41d4-a716-446655440002",
+			name:    "履歴がない場合",
+			itemID:  "a50e8400-e29b-41d4-a716-446655440001",
 			userID:  "550e8400-e29b-41d4-a716-446655440001",
-			want:    []*itemDomain.Item{},
-			wantErr: false,
+			wantIDs: []string{},
+		},
+		{
+			name:    "他のユーザーの復習物の場合",
+			itemID:  "a50e8400-e29b-41d4-a716-446655440002",
+			userID:  "550e8400-e29b-41d4-a716-446655440002",
+			wantIDs: []string{},
+		},
+		{
+			name:    "無効なUUIDの場合",
+			itemID:  "invalid-uuid",
+			userID:  "550e8400-e29b-41d4-a716-446655440001",
+			wantErr: true,
 		},
 	}
 
@@ -1293,7 +1466,7 @@ func TestItemRepository_GetAllUnFinishedItemsByBoxID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			items, err := repo.GetAllUnFinishedItemsByBoxID(ctx, tc.boxID, tc.userID)
+			logs, err := repo.GetReviewLogsByItemID(ctx, tc.itemID, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1307,14 +1480,31 @@ func TestItemRepository_GetAllUnFinishedItemsByBoxID(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, items); diff != "" {
-				t.Errorf("GetAllUnFinishedItemsByBoxID() mismatch (-want +got):\n%s", diff)
+			gotIDs := make([]string, len(logs))
+			for i, l := range logs {
+				gotIDs[i] = l.ReviewLogID
+			}
+			if diff := cmp.Diff(tc.wantIDs, gotIDs); diff != "" {
+				t.Errorf("GetReviewLogsByItemID() mismatch (-want +got):\n%s", diff)
+			}
+
+			// 想起度・所要時間を記録した履歴は値を復元できる
+			for _, l := range logs {
+				if l.ReviewLogID != "c50e8400-e29b-41d4-a716-446655440001" {
+					continue
+				}
+				if l.Grade == nil || *l.Grade != 4 || l.DurationSeconds == nil || *l.DurationSeconds != 120 {
+					t.Errorf("想起度・所要時間が一致しません: %+v", l)
+				}
+				if !l.ReviewedDate.Equal(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)) {
+					t.Errorf("reviewed_date = %v, want 2024-01-03", l.ReviewedDate)
+				}
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetAllReviewDatesByBoxID(t *testing.T) {
+func TestItemRepository_GetCompletedDatesByUserID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1324,40 +1514,34 @@ func TestItemRepository_GetAllReviewDatesByBoxID(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		boxID   string
 		userID  string
-		want    []*itemDomain.Reviewdate
+		toDate  time.Time
+		want    []time.Time
 		wantErr bool
 	}{
 		{
-			name:   "ボックス内の全復習日を取得する場合",
-			boxID:  "950e8400-e29b-41d4-a716-446655440001",
+			name:   "完了した日を取得する場合",
 			userID: "550e8400-e29b-41d4-a716-446655440001",
-			want: []*itemDomain.Reviewdate{
-				{
-					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440001",
-					UserID:               "550e8400-e29b-41d4-a716-446655440001",
-					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
-					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
-					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
-					StepNumber:           1,
-					InitialScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
-					ScheduledDate:        time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
-					IsCompleted:          false,
-				},
-				{
-					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440002",
-					UserID:               "550e8400-e29b-41d4-a716-446655440001",
-					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
-					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
-					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
-					StepNumber:           2,
-					InitialScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
-					ScheduledDate:        time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
-					IsCompleted:          false,
-				},
-			},
-			wantErr: false,
+			toDate: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			want:   []time.Time{time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:   "指定日より後に完了した日は含まない場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			toDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			want:   []time.Time{},
+		},
+		{
+			name:   "完了した復習日がないユーザーの場合",
+			userID: "550e8400-e29b-41d4-a716-446655440002",
+			toDate: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			want:   []time.Time{},
+		},
+		{
+			name:    "無効なUUIDの場合",
+			userID:  "invalid-uuid",
+			toDate:  time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			wantErr: true,
 		},
 	}
 
@@ -1367,7 +1551,7 @@ func TestItemRepository_GetAllReviewDatesByBoxID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			reviewdates, err := repo.GetAllReviewDatesByBoxID(ctx, tc.boxID, tc.userID)
+			got, err := repo.GetCompletedDatesByUserID(ctx, tc.userID, tc.toDate)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1381,14 +1565,14 @@ func TestItemRepository_GetAllReviewDatesByBoxID(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, reviewdates); diff != "" {
-				t.Errorf("GetAllReviewDatesByBoxID() mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetCompletedDatesByUserID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetAllUnFinishedUnclassifiedItemsByUserID(t *testing.T) {
+func TestItemRepository_CountReviewCompletionByUserID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1396,17 +1580,37 @@ func TestItemRepository_GetAllUnFinishedUnclassifiedItemsByUserID(t *testing.T)
 	PrepareTestDatabase(t)
 	defer CleanupTestDatabase(t)
 
+	fromDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
-		name          string
-		userID        string
-		wantErr       bool
-		expectedCount int
+		name    string
+		userID  string
+		want    []*itemDomain.ReviewCompletionCount
+		wantErr bool
 	}{
 		{
-			name:          "ユーザー2の未分類復習物を取得（完全未分類なし）",
-			userID:        "550e8400-e29b-41d4-a716-446655440002",
-			wantErr:       false,
-			expectedCount: 1,
+			name:   "日毎の予定と完了の数を取得する場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			want: []*itemDomain.ReviewCompletionCount{
+				{ScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), DueCount: 1, CompletedCount: 0},
+				{ScheduledDate: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), DueCount: 1, CompletedCount: 1},
+				// 2024-01-06にずらされた復習日も、作成した時点の予定日の2024-01-04で数える
+				{ScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), DueCount: 2, CompletedCount: 0},
+			},
+		},
+		{
+			name:   "途中完了した復習物の未完了の復習日は数えない場合",
+			userID: "550e8400-e29b-41d4-a716-446655440002",
+			want: []*itemDomain.ReviewCompletionCount{
+				{ScheduledDate: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), DueCount: 1, CompletedCount: 0},
+				{ScheduledDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC), DueCount: 1, CompletedCount: 0},
+			},
+		},
+		{
+			name:    "無効なUUIDの場合",
+			userID:  "invalid-uuid",
+			wantErr: true,
 		},
 	}
 
@@ -1416,7 +1620,7 @@ func TestItemRepository_GetAllUnFinishedUnclassifiedItemsByUserID(t *testing.T)
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			items, err := repo.GetAllUnFinishedUnclassifiedItemsByUserID(ctx, tc.userID)
+			got, err := repo.CountReviewCompletionByUserID(ctx, tc.userID, fromDate, toDate)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1427,31 +1631,17 @@ func TestItemRepository_GetAllUnFinishedUnclassifiedItemsByUserID(t *testing.T)
 
 			if err != nil {
 				t.Errorf("予期しないエラー: %v", err)
-			}
-
-			if items == nil {
-				t.Error("復習物のスライスがnilです")
 				return
 			}
 
-			if len(items) != tc.expectedCount {
-				t.Errorf("期待される復習物数: %d, 実際: %d", tc.expectedCount, len(items))
-			}
-
-			// 返された全ての復習物が指定ユーザーのものであり、ボックスがnilであることを検証
-			for _, item := range items {
-				if item.UserID != tc.userID {
-					t.Errorf("期待されるユーザーID: %s, 実際: %s", tc.userID, item.UserID)
-				}
-				if item.BoxID != nil {
-					t.Errorf("復習物のBoxIDはnilであるべきですが、%sが設定されています", *item.BoxID)
-				}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CountReviewCompletionByUserID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetAllUnclassifiedReviewDatesByUserID(t *testing.T) {
+func TestItemRepository_GetAverageReviewDelayByUserID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1459,45 +1649,52 @@ func TestItemRepository_GetAllUnclassifiedReviewDatesByUserID(t *testing.T) {
 	PrepareTestDatabase(t)
 	defer CleanupTestDatabase(t)
 
+	zero := 0.0
+
 	tests := []struct {
 		name    string
 		userID  string
-		want    []*itemDomain.Reviewdate
+		setup   func(t *testing.T)
+		want    *float64
 		wantErr bool
 	}{
 		{
-			name:   "未分類復習日を取得する場合",
-			userID: "550e8400-e29b-41d4-a716-446655440002",
-			want: []*itemDomain.Reviewdate{
-				{
-					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440006",
-					UserID:               "550e8400-e29b-41d4-a716-446655440002",
-					CategoryID:           nil, // 真の未分類のため nil
-					BoxID:                nil, // 未分類のため nil
-					ItemID:               "a50e8400-e29b-41d4-a716-446655440006",
-					StepNumber:           1,
-					InitialScheduledDate: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
-					ScheduledDate:        time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
-					IsCompleted:          false,
-				},
+			name:   "予定通りに完了した復習日のみの場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			want:   &zero,
+		},
+		{
+			name:   "再計算で初回の予定日が書き換えられても作成した時点の予定日から数える場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			setup: func(t *testing.T) {
+				if _, err := testDB.Exec("UPDATE review_dates SET initial_scheduled_date = '2024-01-01' WHERE id = 'b50e8400-e29b-41d4-a716-446655440003'"); err != nil {
+					t.Fatalf("初回の予定日の更新に失敗: %v", err)
+				}
 			},
-			wantErr: false,
+			want: &zero,
 		},
 		{
-			name:    "未分類復習日がないユーザーの場合",
-			userID:  "550e8400-e29b-41d4-a716-446655440001",
-			want:    []*itemDomain.Reviewdate{},
-			wantErr: false,
+			name:   "完了した日を記録した復習日がない場合",
+			userID: "550e8400-e29b-41d4-a716-446655440002",
+			want:   nil,
+		},
+		{
+			name:    "無効なUUIDの場合",
+			userID:  "invalid-uuid",
+			wantErr: true,
 		},
 	}
 
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup(t)
+			}
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			reviewdates, err := repo.GetAllUnclassifiedReviewDatesByUserID(ctx, tc.userID)
+			got, err := repo.GetAverageReviewDelayByUserID(ctx, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1511,14 +1708,14 @@ func TestItemRepository_GetAllUnclassifiedReviewDatesByUserID(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, reviewdates); diff != "" {
-				t.Errorf("GetAllUnclassifiedReviewDatesByUserID() mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetAverageReviewDelayByUserID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetAllUnFinishedUnclassifiedItemsByCategoryID(t *testing.T) {
+func TestItemRepository_CountCompletedReviewsByDate(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1526,40 +1723,45 @@ func TestItemRepository_GetAllUnFinishedUnclassifiedItemsByCategoryID(t *testing
 	PrepareTestDatabase(t)
 	defer CleanupTestDatabase(t)
 
+	fromDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	categoryID := "650e8400-e29b-41d4-a716-446655440001"
+	otherBoxID := "950e8400-e29b-41d4-a716-446655440001"
+
 	tests := []struct {
-		name       string
-		categoryID string
-		userID     string
-		want       []*itemDomain.Item
-		wantErr    bool
+		name    string
+		userID  string
+		filter  *itemDomain.HeatmapFilter
+		want    []*itemDomain.DailyActivityCount
+		wantErr bool
 	}{
 		{
-			name:       "カテゴリの未分類未完了復習物を取得する場合",
-			categoryID: "650e8400-e29b-41d4-a716-446655440004",
-			userID:     "550e8400-e29b-41d4-a716-446655440002",
-			want: []*itemDomain.Item{
-				{
-					ItemID:       "a50e8400-e29b-41d4-a716-446655440005",
-					UserID:       "550e8400-e29b-41d4-a716-446655440002",
-					CategoryID:   stringPtr("650e8400-e29b-41d4-a716-446655440004"),
-					BoxID:        nil, // 未分類のため nil
-					PatternID:    nil,
-					Name:         "明治維新",
-					Detail:       "1868年の政治変革について",
-					LearnedDate:  time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
-					IsFinished:   false,
-					RegisteredAt: time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC),
-					EditedAt:     time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC),
-				},
+			name:   "日毎に完了した復習日数を取得する場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			filter: &itemDomain.HeatmapFilter{},
+			want: []*itemDomain.DailyActivityCount{
+				{Date: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), Count: 1},
 			},
-			wantErr: false,
 		},
 		{
-			name:       "未分類未完了復習物がないカテゴリの場合",
-			categoryID: "650e8400-e29b-41d4-a716-446655440001",
-			userID:     "550e8400-e29b-41d4-a716-446655440001",
-			want:       []*itemDomain.Item{},
-			wantErr:    false,
+			name:   "カテゴリーで絞り込む場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			filter: &itemDomain.HeatmapFilter{CategoryID: &categoryID},
+			want: []*itemDomain.DailyActivityCount{
+				{Date: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), Count: 1},
+			},
+		},
+		{
+			name:   "完了した復習日がないボックスで絞り込む場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			filter: &itemDomain.HeatmapFilter{CategoryID: &categoryID, BoxID: &otherBoxID},
+			want:   []*itemDomain.DailyActivityCount{},
+		},
+		{
+			name:    "無効なUUIDの場合",
+			userID:  "invalid-uuid",
+			filter:  &itemDomain.HeatmapFilter{},
+			wantErr: true,
 		},
 	}
 
@@ -1569,7 +1771,7 @@ func TestItemRepository_GetAllUnFinishedUnclassifiedItemsByCategoryID(t *testing
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			items, err := repo.GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx, tc.categoryID, tc.userID)
+			got, err := repo.CountCompletedReviewsByDate(ctx, tc.userID, fromDate, toDate, tc.filter)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1583,14 +1785,14 @@ func TestItemRepository_GetAllUnFinishedUnclassifiedItemsByCategoryID(t *testing
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, items); diff != "" {
-				t.Errorf("GetAllUnFinishedUnclassifiedItemsByCategoryID() mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CountCompletedReviewsByDate() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetAllUnclassifiedReviewDatesByCategoryID(t *testing.T) {
+func TestItemRepository_CountLearnedItemsByDate(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1598,38 +1800,67 @@ func TestItemRepository_GetAllUnclassifiedReviewDatesByCategoryID(t *testing.T)
 	PrepareTestDatabase(t)
 	defer CleanupTestDatabase(t)
 
+	fromDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	categoryID := "650e8400-e29b-41d4-a716-446655440001"
+	boxID := "950e8400-e29b-41d4-a716-446655440002"
+	unclassifiedCategoryID := "650e8400-e29b-41d4-a716-446655440004"
+
 	tests := []struct {
-		name       string
-		categoryID string
-		userID     string
-		want       []*itemDomain.Reviewdate
-		wantErr    bool
+		name    string
+		userID  string
+		filter  *itemDomain.HeatmapFilter
+		want    []*itemDomain.DailyActivityCount
+		wantErr bool
 	}{
 		{
-			name:       "カテゴリの未分類復習日を取得する場合",
-			categoryID: "650e8400-e29b-41d4-a716-446655440004",
-			userID:     "550e8400-e29b-41d4-a716-446655440002",
-			want: []*itemDomain.Reviewdate{
-				{
-					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440007",
-					UserID:               "550e8400-e29b-41d4-a716-446655440002",
-					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440004"),
-					BoxID:                nil, // 未分類のため nil
-					ItemID:               "a50e8400-e29b-41d4-a716-446655440005",
-					StepNumber:           1,
-					InitialScheduledDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
-					ScheduledDate:        time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
-					IsCompleted:          false,
-				},
+			name:   "日毎に学習した復習物数を取得する場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			filter: &itemDomain.HeatmapFilter{},
+			want: []*itemDomain.DailyActivityCount{
+				{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Count: 1},
+				{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Count: 1},
+				{Date: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), Count: 1},
 			},
-			wantErr: false,
 		},
 		{
-			name:       "未分類復習日がないカテゴリの場合",
-			categoryID: "650e8400-e29b-41d4-a716-446655440001",
-			userID:     "550e8400-e29b-41d4-a716-446655440001",
-			want:       []*itemDomain.Reviewdate{},
-			wantErr:    false,
+			name:   "カテゴリーで絞り込む場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			filter: &itemDomain.HeatmapFilter{CategoryID: &categoryID},
+			want: []*itemDomain.DailyActivityCount{
+				{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Count: 1},
+				{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Count: 1},
+			},
+		},
+		{
+			name:   "ボックスで絞り込む場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			filter: &itemDomain.HeatmapFilter{CategoryID: &categoryID, BoxID: &boxID},
+			want: []*itemDomain.DailyActivityCount{
+				{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Count: 1},
+			},
+		},
+		{
+			name:   "カテゴリーの未分類で絞り込む場合",
+			userID: "550e8400-e29b-41d4-a716-446655440002",
+			filter: &itemDomain.HeatmapFilter{CategoryID: &unclassifiedCategoryID, Unclassified: true},
+			want: []*itemDomain.DailyActivityCount{
+				{Date: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), Count: 1},
+			},
+		},
+		{
+			name:   "ユーザー直下の未分類で絞り込む場合",
+			userID: "550e8400-e29b-41d4-a716-446655440002",
+			filter: &itemDomain.HeatmapFilter{Unclassified: true},
+			want: []*itemDomain.DailyActivityCount{
+				{Date: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC), Count: 2},
+			},
+		},
+		{
+			name:    "無効なUUIDの場合",
+			userID:  "invalid-uuid",
+			filter:  &itemDomain.HeatmapFilter{},
+			wantErr: true,
 		},
 	}
 
@@ -1639,7 +1870,7 @@ func TestItemRepository_GetAllUnclassifiedReviewDatesByCategoryID(t *testing.T)
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			reviewdates, err := repo.GetAllUnclassifiedReviewDatesByCategoryID(ctx, tc.categoryID, tc.userID)
+			got, err := repo.CountLearnedItemsByDate(ctx, tc.userID, fromDate, toDate, tc.filter)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1653,14 +1884,14 @@ func TestItemRepository_GetAllUnclassifiedReviewDatesByCategoryID(t *testing.T)
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, reviewdates); diff != "" {
-				t.Errorf("GetAllUnclassifiedReviewDatesByCategoryID() mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CountLearnedItemsByDate() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_CountItemsGroupedByBoxByUserID(t *testing.T) {
+func TestItemRepository_GetReviewDatesByItemID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1670,23 +1901,37 @@ func TestItemRepository_CountItemsGroupedByBoxByUserID(t *testing.T) {
 
 	tests := []struct {
 		name    string
+		itemID  string
 		userID  string
-		want    []*itemDomain.ItemCountGroupedByBox
+		want    []*itemDomain.Reviewdate
 		wantErr bool
 	}{
 		{
-			name:   "ユーザーのボックス毎の復習物数を取得する場合",
+			name:   "復習物に関連する復習日を取得する場合",
+			itemID: "a50e8400-e29b-41d4-a716-446655440001",
 			userID: "550e8400-e29b-41d4-a716-446655440001",
-			want: []*itemDomain.ItemCountGroupedByBox{
+			want: []*itemDomain.Reviewdate{
 				{
-					CategoryID: "650e8400-e29b-41d4-a716-446655440001",
-					BoxID:      "950e8400-e29b-41d4-a716-446655440001",
-					Count:      1, // 二次方程式の復習物
+					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440001",
+					UserID:               "550e8400-e29b-41d4-a716-446655440001",
+					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
+					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
+					StepNumber:           1,
+					InitialScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					ScheduledDate:        time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					IsCompleted:          false,
 				},
 				{
-					CategoryID: "650e8400-e29b-41d4-a716-446655440002",
-					BoxID:      "950e8400-e29b-41d4-a716-446655440003",
-					Count:      1, // ニュートンの第一法則の復習物
+					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440002",
+					UserID:               "550e8400-e29b-41d4-a716-446655440001",
+					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
+					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
+					StepNumber:           2,
+					InitialScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+					ScheduledDate:        time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+					IsCompleted:          false,
 				},
 			},
 			wantErr: false,
@@ -1699,7 +1944,7 @@ func TestItemRepository_CountItemsGroupedByBoxByUserID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			counts, err := repo.CountItemsGroupedByBoxByUserID(ctx, tc.userID)
+			reviewdates, err := repo.GetReviewDatesByItemID(ctx, tc.itemID, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1713,14 +1958,14 @@ func TestItemRepository_CountItemsGroupedByBoxByUserID(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, counts); diff != "" {
-				t.Errorf("CountItemsGroupedByBoxByUserID() mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.want, reviewdates); diff != "" {
+				t.Errorf("GetReviewDatesByItemID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_CountUnclassifiedItemsGroupedByCategoryByUserID(t *testing.T) {
+func TestItemRepository_DeleteItem(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1729,31 +1974,20 @@ func TestItemRepository_CountUnclassifiedItemsGroupedByCategoryByUserID(t *testi
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name    string
-		userID  string
-		want    []*itemDomain.UnclassifiedItemCountGroupedByCategory
-		wantErr bool
+		name             string
+		itemID           string
+		userID           string
+		want             *itemDomain.Item // 削除後の取得結果（nilなら削除成功）
+		wantErr          bool
+		wantGetItemError bool // GetItemByIDでエラーが発生するか
 	}{
 		{
-			name:   "ユーザーのカテゴリ毎の未分類復習物数を取得する場合",
-			userID: "550e8400-e29b-41d4-a716-446655440002",
-			want: []*itemDomain.UnclassifiedItemCountGroupedByCategory{
-				{
-					CategoryID: "650e8400-e29b-41d4-a716-446655440004",
-					Count:      1, // 明治維新の復習物（未分類）
-				},
-				{
-					CategoryID: "00000000-0000-0000-0000-000000000000", // category_id=nullの場合のUUID表現
-					Count:      1,                                      // Goroutineの復習物（完全未分類）
-				},
-			},
-			wantErr: false,
-		},
-		{
-			name:    "未分類復習物がないユーザーの場合",
-			userID:  "550e8400-e29b-41d4-a716-446655440001",
-			want:    []*itemDomain.UnclassifiedItemCountGroupedByCategory{},
-			wantErr: false,
+			name:             "復習物削除に成功する場合",
+			itemID:           "a50e8400-e29b-41d4-a716-446655440005", // 明治維新 - user2の復習物
+			userID:           "550e8400-e29b-41d4-a716-446655440002",
+			want:             nil,
+			wantErr:          false,
+			wantGetItemError: true, // 削除後は取得できない
 		},
 	}
 
@@ -1763,7 +1997,7 @@ func TestItemRepository_CountUnclassifiedItemsGroupedByCategoryByUserID(t *testi
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			counts, err := repo.CountUnclassifiedItemsGroupedByCategoryByUserID(ctx, tc.userID)
+			err := repo.DeleteItem(ctx, tc.itemID, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1777,14 +2011,29 @@ func TestItemRepository_CountUnclassifiedItemsGroupedByCategoryByUserID(t *testi
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, counts); diff != "" {
-				t.Errorf("CountUnclassifiedItemsGroupedByCategoryByUserID() mismatch (-want +got):\n%s", diff)
+			// 削除後の状態を確認
+			actualItem, err := repo.GetItemByID(ctx, tc.itemID, tc.userID)
+
+			if tc.wantGetItemError {
+				if err == nil {
+					t.Error("GetItemByIDでエラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetItemByIDで予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, actualItem); diff != "" {
+				t.Errorf("DeleteItem() 削除後の状態 mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_CountUnclassifiedItemsByUserID(t *testing.T) {
+func TestItemRepository_DeleteReviewDates(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1793,22 +2042,18 @@ func TestItemRepository_CountUnclassifiedItemsByUserID(t *testing.T) {
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name          string
-		userID        string
-		wantErr       bool
-		expectedCount int64
+		name    string
+		itemID  string
+		userID  string
+		want    []*itemDomain.Reviewdate // 削除後の期待結果
+		wantErr bool
 	}{
 		{
-			name:          "ユーザー2の未分類復習物数をカウント（2件）",
-			userID:        "550e8400-e29b-41d4-a716-446655440002",
-			wantErr:       false,
-			expectedCount: 2, // 明治維新とGoroutineの2件
-		},
-		{
-			name:          "ユーザー1の未分類復習物数をカウント（0件）",
-			userID:        "550e8400-e29b-41d4-a716-446655440001",
-			wantErr:       false,
-			expectedCount: 0,
+			name:    "復習日の削除に成功する場合",
+			itemID:  "a50e8400-e29b-41d4-a716-446655440001", // 二次方程式の復習物
+			userID:  "550e8400-e29b-41d4-a716-446655440001",
+			want:    []*itemDomain.Reviewdate{}, // 削除後は空のスライス
+			wantErr: false,
 		},
 	}
 
@@ -1818,27 +2063,36 @@ func TestItemRepository_CountUnclassifiedItemsByUserID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			count, err := repo.CountUnclassifiedItemsByUserID(ctx, tc.userID)
+			// 削除を実行
+			err := repo.DeleteReviewDates(ctx, tc.itemID, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
-					t.Error("expected error but got none")
+					t.Error("エラーが発生するはずですが、発生しませんでした")
 				}
 				return
 			}
 
 			if err != nil {
-				t.Errorf("unexpected error: %v", err)
+				t.Errorf("予期しないエラー: %v", err)
+				return
 			}
 
-			if int64(count) != tc.expectedCount {
-				t.Errorf("expected count %d, got %d", tc.expectedCount, count)
+			// 削除後の状態を確認
+			actualReviewDates, err := repo.GetReviewDatesByItemID(ctx, tc.itemID, tc.userID)
+			if err != nil {
+				t.Errorf("削除後の復習日取得に失敗: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, actualReviewDates); diff != "" {
+				t.Errorf("DeleteReviewDates() 削除後の状態 mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_CountDailyDatesGroupedByBoxByUserID(t *testing.T) {
+func TestItemRepository_GetAllUnFinishedItemsByBoxID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1847,25 +2101,40 @@ func TestItemRepository_CountDailyDatesGroupedByBoxByUserID(t *testing.T) {
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name       string
-		userID     string
-		targetDate time.Time
-		want       []*itemDomain.DailyCountGroupedByBox
-		wantErr    bool
+		name    string
+		boxID   string
+		userID  string
+		want    []*itemDomain.Item
+		wantErr bool
 	}{
 		{
-			name:       "特定日のボックス毎の復習日数を取得する場合",
-			userID:     "550e8400-e29b-41d4-a716-446655440001",
-			targetDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
-			want: []*itemDomain.DailyCountGroupedByBox{
+			name:   "ボックス内の未完了復習物を取得する場合",
+			boxID:  "950e8400-e29b-41d4-a716-446655440001",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			want: []*itemDomain.Item{
 				{
-					CategoryID: "650e8400-e29b-41d4-a716-446655440001",
-					BoxID:      "950e8400-e29b-41d4-a716-446655440001",
-					Count:      1, // 2024-01-02にスケジュールされた復習日
+					ItemID:       "a50e8400-e29b-41d4-a716-446655440001",
+					UserID:       "550e8400-e29b-41d4-a716-446655440001",
+					CategoryID:   stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+					BoxID:        stringPtr("950e8400-e29b-41d4-a716-446655440001"),
+					PatternID:    stringPtr("750e8400-e29b-41d4-a716-446655440001"),
+					Name:         "二次方程式",
+					Detail:       "ax^2 + bx + c = 0の解の公式を覚える",
+					LearnedDate:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					IsFinished:   false,
+					RegisteredAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					EditedAt:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
 				},
 			},
 			wantErr: false,
 		},
+		{
+			name:    "完了済み復習物のみのボックスの場合（空の結果）",
+			boxID:   "950e8400-e29b-41d4-a716-446655440002",
+			userID:  "550e8400-e29b-41d4-a716-446655440001",
+			want:    []*itemDomain.Item{},
+			wantErr: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1874,7 +2143,7 @@ func TestItemRepository_CountDailyDatesGroupedByBoxByUserID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			counts, err := repo.CountDailyDatesGroupedByBoxByUserID(ctx, tc.userID, tc.targetDate)
+			items, err := repo.GetAllUnFinishedItemsByBoxID(ctx, tc.boxID, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1888,14 +2157,14 @@ func TestItemRepository_CountDailyDatesGroupedByBoxByUserID(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, counts); diff != "" {
-				t.Errorf("CountDailyDatesGroupedByBoxByUserID() mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.want, items); diff != "" {
+				t.Errorf("GetAllUnFinishedItemsByBoxID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_CountDailyDatesUnclassifiedGroupedByCategoryByUserID(t *testing.T) {
+func TestItemRepository_GetAllReviewDatesByBoxID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1904,20 +2173,38 @@ func TestItemRepository_CountDailyDatesUnclassifiedGroupedByCategoryByUserID(t *
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name       string
-		userID     string
-		targetDate time.Time
-		want       []*itemDomain.UnclassifiedDailyDatesCountGroupedByCategory
-		wantErr    bool
+		name    string
+		boxID   string
+		userID  string
+		want    []*itemDomain.Reviewdate
+		wantErr bool
 	}{
 		{
-			name:       "特定日のカテゴリ毎の未分類復習日数を取得する場合",
-			userID:     "550e8400-e29b-41d4-a716-446655440002",
-			targetDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
-			want: []*itemDomain.UnclassifiedDailyDatesCountGroupedByCategory{
+			name:   "ボックス内の全復習日を取得する場合",
+			boxID:  "950e8400-e29b-41d4-a716-446655440001",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			want: []*itemDomain.Reviewdate{
 				{
-					CategoryID: "650e8400-e29b-41d4-a716-446655440004",
-					Count:      1, // 2024-01-06にスケジュールされた未分類復習日
+					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440001",
+					UserID:               "550e8400-e29b-41d4-a716-446655440001",
+					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
+					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
+					StepNumber:           1,
+					InitialScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					ScheduledDate:        time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					IsCompleted:          false,
+				},
+				{
+					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440002",
+					UserID:               "550e8400-e29b-41d4-a716-446655440001",
+					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
+					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
+					StepNumber:           2,
+					InitialScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+					ScheduledDate:        time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+					IsCompleted:          false,
 				},
 			},
 			wantErr: false,
@@ -1930,7 +2217,7 @@ func TestItemRepository_CountDailyDatesUnclassifiedGroupedByCategoryByUserID(t *
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			counts, err := repo.CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx, tc.userID, tc.targetDate)
+			reviewdates, err := repo.GetAllReviewDatesByBoxID(ctx, tc.boxID, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1944,14 +2231,14 @@ func TestItemRepository_CountDailyDatesUnclassifiedGroupedByCategoryByUserID(t *
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, counts); diff != "" {
-				t.Errorf("CountDailyDatesUnclassifiedGroupedByCategoryByUserID() mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.want, reviewdates); diff != "" {
+				t.Errorf("GetAllReviewDatesByBoxID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_CountDailyDatesUnclassifiedByUserID(t *testing.T) {
+func TestItemRepository_GetAllUnFinishedUnclassifiedItemsByUserID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -1960,18 +2247,16 @@ func TestItemRepository_CountDailyDatesUnclassifiedByUserID(t *testing.T) {
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name       string
-		userID     string
-		targetDate time.Time
-		want       int
-		wantErr    bool
+		name          string
+		userID        string
+		wantErr       bool
+		expectedCount int
 	}{
 		{
-			name:       "特定日のユーザーの未分類復習日数を取得する場合",
-			userID:     "550e8400-e29b-41d4-a716-446655440002",
-			targetDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
-			want:       1, // 2024-01-06にスケジュールされた未分類復習日
-			wantErr:    false,
+			name:          "ユーザー2の未分類復習物を取得（完全未分類なし）",
+			userID:        "550e8400-e29b-41d4-a716-446655440002",
+			wantErr:       false,
+			expectedCount: 1,
 		},
 	}
 
@@ -1981,7 +2266,7 @@ func TestItemRepository_CountDailyDatesUnclassifiedByUserID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			count, err := repo.CountDailyDatesUnclassifiedByUserID(ctx, tc.userID, tc.targetDate)
+			items, err := repo.GetAllUnFinishedUnclassifiedItemsByUserID(ctx, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -1992,17 +2277,31 @@ func TestItemRepository_CountDailyDatesUnclassifiedByUserID(t *testing.T) {
 
 			if err != nil {
 				t.Errorf("予期しないエラー: %v", err)
+			}
+
+			if items == nil {
+				t.Error("復習物のスライスがnilです")
 				return
 			}
 
-			if count != tc.want {
-				t.Errorf("CountDailyDatesUnclassifiedByUserID() = %d, want %d", count, tc.want)
+			if len(items) != tc.expectedCount {
+				t.Errorf("期待される復習物数: %d, 実際: %d", tc.expectedCount, len(items))
+			}
+
+			// 返された全ての復習物が指定ユーザーのものであり、ボックスがnilであることを検証
+			for _, item := range items {
+				if item.UserID != tc.userID {
+					t.Errorf("期待されるユーザーID: %s, 実際: %s", tc.userID, item.UserID)
+				}
+				if item.BoxID != nil {
+					t.Errorf("復習物のBoxIDはnilであるべきですが、%sが設定されています", *item.BoxID)
+				}
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetEditedAtByItemID(t *testing.T) {
+func TestItemRepository_GetAllUnclassifiedReviewDatesByUserID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -2012,16 +2311,32 @@ func TestItemRepository_GetEditedAtByItemID(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		itemID  string
 		userID  string
-		want    time.Time
+		want    []*itemDomain.Reviewdate
 		wantErr bool
 	}{
 		{
-			name:    "復習物のEditedAtを取得する場合",
-			itemID:  "a50e8400-e29b-41d4-a716-446655440001",
+			name:   "未分類復習日を取得する場合",
+			userID: "550e8400-e29b-41d4-a716-446655440002",
+			want: []*itemDomain.Reviewdate{
+				{
+					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440006",
+					UserID:               "550e8400-e29b-41d4-a716-446655440002",
+					CategoryID:           nil, // 真の未分類のため nil
+					BoxID:                nil, // 未分類のため nil
+					ItemID:               "a50e8400-e29b-41d4-a716-446655440006",
+					StepNumber:           1,
+					InitialScheduledDate: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+					ScheduledDate:        time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+					IsCompleted:          false,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "未分類復習日がないユーザーの場合",
 			userID:  "550e8400-e29b-41d4-a716-446655440001",
-			want:    time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			want:    []*itemDomain.Reviewdate{},
 			wantErr: false,
 		},
 	}
@@ -2032,7 +2347,7 @@ func TestItemRepository_GetEditedAtByItemID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			editedAt, err := repo.GetEditedAtByItemID(ctx, tc.itemID, tc.userID)
+			reviewdates, err := repo.GetAllUnclassifiedReviewDatesByUserID(ctx, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -2046,14 +2361,14 @@ func TestItemRepository_GetEditedAtByItemID(t *testing.T) {
 				return
 			}
 
-			if !editedAt.Equal(tc.want) {
-				t.Errorf("GetEditedAtByItemID() = %v, want %v", editedAt, tc.want)
+			if diff := cmp.Diff(tc.want, reviewdates); diff != "" {
+				t.Errorf("GetAllUnclassifiedReviewDatesByUserID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_CountAllDailyReviewDates(t *testing.T) {
+func TestItemRepository_GetAllUnFinishedUnclassifiedItemsByCategoryID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -2062,18 +2377,39 @@ func TestItemRepository_CountAllDailyReviewDates(t *testing.T) {
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name        string
-		userID      string
-		parsedToday time.Time
-		want        int
-		wantErr     bool
+		name       string
+		categoryID string
+		userID     string
+		want       []*itemDomain.Item
+		wantErr    bool
 	}{
 		{
-			name:        "特定日の全復習日数を取得する場合",
-			userID:      "550e8400-e29b-41d4-a716-446655440001",
-			parsedToday: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
-			want:        1, // 2024-01-02にスケジュールされた復習日
-			wantErr:     false,
+			name:       "カテゴリの未分類未完了復習物を取得する場合",
+			categoryID: "650e8400-e29b-41d4-a716-446655440004",
+			userID:     "550e8400-e29b-41d4-a716-446655440002",
+			want: []*itemDomain.Item{
+				{
+					ItemID:       "a50e8400-e29b-41d4-a716-446655440005",
+					UserID:       "550e8400-e29b-41d4-a716-446655440002",
+					CategoryID:   stringPtr("650e8400-e29b-41d4-a716-446655440004"),
+					BoxID:        nil, // 未分類のため nil
+					PatternID:    nil,
+					Name:         "明治維新",
+					Detail:       "1868年の政治変革について",
+					LearnedDate:  time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+					IsFinished:   false,
+					RegisteredAt: time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC),
+					EditedAt:     time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:       "未分類未完了復習物がないカテゴリの場合",
+			categoryID: "650e8400-e29b-41d4-a716-446655440001",
+			userID:     "550e8400-e29b-41d4-a716-446655440001",
+			want:       []*itemDomain.Item{},
+			wantErr:    false,
 		},
 	}
 
@@ -2083,7 +2419,7 @@ func TestItemRepository_CountAllDailyReviewDates(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			count, err := repo.CountAllDailyReviewDates(ctx, tc.userID, tc.parsedToday)
+			items, err := repo.GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx, tc.categoryID, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -2097,14 +2433,14 @@ func TestItemRepository_CountAllDailyReviewDates(t *testing.T) {
 				return
 			}
 
-			if count != tc.want {
-				t.Errorf("CountAllDailyReviewDates() = %d, want %d", count, tc.want)
+			if diff := cmp.Diff(tc.want, items); diff != "" {
+				t.Errorf("GetAllUnFinishedUnclassifiedItemsByCategoryID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetAllDailyReviewDates(t *testing.T) {
+func TestItemRepository_GetAllUnclassifiedReviewDatesByCategoryID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -2113,43 +2449,37 @@ func TestItemRepository_GetAllDailyReviewDates(t *testing.T) {
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name        string
-		userID      string
-		parsedToday time.Time
-		want        []*itemDomain.DailyReviewDate
-		wantErr     bool
+		name       string
+		categoryID string
+		userID     string
+		want       []*itemDomain.Reviewdate
+		wantErr    bool
 	}{
 		{
-			name:        "特定日の全復習日詳細を取得する場合",
-			userID:      "550e8400-e29b-41d4-a716-446655440001",
-			parsedToday: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
-			want: []*itemDomain.DailyReviewDate{
+			name:       "カテゴリの未分類復習日を取得する場合",
+			categoryID: "650e8400-e29b-41d4-a716-446655440004",
+			userID:     "550e8400-e29b-41d4-a716-446655440002",
+			want: []*itemDomain.Reviewdate{
 				{
-					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440001",
-					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
-					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
+					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440007",
+					UserID:               "550e8400-e29b-41d4-a716-446655440002",
+					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440004"),
+					BoxID:                nil, // 未分類のため nil
+					ItemID:               "a50e8400-e29b-41d4-a716-446655440005",
 					StepNumber:           1,
-					InitialScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
-					PrevScheduledDate:    nil,
-					ScheduledDate:        time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
-					NextScheduledDate:    timePtr(time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)),
+					InitialScheduledDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+					ScheduledDate:        time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
 					IsCompleted:          false,
-					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
-					Name:                 "二次方程式",
-					Detail:               "ax^2 + bx + c = 0の解の公式を覚える",
-					LearnedDate:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
-					RegisteredAt:         time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
-					EditedAt:             time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name:        "復習日がない日付の場合",
-			userID:      "550e8400-e29b-41d4-a716-446655440001",
-			parsedToday: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
-			want:        []*itemDomain.DailyReviewDate{},
-			wantErr:     false,
+			name:       "未分類復習日がないカテゴリの場合",
+			categoryID: "650e8400-e29b-41d4-a716-446655440001",
+			userID:     "550e8400-e29b-41d4-a716-446655440001",
+			want:       []*itemDomain.Reviewdate{},
+			wantErr:    false,
 		},
 	}
 
@@ -2159,7 +2489,7 @@ func TestItemRepository_GetAllDailyReviewDates(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			reviewDates, err := repo.GetAllDailyReviewDates(ctx, tc.userID, tc.parsedToday)
+			reviewdates, err := repo.GetAllUnclassifiedReviewDatesByCategoryID(ctx, tc.categoryID, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -2173,14 +2503,14 @@ func TestItemRepository_GetAllDailyReviewDates(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, reviewDates); diff != "" {
-				t.Errorf("GetAllDailyReviewDates() mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.want, reviewdates); diff != "" {
+				t.Errorf("GetAllUnclassifiedReviewDatesByCategoryID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetFinishedItemsByBoxID(t *testing.T) {
+func TestItemRepository_GetItemIDsByTagID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -2190,38 +2520,31 @@ func TestItemRepository_GetFinishedItemsByBoxID(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		boxID   string
+		tagID   string
 		userID  string
-		want    []*itemDomain.Item
+		want    []string
 		wantErr bool
 	}{
 		{
-			name:   "ボックス内の完了済み復習物を取得する場合",
-			boxID:  "950e8400-e29b-41d4-a716-446655440002",
+			name:   "タグが付いた復習物のIDを取得する場合",
+			tagID:  "e50e8400-e29b-41d4-a716-446655440001",
 			userID: "550e8400-e29b-41d4-a716-446655440001",
-			want: []*itemDomain.Item{
-				{
-					ItemID:       "a50e8400-e29b-41d4-a716-446655440002",
-					UserID:       "550e8400-e29b-41d4-a716-446655440001",
-					CategoryID:   stringPtr("650e8400-e29b-41d4-a716-446655440001"),
-					BoxID:        stringPtr("950e8400-e29b-41d4-a716-446655440002"),
-					PatternID:    stringPtr("750e8400-e29b-41d4-a716-446655440002"),
-					Name:         "円の面積",
-					Detail:       "π × r^2の公式を理解する",
-					LearnedDate:  time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
-					IsFinished:   true,
-					RegisteredAt: time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC),
-					EditedAt:     time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC),
-				},
+			want: []string{
+				"a50e8400-e29b-41d4-a716-446655440001",
+				"a50e8400-e29b-41d4-a716-446655440003",
 			},
-			wantErr: false,
 		},
 		{
-			name:    "未完了復習物のみのボックスの場合（空の結果）",
-			boxID:   "950e8400-e29b-41d4-a716-446655440001",
+			name:   "他のユーザーのタグの場合は空",
+			tagID:  "e50e8400-e29b-41d4-a716-446655440003",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			want:   []string{},
+		},
+		{
+			name:    "無効なUUIDの場合",
+			tagID:   "invalid-uuid",
 			userID:  "550e8400-e29b-41d4-a716-446655440001",
-			want:    []*itemDomain.Item{},
-			wantErr: false,
+			wantErr: true,
 		},
 	}
 
@@ -2231,7 +2554,7 @@ func TestItemRepository_GetFinishedItemsByBoxID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			items, err := repo.GetFinishedItemsByBoxID(ctx, tc.boxID, tc.userID)
+			got, err := repo.GetItemIDsByTagID(ctx, tc.tagID, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -2245,14 +2568,15 @@ func TestItemRepository_GetFinishedItemsByBoxID(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, items); diff != "" {
-				t.Errorf("GetFinishedItemsByBoxID() mismatch (-want +got):\n%s", diff)
+			sort.Strings(got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetItemIDsByTagID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetUnclassfiedFinishedItemsByCategoryID(t *testing.T) {
+func TestItemRepository_CountItemsGroupedByBoxByUserID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -2261,32 +2585,27 @@ func TestItemRepository_GetUnclassfiedFinishedItemsByCategoryID(t *testing.T) {
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name       string
-		categoryID string
-		userID     string
-		want       []*itemDomain.Item
-		wantErr    bool
+		name    string
+		userID  string
+		want    []*itemDomain.ItemCountGroupedByBox
+		wantErr bool
 	}{
 		{
-			name:       "未分類完了済み復習物がないカテゴリの場合（GetUnclassfiedFinishedItemsByCategoryIDは真の未分類のみ）",
-			categoryID: "650e8400-e29b-41d4-a716-446655440003",
-			userID:     "550e8400-e29b-41d4-a716-446655440002",
-			want:       []*itemDomain.Item{},
-			wantErr:    false,
-		},
-		{
-			name:       "未分類完了済み復習物がないカテゴリの場合",
-			categoryID: "650e8400-e29b-41d4-a716-446655440001",
-			userID:     "550e8400-e29b-41d4-a716-446655440001",
-			want:       []*itemDomain.Item{},
-			wantErr:    false,
-		},
-		{
-			name:       "存在しないカテゴリの場合",
-			categoryID: uuid.New().String(),
-			userID:     "550e8400-e29b-41d4-a716-446655440001",
-			want:       []*itemDomain.Item{},
-			wantErr:    false,
+			name:   "ユーザーのボックス毎の復習物数を取得する場合",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			want: []*itemDomain.ItemCountGroupedByBox{
+				{
+					CategoryID: "650e8400-e29b-41d4-a716-446655440001",
+					BoxID:      "950e8400-e29b-41d4-a716-446655440001",
+					Count:      1, // 二次方程式の復習物
+				},
+				{
+					CategoryID: "650e8400-e29b-41d4-a716-446655440002",
+					BoxID:      "950e8400-e29b-41d4-a716-446655440003",
+					Count:      1, // ニュートンの第一法則の復習物
+				},
+			},
+			wantErr: false,
 		},
 	}
 
@@ -2296,7 +2615,7 @@ func TestItemRepository_GetUnclassfiedFinishedItemsByCategoryID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			items, err := repo.GetUnclassfiedFinishedItemsByCategoryID(ctx, tc.categoryID, tc.userID)
+			counts, err := repo.CountItemsGroupedByBoxByUserID(ctx, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -2310,14 +2629,14 @@ func TestItemRepository_GetUnclassfiedFinishedItemsByCategoryID(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, items); diff != "" {
-				t.Errorf("GetUnclassfiedFinishedItemsByCategoryID() mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.want, counts); diff != "" {
+				t.Errorf("CountItemsGroupedByBoxByUserID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_GetUnclassfiedFinishedItemsByUserID(t *testing.T) {
+func TestItemRepository_CountUnclassifiedItemsGroupedByCategoryByUserID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -2328,33 +2647,28 @@ func TestItemRepository_GetUnclassfiedFinishedItemsByUserID(t *testing.T) {
 	tests := []struct {
 		name    string
 		userID  string
-		want    []*itemDomain.Item
+		want    []*itemDomain.UnclassifiedItemCountGroupedByCategory
 		wantErr bool
 	}{
 		{
-			name:   "ユーザーの未分類完了済み復習物を取得する場合",
+			name:   "ユーザーのカテゴリ毎の未分類復習物数を取得する場合",
 			userID: "550e8400-e29b-41d4-a716-446655440002",
-			want: []*itemDomain.Item{
+			want: []*itemDomain.UnclassifiedItemCountGroupedByCategory{
 				{
-					ItemID:       "a50e8400-e29b-41d4-a716-446655440006",
-					UserID:       "550e8400-e29b-41d4-a716-446655440002",
-					CategoryID:   nil, // 真の未分類のため nil
-					BoxID:        nil, // 未分類のため nil
-					PatternID:    nil,
-					Name:         "江戸時代",
-					Detail:       "江戸時代の政治と社会を学ぶ",
-					LearnedDate:  time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
-					IsFinished:   true,
-					RegisteredAt: time.Date(2024, 1, 1, 15, 0, 0, 0, time.UTC),
-					EditedAt:     time.Date(2024, 1, 1, 15, 0, 0, 0, time.UTC),
+					CategoryID: "650e8400-e29b-41d4-a716-446655440004",
+					Count:      1, // 明治維新の復習物（未分類）
+				},
+				{
+					CategoryID: "00000000-0000-0000-0000-000000000000", // category_id=nullの場合のUUID表現
+					Count:      1,                                      // Goroutineの復習物（完全未分類）
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name:    "未分類完了済み復習物がないユーザーの場合",
+			name:    "未分類復習物がないユーザーの場合",
 			userID:  "550e8400-e29b-41d4-a716-446655440001",
-			want:    []*itemDomain.Item{},
+			want:    []*itemDomain.UnclassifiedItemCountGroupedByCategory{},
 			wantErr: false,
 		},
 	}
@@ -2365,7 +2679,7 @@ func TestItemRepository_GetUnclassfiedFinishedItemsByUserID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			items, err := repo.GetUnclassfiedFinishedItemsByUserID(ctx, tc.userID)
+			counts, err := repo.CountUnclassifiedItemsGroupedByCategoryByUserID(ctx, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
@@ -2379,14 +2693,14 @@ func TestItemRepository_GetUnclassfiedFinishedItemsByUserID(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.want, items); diff != "" {
-				t.Errorf("GetUnclassfiedFinishedItemsByUserID() mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.want, counts); diff != "" {
+				t.Errorf("CountUnclassifiedItemsGroupedByCategoryByUserID() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestItemRepository_IsPatternRelatedToItemByPatternID(t *testing.T) {
+func TestItemRepository_CountUnclassifiedItemsByUserID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -2395,25 +2709,22 @@ func TestItemRepository_IsPatternRelatedToItemByPatternID(t *testing.T) {
 	defer CleanupTestDatabase(t)
 
 	tests := []struct {
-		name      string
-		patternID string
-		userID    string
-		want      bool
-		wantErr   bool
+		name          string
+		userID        string
+		wantErr       bool
+		expectedCount int64
 	}{
 		{
-			name:      "パターンが復習物に関連している場合",
-			patternID: "750e8400-e29b-41d4-a716-446655440001",
-			userID:    "550e8400-e29b-41d4-a716-446655440001",
-			want:      true,
-			wantErr:   false,
+			name:          "ユーザー2の未分類復習物数をカウント（2件）",
+			userID:        "550e8400-e29b-41d4-a716-446655440002",
+			wantErr:       false,
+			expectedCount: 2, // 明治維新とGoroutineの2件
 		},
 		{
-			name:      "パターンが復習物に関連していない場合(異なるユーザーのパターン)",
-			patternID: "750e8400-e29b-41d4-a716-446655440003",
-			userID:    "550e8400-e29b-41d4-a716-446655440001",
-			want:      false,
-			wantErr:   false,
+			name:          "ユーザー1の未分類復習物数をカウント（0件）",
+			userID:        "550e8400-e29b-41d4-a716-446655440001",
+			wantErr:       false,
+			expectedCount: 0,
 		},
 	}
 
@@ -2423,22 +2734,1633 @@ func TestItemRepository_IsPatternRelatedToItemByPatternID(t *testing.T) {
 			ctx := GetTestContext()
 			repo := NewItemRepository()
 
-			isRelated, err := repo.IsPatternRelatedToItemByPatternID(ctx, tc.patternID, tc.userID)
+			count, err := repo.CountUnclassifiedItemsByUserID(ctx, tc.userID)
 
 			if tc.wantErr {
 				if err == nil {
-					t.Error("エラーが発生するはずですが、発生しませんでした")
+					t.Error("expected error but got none")
 				}
 				return
 			}
 
 			if err != nil {
-				t.Errorf("予期しないエラー: %v", err)
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if int64(count) != tc.expectedCount {
+				t.Errorf("expected count %d, got %d", tc.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestItemRepository_CountDailyDatesGroupedByBoxByUserID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name       string
+		userID     string
+		targetDate time.Time
+		tagID      *string
+		setup      func(t *testing.T)
+		want       []*itemDomain.DailyCountGroupedByBox
+		wantErr    bool
+	}{
+		{
+			name:       "特定日のボックス毎の復習日数を取得する場合",
+			userID:     "550e8400-e29b-41d4-a716-446655440001",
+			targetDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			want: []*itemDomain.DailyCountGroupedByBox{
+				{
+					CategoryID: "650e8400-e29b-41d4-a716-446655440001",
+					BoxID:      "950e8400-e29b-41d4-a716-446655440001",
+					Count:      1, // 2024-01-02にスケジュールされた復習日
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:       "タグで絞り込む場合",
+			userID:     "550e8400-e29b-41d4-a716-446655440001",
+			targetDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			tagID:      stringPtr("e50e8400-e29b-41d4-a716-446655440002"),
+			want:       []*itemDomain.DailyCountGroupedByBox{}, // 2024-01-06の復習物にはこのタグが付いていない
+			wantErr:    false,
+		},
+		{
+			name:       "期限切れのまま残す復習パターンの期限切れの復習日を含める場合",
+			userID:     "550e8400-e29b-41d4-a716-446655440001",
+			targetDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+			setup: func(t *testing.T) {
+				if _, err := testDB.Exec("UPDATE review_patterns SET overdue_policy = 'keep' WHERE id = '750e8400-e29b-41d4-a716-446655440001'"); err != nil {
+					t.Fatalf("復習パターンの更新に失敗しました: %v", err)
+				}
+			},
+			want: []*itemDomain.DailyCountGroupedByBox{
+				{
+					CategoryID: "650e8400-e29b-41d4-a716-446655440001",
+					BoxID:      "950e8400-e29b-41d4-a716-446655440001",
+					Count:      2, // 2024-01-04の復習日と、2024-01-02から期限切れのまま残っている復習日
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup(t)
+			}
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			counts, err := repo.CountDailyDatesGroupedByBoxByUserID(ctx, tc.userID, tc.targetDate, tc.tagID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, counts); diff != "" {
+				t.Errorf("CountDailyDatesGroupedByBoxByUserID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestItemRepository_CountReviewForecastByUserID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	categoryID1 := "650e8400-e29b-41d4-a716-446655440001"
+	boxID1 := "950e8400-e29b-41d4-a716-446655440001"
+	categoryID3 := "650e8400-e29b-41d4-a716-446655440003"
+	boxID4 := "950e8400-e29b-41d4-a716-446655440004"
+	categoryID4 := "650e8400-e29b-41d4-a716-446655440004"
+
+	tests := []struct {
+		name     string
+		userID   string
+		fromDate time.Time
+		toDate   time.Time
+		tagID    *string
+		setup    func(t *testing.T)
+		want     []*itemDomain.ReviewForecastCount
+		wantErr  bool
+	}{
+		{
+			name:     "期間内の未完了の復習日のみ日毎に数える場合",
+			userID:   "550e8400-e29b-41d4-a716-446655440001",
+			fromDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			toDate:   time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			want: []*itemDomain.ReviewForecastCount{
+				{
+					ScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					CategoryID:    &categoryID1,
+					BoxID:         &boxID1,
+					Count:         1,
+				},
+				// 2024-01-03は完了済み、2024-01-06は期間外
+				{
+					ScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+					CategoryID:    &categoryID1,
+					BoxID:         &boxID1,
+					Count:         1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "未分類の復習日を含む場合",
+			userID:   "550e8400-e29b-41d4-a716-446655440002",
+			fromDate: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			toDate:   time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+			want: []*itemDomain.ReviewForecastCount{
+				{
+					ScheduledDate: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+					CategoryID:    &categoryID3,
+					BoxID:         &boxID4,
+					Count:         1,
+				},
+				{
+					ScheduledDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+					CategoryID:    &categoryID4,
+					BoxID:         nil,
+					Count:         1,
+				},
+				{
+					ScheduledDate: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+					CategoryID:    nil,
+					BoxID:         nil,
+					Count:         1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "タグが付いた復習物の復習日だけを数える場合",
+			userID:   "550e8400-e29b-41d4-a716-446655440002",
+			fromDate: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			toDate:   time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+			tagID:    stringPtr("e50e8400-e29b-41d4-a716-446655440003"),
+			want: []*itemDomain.ReviewForecastCount{
+				{
+					ScheduledDate: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+					CategoryID:    &categoryID3,
+					BoxID:         &boxID4,
+					Count:         1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "タグが付いていない復習物の復習日を数えない場合",
+			userID:   "550e8400-e29b-41d4-a716-446655440001",
+			fromDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			toDate:   time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			tagID:    stringPtr("e50e8400-e29b-41d4-a716-446655440002"),
+			want: []*itemDomain.ReviewForecastCount{
+				{
+					ScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					CategoryID:    &categoryID1,
+					BoxID:         &boxID1,
+					Count:         1,
+				},
+				// 2024-01-06の復習物にはこのタグが付いていない
+				{
+					ScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+					CategoryID:    &categoryID1,
+					BoxID:         &boxID1,
+					Count:         1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "期限切れのまま残す復習パターンの期限切れの復習日を開始日に含める場合",
+			userID:   "550e8400-e29b-41d4-a716-446655440001",
+			fromDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+			toDate:   time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			setup: func(t *testing.T) {
+				if _, err := testDB.Exec("UPDATE review_patterns SET overdue_policy = 'keep' WHERE id = '750e8400-e29b-41d4-a716-446655440001'"); err != nil {
+					t.Fatalf("復習パターンの更新に失敗しました: %v", err)
+				}
+			},
+			want: []*itemDomain.ReviewForecastCount{
+				{
+					ScheduledDate: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+					CategoryID:    &categoryID1,
+					BoxID:         &boxID1,
+					Count:         2, // 2024-01-04の復習日と、2024-01-02から期限切れのまま残っている復習日
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup(t)
+			}
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			counts, err := repo.CountReviewForecastByUserID(ctx, tc.userID, tc.fromDate, tc.toDate, tc.tagID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, counts); diff != "" {
+				t.Errorf("CountReviewForecastByUserID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestItemRepository_CountDailyDatesUnclassifiedGroupedByCategoryByUserID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name       string
+		userID     string
+		targetDate time.Time
+		tagID      *string
+		want       []*itemDomain.UnclassifiedDailyDatesCountGroupedByCategory
+		wantErr    bool
+	}{
+		{
+			name:       "特定日のカテゴリ毎の未分類復習日数を取得する場合",
+			userID:     "550e8400-e29b-41d4-a716-446655440002",
+			targetDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			want: []*itemDomain.UnclassifiedDailyDatesCountGroupedByCategory{
+				{
+					CategoryID: "650e8400-e29b-41d4-a716-446655440004",
+					Count:      1, // 2024-01-06にスケジュールされた未分類復習日
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:       "タグで絞り込む場合",
+			userID:     "550e8400-e29b-41d4-a716-446655440002",
+			targetDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			tagID:      stringPtr("e50e8400-e29b-41d4-a716-446655440003"),
+			want:       []*itemDomain.UnclassifiedDailyDatesCountGroupedByCategory{}, // 未分類の復習物にはこのタグが付いていない
+			wantErr:    false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			counts, err := repo.CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx, tc.userID, tc.targetDate, tc.tagID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, counts); diff != "" {
+				t.Errorf("CountDailyDatesUnclassifiedGroupedByCategoryByUserID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestItemRepository_CountDailyDatesUnclassifiedByUserID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name       string
+		userID     string
+		targetDate time.Time
+		tagID      *string
+		want       int
+		wantErr    bool
+	}{
+		{
+			name:       "特定日のユーザーの未分類復習日数を取得する場合",
+			userID:     "550e8400-e29b-41d4-a716-446655440002",
+			targetDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			want:       1, // 2024-01-06にスケジュールされた未分類復習日
+			wantErr:    false,
+		},
+		{
+			name:       "タグで絞り込む場合",
+			userID:     "550e8400-e29b-41d4-a716-446655440002",
+			targetDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			tagID:      stringPtr("e50e8400-e29b-41d4-a716-446655440003"),
+			want:       0, // 未分類の復習物にはこのタグが付いていない
+			wantErr:    false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			count, err := repo.CountDailyDatesUnclassifiedByUserID(ctx, tc.userID, tc.targetDate, tc.tagID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if count != tc.want {
+				t.Errorf("CountDailyDatesUnclassifiedByUserID() = %d, want %d", count, tc.want)
+			}
+		})
+	}
+}
+
+func TestItemRepository_GetEditedAtByItemID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name    string
+		itemID  string
+		userID  string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:    "復習物のEditedAtを取得する場合",
+			itemID:  "a50e8400-e29b-41d4-a716-446655440001",
+			userID:  "550e8400-e29b-41d4-a716-446655440001",
+			want:    time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			editedAt, err := repo.GetEditedAtByItemID(ctx, tc.itemID, tc.userID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if !editedAt.Equal(tc.want) {
+				t.Errorf("GetEditedAtByItemID() = %v, want %v", editedAt, tc.want)
+			}
+		})
+	}
+}
+
+func TestItemRepository_CountAllDailyReviewDates(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name        string
+		userID      string
+		parsedToday time.Time
+		tagID       *string
+		want        int
+		wantErr     bool
+	}{
+		{
+			name:        "特定日の全復習日数を取得する場合",
+			userID:      "550e8400-e29b-41d4-a716-446655440001",
+			parsedToday: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			want:        1, // 2024-01-02にスケジュールされた復習日
+			wantErr:     false,
+		},
+		{
+			name:        "タグが付いた復習物の復習日だけを数える場合",
+			userID:      "550e8400-e29b-41d4-a716-446655440001",
+			parsedToday: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			tagID:       stringPtr("e50e8400-e29b-41d4-a716-446655440001"),
+			want:        1, // 2024-01-06の復習物にはこのタグが付いている
+			wantErr:     false,
+		},
+		{
+			name:        "タグが付いた復習物に今日の復習日がない場合",
+			userID:      "550e8400-e29b-41d4-a716-446655440001",
+			parsedToday: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			tagID:       stringPtr("e50e8400-e29b-41d4-a716-446655440002"),
+			want:        0,
+			wantErr:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			count, err := repo.CountAllDailyReviewDates(ctx, tc.userID, tc.parsedToday, tc.tagID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if count != tc.want {
+				t.Errorf("CountAllDailyReviewDates() = %d, want %d", count, tc.want)
+			}
+		})
+	}
+}
+
+func TestItemRepository_GetAllDailyReviewDates(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name        string
+		userID      string
+		parsedToday time.Time
+		want        []*itemDomain.DailyReviewDate
+		wantErr     bool
+	}{
+		{
+			name:        "特定日の全復習日詳細を取得する場合",
+			userID:      "550e8400-e29b-41d4-a716-446655440001",
+			parsedToday: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			want: []*itemDomain.DailyReviewDate{
+				{
+					ReviewdateID:         "b50e8400-e29b-41d4-a716-446655440001",
+					CategoryID:           stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+					BoxID:                stringPtr("950e8400-e29b-41d4-a716-446655440001"),
+					StepNumber:           1,
+					InitialScheduledDate: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					PrevScheduledDate:    nil,
+					ScheduledDate:        time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					NextScheduledDate:    timePtr(time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)),
+					IsCompleted:          false,
+					ItemID:               "a50e8400-e29b-41d4-a716-446655440001",
+					Name:                 "二次方程式",
+					Detail:               "ax^2 + bx + c = 0の解の公式を覚える",
+					LearnedDate:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					RegisteredAt:         time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					EditedAt:             time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					TargetWeight:         "normal",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:        "復習日がない日付の場合",
+			userID:      "550e8400-e29b-41d4-a716-446655440001",
+			parsedToday: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+			want:        []*itemDomain.DailyReviewDate{},
+			wantErr:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			reviewDates, err := repo.GetAllDailyReviewDates(ctx, tc.userID, tc.parsedToday)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, reviewDates); diff != "" {
+				t.Errorf("GetAllDailyReviewDates() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestItemRepository_GetFinishedItemsByBoxID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name    string
+		boxID   string
+		userID  string
+		want    []*itemDomain.Item
+		wantErr bool
+	}{
+		{
+			name:   "ボックス内の完了済み復習物を取得する場合",
+			boxID:  "950e8400-e29b-41d4-a716-446655440002",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			want: []*itemDomain.Item{
+				{
+					ItemID:       "a50e8400-e29b-41d4-a716-446655440002",
+					UserID:       "550e8400-e29b-41d4-a716-446655440001",
+					CategoryID:   stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+					BoxID:        stringPtr("950e8400-e29b-41d4-a716-446655440002"),
+					PatternID:    stringPtr("750e8400-e29b-41d4-a716-446655440002"),
+					Name:         "円の面積",
+					Detail:       "π × r^2の公式を理解する",
+					LearnedDate:  time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					IsFinished:   true,
+					RegisteredAt: time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC),
+					EditedAt:     time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "未完了復習物のみのボックスの場合（空の結果）",
+			boxID:   "950e8400-e29b-41d4-a716-446655440001",
+			userID:  "550e8400-e29b-41d4-a716-446655440001",
+			want:    []*itemDomain.Item{},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			items, err := repo.GetFinishedItemsByBoxID(ctx, tc.boxID, tc.userID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, items); diff != "" {
+				t.Errorf("GetFinishedItemsByBoxID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestItemRepository_GetUnclassfiedFinishedItemsByCategoryID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name       string
+		categoryID string
+		userID     string
+		want       []*itemDomain.Item
+		wantErr    bool
+	}{
+		{
+			name:       "未分類完了済み復習物がないカテゴリの場合（GetUnclassfiedFinishedItemsByCategoryIDは真の未分類のみ）",
+			categoryID: "650e8400-e29b-41d4-a716-446655440003",
+			userID:     "550e8400-e29b-41d4-a716-446655440002",
+			want:       []*itemDomain.Item{},
+			wantErr:    false,
+		},
+		{
+			name:       "未分類完了済み復習物がないカテゴリの場合",
+			categoryID: "650e8400-e29b-41d4-a716-446655440001",
+			userID:     "550e8400-e29b-41d4-a716-446655440001",
+			want:       []*itemDomain.Item{},
+			wantErr:    false,
+		},
+		{
+			name:       "存在しないカテゴリの場合",
+			categoryID: uuid.New().String(),
+			userID:     "550e8400-e29b-41d4-a716-446655440001",
+			want:       []*itemDomain.Item{},
+			wantErr:    false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			items, err := repo.GetUnclassfiedFinishedItemsByCategoryID(ctx, tc.categoryID, tc.userID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, items); diff != "" {
+				t.Errorf("GetUnclassfiedFinishedItemsByCategoryID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestItemRepository_GetUnclassfiedFinishedItemsByUserID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name    string
+		userID  string
+		want    []*itemDomain.Item
+		wantErr bool
+	}{
+		{
+			name:   "ユーザーの未分類完了済み復習物を取得する場合",
+			userID: "550e8400-e29b-41d4-a716-446655440002",
+			want: []*itemDomain.Item{
+				{
+					ItemID:       "a50e8400-e29b-41d4-a716-446655440006",
+					UserID:       "550e8400-e29b-41d4-a716-446655440002",
+					CategoryID:   nil, // 真の未分類のため nil
+					BoxID:        nil, // 未分類のため nil
+					PatternID:    nil,
+					Name:         "江戸時代",
+					Detail:       "江戸時代の政治と社会を学ぶ",
+					LearnedDate:  time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+					IsFinished:   true,
+					RegisteredAt: time.Date(2024, 1, 1, 15, 0, 0, 0, time.UTC),
+					EditedAt:     time.Date(2024, 1, 1, 15, 0, 0, 0, time.UTC),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "未分類完了済み復習物がないユーザーの場合",
+			userID:  "550e8400-e29b-41d4-a716-446655440001",
+			want:    []*itemDomain.Item{},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			items, err := repo.GetUnclassfiedFinishedItemsByUserID(ctx, tc.userID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, items); diff != "" {
+				t.Errorf("GetUnclassfiedFinishedItemsByUserID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestItemRepository_IsPatternRelatedToItemByPatternID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name      string
+		patternID string
+		userID    string
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "パターンが復習物に関連している場合",
+			patternID: "750e8400-e29b-41d4-a716-446655440001",
+			userID:    "550e8400-e29b-41d4-a716-446655440001",
+			want:      true,
+			wantErr:   false,
+		},
+		{
+			name:      "パターンが復習物に関連していない場合(異なるユーザーのパターン)",
+			patternID: "750e8400-e29b-41d4-a716-446655440003",
+			userID:    "550e8400-e29b-41d4-a716-446655440001",
+			want:      false,
+			wantErr:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			isRelated, err := repo.IsPatternRelatedToItemByPatternID(ctx, tc.patternID, tc.userID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if isRelated != tc.want {
+				t.Errorf("IsPatternRelatedToItemByPatternID() = %v, want %v", isRelated, tc.want)
+			}
+		})
+	}
+}
+
+func TestItemRepository_CountIncompleteReviewDatesByUserID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name           string
+		userID         string
+		excludedItemID *string
+		want           map[string]int
+	}{
+		{
+			name:           "除外する復習物を指定せずに未完了の復習日を日付毎に数える場合",
+			userID:         "550e8400-e29b-41d4-a716-446655440001",
+			excludedItemID: nil,
+			want: map[string]int{
+				"2024-01-02": 1,
+				"2024-01-04": 1,
+				"2024-01-06": 1,
+			},
+		},
+		{
+			name:           "再計算対象の復習物の復習日を除く場合",
+			userID:         "550e8400-e29b-41d4-a716-446655440001",
+			excludedItemID: stringPtr("a50e8400-e29b-41d4-a716-446655440001"),
+			want: map[string]int{
+				"2024-01-06": 1,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			got, err := repo.CountIncompleteReviewDatesByUserID(ctx, tc.userID, tc.excludedItemID)
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CountIncompleteReviewDatesByUserID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestItemRepository_GetUnFinishedItemsByPatternID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name            string
+		patternID       string
+		userID          string
+		wantItemIDs     []string
+		wantReviewCount int
+		wantErr         bool
+	}{
+		{
+			name:            "パターンに紐づく未完了の復習物とその復習日を取得する場合",
+			patternID:       "750e8400-e29b-41d4-a716-446655440001",
+			userID:          "550e8400-e29b-41d4-a716-446655440001",
+			wantItemIDs:     []string{"a50e8400-e29b-41d4-a716-446655440001", "a50e8400-e29b-41d4-a716-446655440003"},
+			wantReviewCount: 3,
+			wantErr:         false,
+		},
+		{
+			name:            "紐づく復習物が完了済みのみの場合",
+			patternID:       "750e8400-e29b-41d4-a716-446655440002",
+			userID:          "550e8400-e29b-41d4-a716-446655440001",
+			wantItemIDs:     []string{},
+			wantReviewCount: 0,
+			wantErr:         false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			items, err := repo.GetUnFinishedItemsByPatternID(ctx, tc.patternID, tc.userID)
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
 				return
 			}
 
-			if isRelated != tc.want {
-				t.Errorf("IsPatternRelatedToItemByPatternID() = %v, want %v", isRelated, tc.want)
+			gotItemIDs := make([]string, len(items))
+			for i, item := range items {
+				gotItemIDs[i] = item.ItemID
+			}
+			if diff := cmp.Diff(tc.wantItemIDs, gotItemIDs); diff != "" {
+				t.Errorf("GetUnFinishedItemsByPatternID() mismatch (-want +got):\n%s", diff)
+			}
+
+			reviewdates, err := repo.GetReviewDatesOfUnFinishedItemsByPatternID(ctx, tc.patternID, tc.userID)
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+			if len(reviewdates) != tc.wantReviewCount {
+				t.Errorf("GetReviewDatesOfUnFinishedItemsByPatternID() count = %d, want %d", len(reviewdates), tc.wantReviewCount)
+			}
+		})
+	}
+}
+
+func TestItemRepository_DeleteReviewDatesByIDs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	ctx := GetTestContext()
+	repo := NewItemRepository()
+
+	itemID := "a50e8400-e29b-41d4-a716-446655440001"
+	userID := "550e8400-e29b-41d4-a716-446655440001"
+
+	// 指定した復習日だけを削除する
+	err := repo.DeleteReviewDatesByIDs(ctx, []string{"b50e8400-e29b-41d4-a716-446655440002"}, userID)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	actualReviewDates, err := repo.GetReviewDatesByItemID(ctx, itemID, userID)
+	if err != nil {
+		t.Fatalf("削除後の復習日取得に失敗: %v", err)
+	}
+	if len(actualReviewDates) != 1 || actualReviewDates[0].ReviewdateID != "b50e8400-e29b-41d4-a716-446655440001" {
+		t.Errorf("DeleteReviewDatesByIDs() 削除後の状態が不正です: %+v", actualReviewDates)
+	}
+}
+
+func TestItemRepository_GetLeechItemsByUserID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	categoryID1 := "650e8400-e29b-41d4-a716-446655440001"
+	boxID1 := "950e8400-e29b-41d4-a716-446655440001"
+	categoryID3 := "650e8400-e29b-41d4-a716-446655440003"
+	boxID4 := "950e8400-e29b-41d4-a716-446655440004"
+
+	tests := []struct {
+		name             string
+		userID           string
+		slipThreshold    int
+		failureThreshold int
+		want             []*itemDomain.LeechItem
+		wantErr          bool
+	}{
+		{
+			name:             "想起に失敗した回数が基準以上の場合",
+			userID:           "550e8400-e29b-41d4-a716-446655440001",
+			slipThreshold:    5,
+			failureThreshold: 4,
+			want: []*itemDomain.LeechItem{
+				{ItemID: "a50e8400-e29b-41d4-a716-446655440001", CategoryID: &categoryID1, BoxID: &boxID1, Name: "二次方程式", SlipCount: 0, FailureCount: 4},
+			},
+		},
+		{
+			name:             "ずらされた回数が基準以上の場合",
+			userID:           "550e8400-e29b-41d4-a716-446655440002",
+			slipThreshold:    5,
+			failureThreshold: 4,
+			want: []*itemDomain.LeechItem{
+				{ItemID: "a50e8400-e29b-41d4-a716-446655440004", CategoryID: &categoryID3, BoxID: &boxID4, Name: "英語の過去形", SlipCount: 5, FailureCount: 0},
+			},
+		},
+		{
+			name:             "基準を下げると回数の合計が多い順に並ぶ場合（完了済みの復習物は除く）",
+			userID:           "550e8400-e29b-41d4-a716-446655440001",
+			slipThreshold:    1,
+			failureThreshold: 1,
+			want: []*itemDomain.LeechItem{
+				{ItemID: "a50e8400-e29b-41d4-a716-446655440001", CategoryID: &categoryID1, BoxID: &boxID1, Name: "二次方程式", SlipCount: 0, FailureCount: 4},
+				{ItemID: "a50e8400-e29b-41d4-a716-446655440003", CategoryID: stringPtr("650e8400-e29b-41d4-a716-446655440002"), BoxID: stringPtr("950e8400-e29b-41d4-a716-446655440003"), Name: "ニュートンの第一法則", SlipCount: 2, FailureCount: 0},
+			},
+		},
+		{
+			name:    "無効なUUIDの場合",
+			userID:  "invalid-uuid",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			got, err := repo.GetLeechItemsByUserID(ctx, tc.userID, tc.slipThreshold, tc.failureThreshold)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetLeechItemsByUserID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestItemRepository_IncrementSlipCount(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name          string
+		itemID        string
+		userID        string
+		wantSlipCount int
+		wantErr       bool
+	}{
+		{
+			name:          "ずらされた回数を1増やす場合",
+			itemID:        "a50e8400-e29b-41d4-a716-446655440004",
+			userID:        "550e8400-e29b-41d4-a716-446655440002",
+			wantSlipCount: 6,
+		},
+		{
+			name:    "無効なUUIDの場合",
+			itemID:  "invalid-uuid",
+			userID:  "550e8400-e29b-41d4-a716-446655440002",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			err := repo.IncrementSlipCount(ctx, tc.itemID, tc.userID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			// リーチの一覧からずらされた回数を確認する
+			leeches, err := repo.GetLeechItemsByUserID(ctx, tc.userID, 1, 1)
+			if err != nil {
+				t.Fatalf("GetLeechItemsByUserID() error = %v", err)
+			}
+			for _, leech := range leeches {
+				if leech.ItemID == tc.itemID {
+					if leech.SlipCount != tc.wantSlipCount {
+						t.Errorf("SlipCount = %d, want %d", leech.SlipCount, tc.wantSlipCount)
+					}
+					return
+				}
+			}
+			t.Errorf("復習物 %s がリーチの一覧にありません", tc.itemID)
+		})
+	}
+}
+
+func TestItemRepository_GetItemOperationsByUserID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	ctx := GetTestContext()
+	repo := NewItemRepository()
+
+	userID := "550e8400-e29b-41d4-a716-446655440001"
+	itemID1 := "a50e8400-e29b-41d4-a716-446655440001"
+	itemID3 := "a50e8400-e29b-41d4-a716-446655440003"
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	operations := []*itemDomain.ItemOperation{
+		{OperationID: uuid.NewString(), UserID: userID, Kind: itemDomain.ItemOperationKindUpdateItem, ItemIDs: []string{itemID1}, OperatedAt: now.Add(-40 * time.Minute)},
+		{OperationID: uuid.NewString(), UserID: userID, Kind: itemDomain.ItemOperationKindCompleteReviewDate, ItemIDs: []string{itemID1}, OperatedAt: now.Add(-10 * time.Minute)},
+		{OperationID: uuid.NewString(), UserID: userID, Kind: itemDomain.ItemOperationKindFinishItem, ItemIDs: []string{itemID1, itemID3}, OperatedAt: now.Add(-5 * time.Minute)},
+	}
+	for _, op := range operations {
+		if err := repo.SaveItemOperation(ctx, op); err != nil {
+			t.Fatalf("SaveItemOperation() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		userID       string
+		operatedFrom time.Time
+		limit        int
+		want         []*itemDomain.ItemOperation
+		wantErr      bool
+	}{
+		{
+			name:         "指定日時以降の操作を新しい順に取得する場合",
+			userID:       userID,
+			operatedFrom: now.Add(-30 * time.Minute),
+			limit:        10,
+			want:         []*itemDomain.ItemOperation{operations[2], operations[1]},
+		},
+		{
+			name:         "取得する数を制限する場合",
+			userID:       userID,
+			operatedFrom: now.Add(-time.Hour),
+			limit:        1,
+			want:         []*itemDomain.ItemOperation{operations[2]},
+		},
+		{
+			name:         "操作がないユーザーの場合",
+			userID:       "550e8400-e29b-41d4-a716-446655440002",
+			operatedFrom: now.Add(-time.Hour),
+			limit:        10,
+			want:         []*itemDomain.ItemOperation{},
+		},
+		{
+			name:    "無効なUUIDの場合",
+			userID:  "invalid-uuid",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := repo.GetItemOperationsByUserID(ctx, tc.userID, tc.operatedFrom, tc.limit)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetItemOperationsByUserID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestItemRepository_RestoreItemOperation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	userID := "550e8400-e29b-41d4-a716-446655440001"
+
+	t.Run("削除した復習物と復習日を元に戻す場合", func(t *testing.T) {
+		ctx := GetTestContext()
+		repo := NewItemRepository()
+		itemID := "a50e8400-e29b-41d4-a716-446655440001"
+
+		wantItem, err := repo.GetItemByID(ctx, itemID, userID)
+		if err != nil {
+			t.Fatalf("GetItemByID() error = %v", err)
+		}
+		wantReviewdates, err := repo.GetReviewDatesByItemID(ctx, itemID, userID)
+		if err != nil {
+			t.Fatalf("GetReviewDatesByItemID() error = %v", err)
+		}
+
+		operation := &itemDomain.ItemOperation{OperationID: uuid.NewString(), UserID: userID, Kind: itemDomain.ItemOperationKindDeleteItem, ItemIDs: []string{itemID}, OperatedAt: time.Now().UTC()}
+		if err := repo.SaveItemOperation(ctx, operation); err != nil {
+			t.Fatalf("SaveItemOperation() error = %v", err)
+		}
+		if err := repo.DeleteItem(ctx, itemID, userID); err != nil {
+			t.Fatalf("DeleteItem() error = %v", err)
+		}
+
+		if err := repo.RestoreItemOperation(ctx, operation.OperationID, userID); err != nil {
+			t.Fatalf("RestoreItemOperation() error = %v", err)
+		}
+
+		gotItem, err := repo.GetItemByID(ctx, itemID, userID)
+		if err != nil {
+			t.Fatalf("元に戻した復習物が取得できません: %v", err)
+		}
+		if diff := cmp.Diff(wantItem, gotItem); diff != "" {
+			t.Errorf("復習物 mismatch (-want +got):\n%s", diff)
+		}
+		gotReviewdates, err := repo.GetReviewDatesByItemID(ctx, itemID, userID)
+		if err != nil {
+			t.Fatalf("GetReviewDatesByItemID() error = %v", err)
+		}
+		if diff := cmp.Diff(wantReviewdates, gotReviewdates); diff != "" {
+			t.Errorf("復習日 mismatch (-want +got):\n%s", diff)
+		}
+
+		// 復習物と一緒にカスケードで消えた想起失敗・タグの紐付けも戻る
+		var failureCount, tagCount int
+		if err := testDB.QueryRow("SELECT COUNT(*) FROM review_failures WHERE item_id = $1", itemID).Scan(&failureCount); err != nil {
+			t.Fatalf("想起失敗の取得に失敗しました: %v", err)
+		}
+		if failureCount != 4 {
+			t.Errorf("想起失敗の数 = %d, want 4", failureCount)
+		}
+		if err := testDB.QueryRow("SELECT COUNT(*) FROM review_item_tags WHERE item_id = $1", itemID).Scan(&tagCount); err != nil {
+			t.Fatalf("タグの紐付けの取得に失敗しました: %v", err)
+		}
+		if tagCount != 2 {
+			t.Errorf("タグの紐付けの数 = %d, want 2", tagCount)
+		}
+
+		// 取り消した操作は再度取り消せない
+		operations, err := repo.GetItemOperationsByUserID(ctx, userID, operation.OperatedAt.Add(-time.Minute), 10)
+		if err != nil {
+			t.Fatalf("GetItemOperationsByUserID() error = %v", err)
+		}
+		if len(operations) != 0 {
+			t.Errorf("取り消した操作が残っています: %+v", operations)
+		}
+	})
+
+	t.Run("削除した復習物の履歴を元に戻す場合", func(t *testing.T) {
+		ctx := GetTestContext()
+		repo := NewItemRepository()
+		itemID := "a50e8400-e29b-41d4-a716-446655440002"
+
+		wantLogs, err := repo.GetReviewLogsByItemID(ctx, itemID, userID)
+		if err != nil {
+			t.Fatalf("GetReviewLogsByItemID() error = %v", err)
+		}
+		if len(wantLogs) == 0 {
+			t.Fatal("前提となる履歴がありません")
+		}
+
+		operation := &itemDomain.ItemOperation{OperationID: uuid.NewString(), UserID: userID, Kind: itemDomain.ItemOperationKindDeleteItem, ItemIDs: []string{itemID}, OperatedAt: time.Now().UTC()}
+		if err := repo.SaveItemOperation(ctx, operation); err != nil {
+			t.Fatalf("SaveItemOperation() error = %v", err)
+		}
+		if err := repo.DeleteItem(ctx, itemID, userID); err != nil {
+			t.Fatalf("DeleteItem() error = %v", err)
+		}
+
+		if err := repo.RestoreItemOperation(ctx, operation.OperationID, userID); err != nil {
+			t.Fatalf("RestoreItemOperation() error = %v", err)
+		}
+
+		gotLogs, err := repo.GetReviewLogsByItemID(ctx, itemID, userID)
+		if err != nil {
+			t.Fatalf("GetReviewLogsByItemID() error = %v", err)
+		}
+		if diff := cmp.Diff(wantLogs, gotLogs); diff != "" {
+			t.Errorf("履歴 mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("操作で記録された履歴を削除する場合", func(t *testing.T) {
+		ctx := GetTestContext()
+		repo := NewItemRepository()
+		itemID := "a50e8400-e29b-41d4-a716-446655440003"
+
+		wantLogs, err := repo.GetReviewLogsByItemID(ctx, itemID, userID)
+		if err != nil {
+			t.Fatalf("GetReviewLogsByItemID() error = %v", err)
+		}
+
+		operation := &itemDomain.ItemOperation{OperationID: uuid.NewString(), UserID: userID, Kind: itemDomain.ItemOperationKindCompleteReviewDate, ItemIDs: []string{itemID}, OperatedAt: time.Now().UTC()}
+		if err := repo.SaveItemOperation(ctx, operation); err != nil {
+			t.Fatalf("SaveItemOperation() error = %v", err)
+		}
+		log := &itemDomain.ReviewLog{
+			ReviewLogID:  uuid.NewString(),
+			UserID:       userID,
+			ItemID:       itemID,
+			StepNumber:   1,
+			Outcome:      itemDomain.ReviewOutcomeCompleted,
+			ReviewedDate: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+			ReviewedAt:   time.Now().UTC(),
+		}
+		if err := repo.CreateReviewLog(ctx, log); err != nil {
+			t.Fatalf("CreateReviewLog() error = %v", err)
+		}
+
+		if err := repo.RestoreItemOperation(ctx, operation.OperationID, userID); err != nil {
+			t.Fatalf("RestoreItemOperation() error = %v", err)
+		}
+
+		gotLogs, err := repo.GetReviewLogsByItemID(ctx, itemID, userID)
+		if err != nil {
+			t.Fatalf("GetReviewLogsByItemID() error = %v", err)
+		}
+		if diff := cmp.Diff(wantLogs, gotLogs); diff != "" {
+			t.Errorf("履歴 mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("作成した復習物を削除する場合", func(t *testing.T) {
+		ctx := GetTestContext()
+		repo := NewItemRepository()
+		now := time.Now().UTC()
+		item := &itemDomain.Item{
+			ItemID:       uuid.NewString(),
+			UserID:       userID,
+			CategoryID:   stringPtr("650e8400-e29b-41d4-a716-446655440001"),
+			Name:         "取り消される復習物",
+			LearnedDate:  time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+			RegisteredAt: now,
+			EditedAt:     now,
+		}
+
+		operation := &itemDomain.ItemOperation{OperationID: uuid.NewString(), UserID: userID, Kind: itemDomain.ItemOperationKindCreateItem, ItemIDs: []string{item.ItemID}, OperatedAt: now}
+		if err := repo.SaveItemOperation(ctx, operation); err != nil {
+			t.Fatalf("SaveItemOperation() error = %v", err)
+		}
+		if err := repo.CreateItem(ctx, item); err != nil {
+			t.Fatalf("CreateItem() error = %v", err)
+		}
+
+		if err := repo.RestoreItemOperation(ctx, operation.OperationID, userID); err != nil {
+			t.Fatalf("RestoreItemOperation() error = %v", err)
+		}
+
+		if _, err := repo.GetItemByID(ctx, item.ItemID, userID); err == nil {
+			t.Error("作成を取り消した復習物が残っています")
+		}
+	})
+
+	t.Run("戻す復習物のボックスが操作の後に削除された場合", func(t *testing.T) {
+		ctx := GetTestContext()
+		repo := NewItemRepository()
+		itemID := "a50e8400-e29b-41d4-a716-446655440002"
+
+		operation := &itemDomain.ItemOperation{OperationID: uuid.NewString(), UserID: userID, Kind: itemDomain.ItemOperationKindDeleteItem, ItemIDs: []string{itemID}, OperatedAt: time.Now().UTC()}
+		if err := repo.SaveItemOperation(ctx, operation); err != nil {
+			t.Fatalf("SaveItemOperation() error = %v", err)
+		}
+		if err := repo.DeleteItem(ctx, itemID, userID); err != nil {
+			t.Fatalf("DeleteItem() error = %v", err)
+		}
+		if _, err := testDB.Exec("DELETE FROM review_boxes WHERE id = $1", "950e8400-e29b-41d4-a716-446655440002"); err != nil {
+			t.Fatalf("ボックスの削除に失敗しました: %v", err)
+		}
+
+		err := repo.RestoreItemOperation(ctx, operation.OperationID, userID)
+		if !errors.Is(err, itemDomain.ErrItemOperationReferenceDeleted) {
+			t.Fatalf("RestoreItemOperation() error = %v, want %v", err, itemDomain.ErrItemOperationReferenceDeleted)
+		}
+		if _, err := repo.GetItemByID(ctx, itemID, userID); err == nil {
+			t.Error("戻せない復習物が作り直されています")
+		}
+	})
+
+	t.Run("無効なUUIDの場合", func(t *testing.T) {
+		ctx := GetTestContext()
+		repo := NewItemRepository()
+		if err := repo.RestoreItemOperation(ctx, "invalid-uuid", userID); err == nil {
+			t.Error("エラーが発生するはずですが、発生しませんでした")
+		}
+	})
+}
+
+func TestItemRepository_SearchItems(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	user1 := "550e8400-e29b-41d4-a716-446655440001"
+	user2 := "550e8400-e29b-41d4-a716-446655440002"
+	category2 := "650e8400-e29b-41d4-a716-446655440002"
+	learnedFrom := time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC)
+	unfinished := false
+
+	tests := []struct {
+		name      string
+		userID    string
+		terms     []string
+		filter    itemDomain.ItemSearchFilter
+		limit     int
+		setup     func(t *testing.T)
+		want      []string
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name:   "詳細に一致する復習物を完了済み・未完了の両方から学習日の新しい順に取得する場合",
+			userID: user1,
+			terms:  []string{"公式"},
+			limit:  10,
+			want: []string{
+				"a50e8400-e29b-41d4-a716-446655440002",
+				"a50e8400-e29b-41d4-a716-446655440001",
+			},
+			wantTotal: 2,
+		},
+		{
+			name:      "全ての検索語を含む復習物だけを取得する場合",
+			userID:    user1,
+			terms:     []string{"理解", "公式"},
+			limit:     10,
+			want:      []string{"a50e8400-e29b-41d4-a716-446655440002"},
+			wantTotal: 1,
+		},
+		{
+			name:      "大文字小文字を区別せずに名前に一致する場合",
+			userID:    user2,
+			terms:     []string{"goroutine"},
+			limit:     10,
+			want:      []string{"a50e8400-e29b-41d4-a716-446655440007"},
+			wantTotal: 1,
+		},
+		{
+			name:      "未完了の復習物に絞り込む場合",
+			userID:    user1,
+			terms:     []string{"公式"},
+			filter:    itemDomain.ItemSearchFilter{IsFinished: &unfinished},
+			limit:     10,
+			want:      []string{"a50e8400-e29b-41d4-a716-446655440001"},
+			wantTotal: 1,
+		},
+		{
+			name:      "カテゴリーで絞り込む場合",
+			userID:    user1,
+			terms:     []string{"理解"},
+			filter:    itemDomain.ItemSearchFilter{CategoryID: &category2},
+			limit:     10,
+			want:      []string{"a50e8400-e29b-41d4-a716-446655440003"},
+			wantTotal: 1,
+		},
+		{
+			name:      "学習日で絞り込む場合",
+			userID:    user2,
+			terms:     []string{"政治"},
+			filter:    itemDomain.ItemSearchFilter{LearnedFrom: &learnedFrom},
+			limit:     10,
+			want:      []string{"a50e8400-e29b-41d4-a716-446655440006"},
+			wantTotal: 1,
+		},
+		{
+			name:      "取得件数を制限しても一致した全件数を返す場合",
+			userID:    user1,
+			terms:     []string{"公式"},
+			limit:     1,
+			want:      []string{"a50e8400-e29b-41d4-a716-446655440002"},
+			wantTotal: 2,
+		},
+		{
+			name:   "LIKEの特殊文字は文字として検索する場合",
+			userID: user1,
+			terms:  []string{"%"},
+			limit:  10,
+			want:   []string{},
+		},
+		{
+			name:   "他のユーザーの復習物は取得しない場合",
+			userID: user1,
+			terms:  []string{"政治"},
+			limit:  10,
+			want:   []string{},
+		},
+		{
+			name:   "名前に一致する復習物を学習日が古くても詳細だけに一致する復習物より先に取得する場合",
+			userID: user1,
+			terms:  []string{"公式"},
+			limit:  1,
+			setup: func(t *testing.T) {
+				now := time.Date(2023, 12, 1, 12, 0, 0, 0, time.UTC)
+				item := &itemDomain.Item{
+					ItemID:       "a50e8400-e29b-41d4-a716-446655440099",
+					UserID:       user1,
+					Name:         "公式",
+					LearnedDate:  time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+					RegisteredAt: now,
+					EditedAt:     now,
+				}
+				if err := NewItemRepository().CreateItem(GetTestContext(), item); err != nil {
+					t.Fatalf("CreateItem() error = %v", err)
+				}
+			},
+			want:      []string{"a50e8400-e29b-41d4-a716-446655440099"},
+			wantTotal: 3,
+		},
+		{
+			name:    "無効なUUIDの場合",
+			userID:  "invalid-uuid",
+			terms:   []string{"公式"},
+			limit:   10,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewItemRepository()
+
+			if tc.setup != nil {
+				tc.setup(t)
+			}
+			got, total, err := repo.SearchItems(ctx, tc.userID, tc.terms, &tc.filter, tc.limit)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			gotIDs := make([]string, len(got))
+			for i, item := range got {
+				gotIDs[i] = item.ItemID
+			}
+			if diff := cmp.Diff(tc.want, gotIDs); diff != "" {
+				t.Errorf("SearchItems() mismatch (-want +got):\n%s", diff)
+			}
+			if total != tc.wantTotal {
+				t.Errorf("SearchItems() total = %d, want %d", total, tc.wantTotal)
 			}
 		})
 	}
@@ -27,12 +27,19 @@ func (r *patternRepository) CreatePattern(ctx context.Context, p *patternDomain.
 	pgEdit := pgtype.Timestamptz{Time: p.EditedAt, Valid: true}
 
 	params := dbgen.CreatePatternParams{
-		ID:           pgID,
-		UserID:       pgUserID,
-		Name:         p.Name,
-		TargetWeight: dbgen.TargetWeightEnum(p.TargetWeight),
-		RegisteredAt: pgReg,
-		EditedAt:     pgEdit,
+		ID:                     pgID,
+		UserID:                 pgUserID,
+		Name:                   p.Name,
+		TargetWeight:           dbgen.TargetWeightEnum(p.TargetWeight),
+		SchedulerKind:          dbgen.SchedulerKindEnum(p.SchedulerKind),
+		TargetRetention:        p.TargetRetention,
+		IntervalFuzz:           p.IntervalFuzz,
+		IntervalFromCompletion: p.IntervalFromCompletion,
+		OverduePolicy:          dbgen.OverduePolicyEnum(p.OverduePolicy),
+		OverdueSpreadDays:      int16(p.OverdueSpreadDays), // #nosec G115
+		Version:                int32(p.Version),           // #nosec G115
+		RegisteredAt:           pgReg,
+		EditedAt:               pgEdit,
 	}
 
 	return q.CreatePattern(ctx, params)
@@ -40,7 +47,7 @@ func (r *patternRepository) CreatePattern(ctx context.Context, p *patternDomain.
 
 func (r *patternRepository) CreatePatternSteps(ctx context.Context, steps []*patternDomain.PatternStep) (int64, error) {
 	q := db.GetQuery(ctx)
-	colums := []string{"id", "user_id", "pattern_id", "step_number", "interval_days"}
+	colums := []string{"id", "user_id", "pattern_id", "step_number", "interval_days", "version"}
 	cps := make([]dbgen.CreatePatternStepsParams, len(steps))
 	rows := make([][]any, len(steps))
 	for i, s := range steps {
@@ -53,6 +60,7 @@ func (r *patternRepository) CreatePatternSteps(ctx context.Context, steps []*pat
 			PatternID:    pgPatternID,
 			StepNumber:   int16(s.StepNumber),   // #nosec G115
 			IntervalDays: int16(s.IntervalDays), // #nosec G115
+			Version:      int32(s.Version),      // #nosec G115
 		}
 		rows[i] = []any{
 			cps[i].ID,
@@ -60,6 +68,7 @@ func (r *patternRepository) CreatePatternSteps(ctx context.Context, steps []*pat
 			cps[i].PatternID,
 			cps[i].StepNumber,
 			cps[i].IntervalDays,
+			cps[i].Version,
 		}
 	}
 	return q.CopyFrom(
@@ -87,6 +96,13 @@ func (r *patternRepository) GetAllPatternsByUserID(ctx context.Context, userID s
 			userID,
 			row.Name,
 			string(row.TargetWeight),
+			string(row.SchedulerKind),
+			row.TargetRetention,
+			row.IntervalFuzz,
+			row.IntervalFromCompletion,
+			string(row.OverduePolicy),
+			int(row.OverdueSpreadDays),
+			int(row.Version),
 			row.RegisteredAt.Time,
 			row.EditedAt.Time,
 		)
@@ -119,6 +135,7 @@ func (r *patternRepository) GetAllPatternStepsByUserID(ctx context.Context, user
 			patternID,
 			int(row.StepNumber),
 			int(row.IntervalDays),
+			int(row.Version),
 		)
 		if err != nil {
 			return nil, err
@@ -134,11 +151,18 @@ func (r *patternRepository) UpdatePattern(ctx context.Context, p *patternDomain.
 	pgEdit := pgtype.Timestamptz{Time: p.EditedAt, Valid: true}
 
 	params := dbgen.UpdatePatternParams{
-		Name:         p.Name,
-		TargetWeight: dbgen.TargetWeightEnum(p.TargetWeight),
-		EditedAt:     pgEdit,
-		ID:           pgID,
-		UserID:       pgUserID,
+		Name:                   p.Name,
+		TargetWeight:           dbgen.TargetWeightEnum(p.TargetWeight),
+		SchedulerKind:          dbgen.SchedulerKindEnum(p.SchedulerKind),
+		TargetRetention:        p.TargetRetention,
+		IntervalFuzz:           p.IntervalFuzz,
+		IntervalFromCompletion: p.IntervalFromCompletion,
+		OverduePolicy:          dbgen.OverduePolicyEnum(p.OverduePolicy),
+		OverdueSpreadDays:      int16(p.OverdueSpreadDays), // #nosec G115
+		Version:                int32(p.Version),           // #nosec G115
+		EditedAt:               pgEdit,
+		ID:                     pgID,
+		UserID:                 pgUserID,
 	}
 	return q.UpdatePattern(ctx, params)
 }
@@ -193,6 +217,13 @@ func (r *patternRepository) FindPatternByPatternID(ctx context.Context, patternI
 		userID,
 		row.Name,
 		string(row.TargetWeight),
+		string(row.SchedulerKind),
+		row.TargetRetention,
+		row.IntervalFuzz,
+		row.IntervalFromCompletion,
+		string(row.OverduePolicy),
+		int(row.OverdueSpreadDays),
+		int(row.Version),
 		row.RegisteredAt.Time,
 		row.EditedAt.Time,
 	)
@@ -232,6 +263,46 @@ func (r *patternRepository) GetAllPatternStepsByPatternID(ctx context.Context, p
 			patternID,
 			int(row.StepNumber),
 			int(row.IntervalDays),
+			int(row.Version),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// 復習物が記録しているバージョンのstepsを取得
+func (r *patternRepository) GetPatternStepsByPatternVersion(ctx context.Context, patternID string, version int, userID string) ([]*patternDomain.PatternStep, error) {
+	q := db.GetQuery(ctx)
+	pgID, err := toUUID(patternID)
+	if err != nil {
+		return nil, err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	params := dbgen.GetPatternStepsByPatternVersionParams{
+		PatternID: pgID,
+		Version:   int32(version), // #nosec G115
+		UserID:    pgUserID,
+	}
+
+	rows, err := q.GetPatternStepsByPatternVersion(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*patternDomain.PatternStep, len(rows))
+	for i, row := range rows {
+		PatternStepID := uuid.UUID(row.ID.Bytes).String()
+		out[i], err = patternDomain.ReconstructPatternStep(
+			PatternStepID,
+			userID,
+			patternID,
+			int(row.StepNumber),
+			int(row.IntervalDays),
+			int(row.Version),
 		)
 		if err != nil {
 			return nil, err
@@ -26,29 +26,129 @@ func TestPatternRepository_CreatePattern(t *testing.T) {
 		{
 			name: "パターン作成に成功する場合",
 			pattern: &patternDomain.Pattern{
-				PatternID:    uuid.New().String(),
-				UserID:       "550e8400-e29b-41d4-a716-446655440001", // Exists in fixture
-				Name:         "新しいパターン",
-				TargetWeight: "normal",
-				RegisteredAt: time.Now(),
-				EditedAt:     time.Now(),
+				PatternID:         uuid.New().String(),
+				UserID:            "550e8400-e29b-41d4-a716-446655440001", // Exists in fixture
+				Name:              "新しいパターン",
+				TargetWeight:      "normal",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				OverduePolicy:     patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+				Version:           1,
+				RegisteredAt:      time.Now(),
+				EditedAt:          time.Now(),
 			},
 			want: &patternDomain.Pattern{
-				UserID:       "550e8400-e29b-41d4-a716-446655440001",
-				Name:         "新しいパターン",
-				TargetWeight: "normal",
+				UserID:            "550e8400-e29b-41d4-a716-446655440001",
+				Name:              "新しいパターン",
+				TargetWeight:      "normal",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				OverduePolicy:     patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+				Version:           1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "間隔の揺らぎを有効にしたパターンを作成する場合",
+			pattern: &patternDomain.Pattern{
+				PatternID:         uuid.New().String(),
+				UserID:            "550e8400-e29b-41d4-a716-446655440001",
+				Name:              "揺らぎありパターン",
+				TargetWeight:      "normal",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				IntervalFuzz:      true,
+				OverduePolicy:     patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+				Version:           1,
+				RegisteredAt:      time.Now(),
+				EditedAt:          time.Now(),
+			},
+			want: &patternDomain.Pattern{
+				UserID:            "550e8400-e29b-41d4-a716-446655440001",
+				Name:              "揺らぎありパターン",
+				TargetWeight:      "normal",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				IntervalFuzz:      true,
+				OverduePolicy:     patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+				Version:           1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "間隔の起点を完了日にしたパターンを作成する場合",
+			pattern: &patternDomain.Pattern{
+				PatternID:              uuid.New().String(),
+				UserID:                 "550e8400-e29b-41d4-a716-446655440001",
+				Name:                   "完了日起点パターン",
+				TargetWeight:           "normal",
+				SchedulerKind:          "fixed_steps",
+				TargetRetention:        0.9,
+				IntervalFromCompletion: true,
+				OverduePolicy:          patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays:      patternDomain.DefaultOverdueSpreadDays,
+				Version:                1,
+				RegisteredAt:           time.Now(),
+				EditedAt:               time.Now(),
+			},
+			want: &patternDomain.Pattern{
+				UserID:                 "550e8400-e29b-41d4-a716-446655440001",
+				Name:                   "完了日起点パターン",
+				TargetWeight:           "normal",
+				SchedulerKind:          "fixed_steps",
+				TargetRetention:        0.9,
+				IntervalFromCompletion: true,
+				OverduePolicy:          patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays:      patternDomain.DefaultOverdueSpreadDays,
+				Version:                1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "期限切れの復習物を振り分けるパターンを作成する場合",
+			pattern: &patternDomain.Pattern{
+				PatternID:         uuid.New().String(),
+				UserID:            "550e8400-e29b-41d4-a716-446655440001",
+				Name:              "振り分けパターン",
+				TargetWeight:      "normal",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				OverduePolicy:     patternDomain.OverduePolicySpread,
+				OverdueSpreadDays: 14,
+				Version:           1,
+				RegisteredAt:      time.Now(),
+				EditedAt:          time.Now(),
+			},
+			want: &patternDomain.Pattern{
+				UserID:            "550e8400-e29b-41d4-a716-446655440001",
+				Name:              "振り分けパターン",
+				TargetWeight:      "normal",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				OverduePolicy:     patternDomain.OverduePolicySpread,
+				OverdueSpreadDays: 14,
+				Version:           1,
 			},
 			wantErr: false,
 		},
 		{
 			name: "存在しないユーザーによる外部キー制約違反",
 			pattern: &patternDomain.Pattern{
-				PatternID:    uuid.New().String(),
-				UserID:       uuid.New().String(), // Does not exist in fixture
-				Name:         "存在しないユーザーパターン",
-				TargetWeight: "normal",
-				RegisteredAt: time.Now(),
-				EditedAt:     time.Now(),
+				PatternID:         uuid.New().String(),
+				UserID:            uuid.New().String(), // Does not exist in fixture
+				Name:              "存在しないユーザーパターン",
+				TargetWeight:      "normal",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				OverduePolicy:     patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+				Version:           1,
+				RegisteredAt:      time.Now(),
+				EditedAt:          time.Now(),
 			},
 			want:    nil,
 			wantErr: true,
@@ -56,12 +156,17 @@ func TestPatternRepository_CreatePattern(t *testing.T) {
 		{
 			name: "無効な重みで作成する場合",
 			pattern: &patternDomain.Pattern{
-				PatternID:    uuid.New().String(),
-				UserID:       "550e8400-e29b-41d4-a716-446655440001",
-				Name:         "無効な重みパターン",
-				TargetWeight: "invalid_weight", // Invalid enum value
-				RegisteredAt: time.Now(),
-				EditedAt:     time.Now(),
+				PatternID:         uuid.New().String(),
+				UserID:            "550e8400-e29b-41d4-a716-446655440001",
+				Name:              "無効な重みパターン",
+				TargetWeight:      "invalid_weight", // Invalid enum value
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				OverduePolicy:     patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+				Version:           1,
+				RegisteredAt:      time.Now(),
+				EditedAt:          time.Now(),
 			},
 			want:    nil,
 			wantErr: true,
@@ -133,28 +238,43 @@ func TestPatternRepository_GetAllPatternsByUserID(t *testing.T) {
 			userID: "550e8400-e29b-41d4-a716-446655440001",
 			want: []patternDomain.Pattern{
 				{
-					PatternID:    "750e8400-e29b-41d4-a716-446655440001",
-					UserID:       "550e8400-e29b-41d4-a716-446655440001",
-					Name:         "フィボナッチパターン",
-					TargetWeight: "normal",
-					RegisteredAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
-					EditedAt:     time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+					PatternID:         "750e8400-e29b-41d4-a716-446655440001",
+					UserID:            "550e8400-e29b-41d4-a716-446655440001",
+					Name:              "フィボナッチパターン",
+					TargetWeight:      "normal",
+					SchedulerKind:     "fixed_steps",
+					TargetRetention:   0.9,
+					OverduePolicy:     patternDomain.OverduePolicySlideAll,
+					OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+					Version:           1,
+					RegisteredAt:      time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+					EditedAt:          time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
 				},
 				{
-					PatternID:    "750e8400-e29b-41d4-a716-446655440002",
-					UserID:       "550e8400-e29b-41d4-a716-446655440001",
-					Name:         "エビングハウスパターン",
-					TargetWeight: "heavy",
-					RegisteredAt: time.Date(2024, 1, 1, 8, 30, 0, 0, time.UTC),
-					EditedAt:     time.Date(2024, 1, 1, 8, 30, 0, 0, time.UTC),
+					PatternID:         "750e8400-e29b-41d4-a716-446655440002",
+					UserID:            "550e8400-e29b-41d4-a716-446655440001",
+					Name:              "エビングハウスパターン",
+					TargetWeight:      "heavy",
+					SchedulerKind:     "fixed_steps",
+					TargetRetention:   0.9,
+					OverduePolicy:     patternDomain.OverduePolicySlideAll,
+					OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+					Version:           1,
+					RegisteredAt:      time.Date(2024, 1, 1, 8, 30, 0, 0, time.UTC),
+					EditedAt:          time.Date(2024, 1, 1, 8, 30, 0, 0, time.UTC),
 				},
 				{
-					PatternID:    "750e8400-e29b-41d4-a716-446655440005",
-					UserID:       "550e8400-e29b-41d4-a716-446655440001",
-					Name:         "ステップ未作成のパターン",
-					TargetWeight: "light",
-					RegisteredAt: time.Date(2024, 1, 1, 9, 00, 0, 0, time.UTC),
-					EditedAt:     time.Date(2024, 1, 1, 9, 00, 0, 0, time.UTC),
+					PatternID:         "750e8400-e29b-41d4-a716-446655440005",
+					UserID:            "550e8400-e29b-41d4-a716-446655440001",
+					Name:              "ステップ未作成のパターン",
+					TargetWeight:      "light",
+					SchedulerKind:     "fixed_steps",
+					TargetRetention:   0.9,
+					OverduePolicy:     patternDomain.OverduePolicySlideAll,
+					OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+					Version:           1,
+					RegisteredAt:      time.Date(2024, 1, 1, 9, 00, 0, 0, time.UTC),
+					EditedAt:          time.Date(2024, 1, 1, 9, 00, 0, 0, time.UTC),
 				},
 			},
 			wantErr:       false,
@@ -224,31 +344,46 @@ func TestPatternRepository_UpdatePattern(t *testing.T) {
 		{
 			name: "パターン更新に成功する場合",
 			pattern: &patternDomain.Pattern{
-				PatternID:    "750e8400-e29b-41d4-a716-446655440001",
-				UserID:       "550e8400-e29b-41d4-a716-446655440001",
-				Name:         "更新されたフィボナッチパターン",
-				TargetWeight: "heavy",
-				RegisteredAt: time.Now().Add(-24 * time.Hour),
-				EditedAt:     time.Now(),
+				PatternID:         "750e8400-e29b-41d4-a716-446655440001",
+				UserID:            "550e8400-e29b-41d4-a716-446655440001",
+				Name:              "更新されたフィボナッチパターン",
+				TargetWeight:      "heavy",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				OverduePolicy:     patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+				Version:           1,
+				RegisteredAt:      time.Now().Add(-24 * time.Hour),
+				EditedAt:          time.Now(),
 			},
 			want: &patternDomain.Pattern{
-				PatternID:    "750e8400-e29b-41d4-a716-446655440001",
-				UserID:       "550e8400-e29b-41d4-a716-446655440001",
-				Name:         "更新されたフィボナッチパターン",
-				TargetWeight: "heavy",
-				RegisteredAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+				PatternID:         "750e8400-e29b-41d4-a716-446655440001",
+				UserID:            "550e8400-e29b-41d4-a716-446655440001",
+				Name:              "更新されたフィボナッチパターン",
+				TargetWeight:      "heavy",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				OverduePolicy:     patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+				Version:           1,
+				RegisteredAt:      time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
 			},
 			wantErr: false,
 		},
 		{
 			name: "無効な重みで更新する場合",
 			pattern: &patternDomain.Pattern{
-				PatternID:    "750e8400-e29b-41d4-a716-446655440001",
-				UserID:       "550e8400-e29b-41d4-a716-446655440001",
-				Name:         "パターン",
-				TargetWeight: "invalid_weight",
-				RegisteredAt: time.Now().Add(-24 * time.Hour),
-				EditedAt:     time.Now(),
+				PatternID:         "750e8400-e29b-41d4-a716-446655440001",
+				UserID:            "550e8400-e29b-41d4-a716-446655440001",
+				Name:              "パターン",
+				TargetWeight:      "invalid_weight",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				OverduePolicy:     patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+				Version:           1,
+				RegisteredAt:      time.Now().Add(-24 * time.Hour),
+				EditedAt:          time.Now(),
 			},
 			want:    nil,
 			wantErr: true,
@@ -385,12 +520,17 @@ func TestPatternRepository_FindPatternByPatternID(t *testing.T) {
 			patternID: "750e8400-e29b-41d4-a716-446655440001",
 			userID:    "550e8400-e29b-41d4-a716-446655440001",
 			want: &patternDomain.Pattern{
-				PatternID:    "750e8400-e29b-41d4-a716-446655440001",
-				UserID:       "550e8400-e29b-41d4-a716-446655440001",
-				Name:         "フィボナッチパターン",
-				TargetWeight: "normal",
-				RegisteredAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
-				EditedAt:     time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+				PatternID:         "750e8400-e29b-41d4-a716-446655440001",
+				UserID:            "550e8400-e29b-41d4-a716-446655440001",
+				Name:              "フィボナッチパターン",
+				TargetWeight:      "normal",
+				SchedulerKind:     "fixed_steps",
+				TargetRetention:   0.9,
+				OverduePolicy:     patternDomain.OverduePolicySlideAll,
+				OverdueSpreadDays: patternDomain.DefaultOverdueSpreadDays,
+				Version:           1,
+				RegisteredAt:      time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+				EditedAt:          time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
 			},
 			wantErr:      false,
 			expectName:   "フィボナッチパターン",
@@ -456,6 +596,7 @@ func TestPatternRepository_CreatePatternSteps(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440005",
 					StepNumber:    1,
 					IntervalDays:  1,
+					Version:       1,
 				},
 				{
 					PatternStepID: "850e8400-e29b-41d4-a716-446655440101",
@@ -463,6 +604,7 @@ func TestPatternRepository_CreatePatternSteps(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440005",
 					StepNumber:    2,
 					IntervalDays:  3,
+					Version:       1,
 				},
 			},
 			want: []*patternDomain.PatternStep{
@@ -472,6 +614,7 @@ func TestPatternRepository_CreatePatternSteps(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440005",
 					StepNumber:    1,
 					IntervalDays:  1,
+					Version:       1,
 				},
 				{
 					PatternStepID: "850e8400-e29b-41d4-a716-446655440101",
@@ -479,6 +622,7 @@ func TestPatternRepository_CreatePatternSteps(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440005",
 					StepNumber:    2,
 					IntervalDays:  3,
+					Version:       1,
 				},
 			},
 			wantErr: false,
@@ -492,6 +636,7 @@ func TestPatternRepository_CreatePatternSteps(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440999", // 存在しないパターンID
 					StepNumber:    1,
 					IntervalDays:  1,
+					Version:       1,
 				},
 			},
 			want:    nil,
@@ -573,6 +718,7 @@ func TestPatternRepository_GetAllPatternStepsByUserID(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440001",
 					StepNumber:    1,
 					IntervalDays:  1,
+					Version:       1,
 				},
 				{
 					PatternStepID: "850e8400-e29b-41d4-a716-446655440002",
@@ -580,6 +726,7 @@ func TestPatternRepository_GetAllPatternStepsByUserID(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440001",
 					StepNumber:    2,
 					IntervalDays:  2,
+					Version:       1,
 				},
 				{
 					PatternStepID: "850e8400-e29b-41d4-a716-446655440003",
@@ -587,6 +734,7 @@ func TestPatternRepository_GetAllPatternStepsByUserID(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440001",
 					StepNumber:    3,
 					IntervalDays:  3,
+					Version:       1,
 				},
 				{
 					PatternStepID: "850e8400-e29b-41d4-a716-446655440004",
@@ -594,6 +742,7 @@ func TestPatternRepository_GetAllPatternStepsByUserID(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440002",
 					StepNumber:    1,
 					IntervalDays:  1,
+					Version:       1,
 				},
 				{
 					PatternStepID: "850e8400-e29b-41d4-a716-446655440005",
@@ -601,6 +750,7 @@ func TestPatternRepository_GetAllPatternStepsByUserID(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440002",
 					StepNumber:    2,
 					IntervalDays:  5,
+					Version:       1,
 				},
 			},
 			wantErr:       false,
@@ -616,6 +766,7 @@ func TestPatternRepository_GetAllPatternStepsByUserID(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440003",
 					StepNumber:    1,
 					IntervalDays:  7,
+					Version:       1,
 				},
 				{
 					PatternStepID: "850e8400-e29b-41d4-a716-446655440007",
@@ -623,6 +774,7 @@ func TestPatternRepository_GetAllPatternStepsByUserID(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440004",
 					StepNumber:    1,
 					IntervalDays:  3,
+					Version:       1,
 				},
 			},
 			wantErr:       false,
@@ -773,6 +925,7 @@ func TestPatternRepository_GetAllPatternStepsByPatternID(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440001",
 					StepNumber:    1,
 					IntervalDays:  1,
+					Version:       1,
 				},
 				{
 					PatternStepID: "850e8400-e29b-41d4-a716-446655440002",
@@ -780,6 +933,7 @@ func TestPatternRepository_GetAllPatternStepsByPatternID(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440001",
 					StepNumber:    2,
 					IntervalDays:  2,
+					Version:       1,
 				},
 				{
 					PatternStepID: "850e8400-e29b-41d4-a716-446655440003",
@@ -787,6 +941,7 @@ func TestPatternRepository_GetAllPatternStepsByPatternID(t *testing.T) {
 					PatternID:     "750e8400-e29b-41d4-a716-446655440001",
 					StepNumber:    3,
 					IntervalDays:  3,
+					Version:       1,
 				},
 			},
 			wantErr: false,
@@ -827,6 +982,128 @@ func TestPatternRepository_GetAllPatternStepsByPatternID(t *testing.T) {
 	}
 }
 
+func TestPatternRepository_GetPatternStepsByPatternVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	ctx := GetTestContext()
+	repo := NewPatternRepository()
+
+	// パターンID1にバージョン2のステップを追加する（パターン本体のバージョンは1のまま）
+	newVersionSteps := []*patternDomain.PatternStep{
+		{
+			PatternStepID: "850e8400-e29b-41d4-a716-446655440200",
+			UserID:        "550e8400-e29b-41d4-a716-446655440001",
+			PatternID:     "750e8400-e29b-41d4-a716-446655440001",
+			StepNumber:    1,
+			IntervalDays:  2,
+			Version:       2,
+		},
+	}
+	if _, err := repo.CreatePatternSteps(ctx, newVersionSteps); err != nil {
+		t.Fatalf("バージョン2のステップの作成に失敗: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		patternID string
+		version   int
+		userID    string
+		want      []patternDomain.PatternStep
+	}{
+		{
+			name:      "バージョン1のステップを取得（3件）",
+			patternID: "750e8400-e29b-41d4-a716-446655440001",
+			version:   1,
+			userID:    "550e8400-e29b-41d4-a716-446655440001",
+			want: []patternDomain.PatternStep{
+				{
+					PatternStepID: "850e8400-e29b-41d4-a716-446655440001",
+					UserID:        "550e8400-e29b-41d4-a716-446655440001",
+					PatternID:     "750e8400-e29b-41d4-a716-446655440001",
+					StepNumber:    1,
+					IntervalDays:  1,
+					Version:       1,
+				},
+				{
+					PatternStepID: "850e8400-e29b-41d4-a716-446655440002",
+					UserID:        "550e8400-e29b-41d4-a716-446655440001",
+					PatternID:     "750e8400-e29b-41d4-a716-446655440001",
+					StepNumber:    2,
+					IntervalDays:  2,
+					Version:       1,
+				},
+				{
+					PatternStepID: "850e8400-e29b-41d4-a716-446655440003",
+					UserID:        "550e8400-e29b-41d4-a716-446655440001",
+					PatternID:     "750e8400-e29b-41d4-a716-446655440001",
+					StepNumber:    3,
+					IntervalDays:  3,
+					Version:       1,
+				},
+			},
+		},
+		{
+			name:      "バージョン2のステップを取得（1件）",
+			patternID: "750e8400-e29b-41d4-a716-446655440001",
+			version:   2,
+			userID:    "550e8400-e29b-41d4-a716-446655440001",
+			want: []patternDomain.PatternStep{
+				{
+					PatternStepID: "850e8400-e29b-41d4-a716-446655440200",
+					UserID:        "550e8400-e29b-41d4-a716-446655440001",
+					PatternID:     "750e8400-e29b-41d4-a716-446655440001",
+					StepNumber:    1,
+					IntervalDays:  2,
+					Version:       2,
+				},
+			},
+		},
+		{
+			name:      "存在しないバージョンの場合は空",
+			patternID: "750e8400-e29b-41d4-a716-446655440001",
+			version:   3,
+			userID:    "550e8400-e29b-41d4-a716-446655440001",
+			want:      []patternDomain.PatternStep{},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			steps, err := repo.GetPatternStepsByPatternVersion(ctx, tc.patternID, tc.version, tc.userID)
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			// パターンステップのスライスを作成してポインタを外す
+			stepsSlice := make([]patternDomain.PatternStep, len(steps))
+			for i, step := range steps {
+				stepsSlice[i] = *step
+			}
+
+			// 期待値との比較
+			if diff := cmp.Diff(tc.want, stepsSlice); diff != "" {
+				t.Errorf("GetPatternStepsByPatternVersion() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// 現在のステップ取得はパターン本体のバージョンのステップだけを返す
+	currentSteps, err := repo.GetAllPatternStepsByPatternID(ctx, "750e8400-e29b-41d4-a716-446655440001", "550e8400-e29b-41d4-a716-446655440001")
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if len(currentSteps) != 3 {
+		t.Errorf("現在のステップ数: %d, want 3", len(currentSteps))
+	}
+}
+
 func TestPatternRepository_GetPatternTargetWeightsByPatternIDs(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
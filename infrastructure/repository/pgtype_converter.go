@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -12,3 +14,12 @@ func toUUID(s string) (pgtype.UUID, error) {
 	}
 	return pgtype.UUID{Bytes: u, Valid: true}, nil
 }
+
+// NULLの日付はnilにする
+func fromNullableDate(d pgtype.Date) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	t := d.Time
+	return &t
+}
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	tagDomain "github.com/minminseo/recall-setter/domain/tag"
+	"github.com/minminseo/recall-setter/infrastructure/db"
+	"github.com/minminseo/recall-setter/infrastructure/db/dbgen"
+)
+
+type tagRepository struct{}
+
+func NewTagRepository() tagDomain.ITagRepository {
+	return &tagRepository{}
+}
+
+func (r *tagRepository) Create(ctx context.Context, tag *tagDomain.Tag) error {
+	q := db.GetQuery(ctx)
+
+	pgID, err := toUUID(tag.ID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(tag.UserID)
+	if err != nil {
+		return err
+	}
+
+	params := dbgen.CreateTagParams{
+		ID:           pgID,
+		UserID:       pgUserID,
+		Name:         tag.Name,
+		RegisteredAt: pgtype.Timestamptz{Time: tag.RegisteredAt, Valid: true},
+		EditedAt:     pgtype.Timestamptz{Time: tag.EditedAt, Valid: true},
+	}
+	return q.CreateTag(ctx, params)
+}
+
+func (r *tagRepository) GetAllByUserID(ctx context.Context, userID string) ([]*tagDomain.Tag, error) {
+	q := db.GetQuery(ctx)
+
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.GetAllTagsByUserID(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*tagDomain.Tag, len(rows))
+	for i, row := range rows {
+		t, err := tagDomain.ReconstructTag(
+			uuid.UUID(row.ID.Bytes).String(),
+			uuid.UUID(row.UserID.Bytes).String(),
+			row.Name,
+			row.RegisteredAt.Time,
+			row.EditedAt.Time,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tags[i] = t
+	}
+	return tags, nil
+}
+
+func (r *tagRepository) Update(ctx context.Context, tag *tagDomain.Tag) error {
+	q := db.GetQuery(ctx)
+
+	pgID, err := toUUID(tag.ID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(tag.UserID)
+	if err != nil {
+		return err
+	}
+
+	params := dbgen.UpdateTagParams{
+		Name:     tag.Name,
+		EditedAt: pgtype.Timestamptz{Time: tag.EditedAt, Valid: true},
+		ID:       pgID,
+		UserID:   pgUserID,
+	}
+	return q.UpdateTag(ctx, params)
+}
+
+func (r *tagRepository) Delete(ctx context.Context, tagID string, userID string) error {
+	q := db.GetQuery(ctx)
+
+	pgID, err := toUUID(tagID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return err
+	}
+
+	params := dbgen.DeleteTagParams{
+		ID:     pgID,
+		UserID: pgUserID,
+	}
+	return q.DeleteTag(ctx, params)
+}
+
+func (r *tagRepository) ExistsItemByID(ctx context.Context, itemID string, userID string) (bool, error) {
+	q := db.GetQuery(ctx)
+
+	pgItemID, err := toUUID(itemID)
+	if err != nil {
+		return false, err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	params := dbgen.ExistsItemByIDParams{
+		ItemID: pgItemID,
+		UserID: pgUserID,
+	}
+	return q.ExistsItemByID(ctx, params)
+}
+
+func (r *tagRepository) GetTagsByItemID(ctx context.Context, itemID string, userID string) ([]*tagDomain.Tag, error) {
+	q := db.GetQuery(ctx)
+
+	pgItemID, err := toUUID(itemID)
+	if err != nil {
+		return nil, err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := dbgen.GetTagsByItemIDParams{
+		ItemID: pgItemID,
+		UserID: pgUserID,
+	}
+	rows, err := q.GetTagsByItemID(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*tagDomain.Tag, len(rows))
+	for i, row := range rows {
+		t, err := tagDomain.ReconstructTag(
+			uuid.UUID(row.ID.Bytes).String(),
+			uuid.UUID(row.UserID.Bytes).String(),
+			row.Name,
+			row.RegisteredAt.Time,
+			row.EditedAt.Time,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tags[i] = t
+	}
+	return tags, nil
+}
+
+func (r *tagRepository) ReplaceItemTags(ctx context.Context, itemID string, userID string, tagIDs []string) error {
+	q := db.GetQuery(ctx)
+
+	pgItemID, err := toUUID(itemID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return err
+	}
+	pgTagIDs := make([]pgtype.UUID, len(tagIDs))
+	for i, id := range tagIDs {
+		pgTagIDs[i], err = toUUID(id)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = q.DeleteItemTagsByItemID(ctx, dbgen.DeleteItemTagsByItemIDParams{
+		ItemID: pgItemID,
+		UserID: pgUserID,
+	})
+	if err != nil {
+		return err
+	}
+	if len(pgTagIDs) == 0 {
+		return nil
+	}
+
+	params := dbgen.CreateItemTagsParams{
+		ItemID: pgItemID,
+		UserID: pgUserID,
+		TagIds: pgTagIDs,
+	}
+	return q.CreateItemTags(ctx, params)
+}
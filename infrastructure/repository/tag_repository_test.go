@@ -0,0 +1,358 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	tagDomain "github.com/minminseo/recall-setter/domain/tag"
+)
+
+const (
+	testTagUserID  = "550e8400-e29b-41d4-a716-446655440001"
+	testTagItemID  = "a50e8400-e29b-41d4-a716-446655440001"
+	testTagID1     = "e50e8400-e29b-41d4-a716-446655440001"
+	testTagID2     = "e50e8400-e29b-41d4-a716-446655440002"
+	testOtherTagID = "e50e8400-e29b-41d4-a716-446655440003"
+)
+
+func tagNames(tags []*tagDomain.Tag) []string {
+	names := make([]string, len(tags))
+	for i, t := range tags {
+		names[i] = t.Name
+	}
+	return names
+}
+
+func TestTagRepository_Create(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name    string
+		tag     *tagDomain.Tag
+		wantErr bool
+	}{
+		{
+			name:    "タグ作成に成功する場合",
+			tag:     &tagDomain.Tag{ID: uuid.NewString(), UserID: testTagUserID, Name: "暗記", RegisteredAt: now, EditedAt: now},
+			wantErr: false,
+		},
+		{
+			name:    "他のユーザーと同名のタグは作成できる場合",
+			tag:     &tagDomain.Tag{ID: uuid.NewString(), UserID: testTagUserID, Name: "英単語", RegisteredAt: now, EditedAt: now},
+			wantErr: false,
+		},
+		{
+			name:    "同じユーザーに同名のタグがある場合は一意制約違反",
+			tag:     &tagDomain.Tag{ID: uuid.NewString(), UserID: testTagUserID, Name: "重要", RegisteredAt: now, EditedAt: now},
+			wantErr: true,
+		},
+		{
+			name:    "存在しないユーザーによる外部キー制約違反",
+			tag:     &tagDomain.Tag{ID: uuid.NewString(), UserID: uuid.NewString(), Name: "外部キー制約違反テスト", RegisteredAt: now, EditedAt: now},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewTagRepository()
+
+			err := repo.Create(ctx, tc.tag)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			tags, err := repo.GetAllByUserID(ctx, tc.tag.UserID)
+			if err != nil {
+				t.Fatalf("GetAllByUserID() error = %v", err)
+			}
+			for _, got := range tags {
+				if got.ID == tc.tag.ID {
+					if got.Name != tc.tag.Name {
+						t.Errorf("Name = %s, want %s", got.Name, tc.tag.Name)
+					}
+					return
+				}
+			}
+			t.Errorf("作成したタグ %s が取得できません", tc.tag.ID)
+		})
+	}
+}
+
+func TestTagRepository_GetAllByUserID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name    string
+		userID  string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "ユーザーのタグを登録順に取得する場合",
+			userID: testTagUserID,
+			want:   []string{"重要", "苦手"},
+		},
+		{
+			name:   "タグがないユーザーの場合は空",
+			userID: uuid.NewString(),
+			want:   []string{},
+		},
+		{
+			name:    "無効なUUIDの場合",
+			userID:  "invalid-uuid",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewTagRepository()
+
+			got, err := repo.GetAllByUserID(ctx, tc.userID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, tagNames(got)); diff != "" {
+				t.Errorf("GetAllByUserID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTagRepository_Update(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	ctx := GetTestContext()
+	repo := NewTagRepository()
+
+	editedAt := time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+	tag := &tagDomain.Tag{ID: testTagID1, UserID: testTagUserID, Name: "最重要", EditedAt: editedAt}
+	if err := repo.Update(ctx, tag); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	tags, err := repo.GetAllByUserID(ctx, testTagUserID)
+	if err != nil {
+		t.Fatalf("GetAllByUserID() error = %v", err)
+	}
+	if tags[0].Name != "最重要" || !tags[0].EditedAt.Equal(editedAt) {
+		t.Errorf("更新したタグ = %+v", tags[0])
+	}
+
+	// 同じユーザーの他のタグと同名には更新できない
+	tag.Name = "苦手"
+	if err := repo.Update(ctx, tag); err == nil {
+		t.Error("エラーが発生するはずですが、発生しませんでした")
+	}
+}
+
+func TestTagRepository_Delete(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	ctx := GetTestContext()
+	repo := NewTagRepository()
+
+	if err := repo.Delete(ctx, testTagID1, testTagUserID); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	tags, err := repo.GetAllByUserID(ctx, testTagUserID)
+	if err != nil {
+		t.Fatalf("GetAllByUserID() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"苦手"}, tagNames(tags)); diff != "" {
+		t.Errorf("削除後のタグ mismatch (-want +got):\n%s", diff)
+	}
+
+	// タグを削除すると復習物との紐付けも消える
+	itemTags, err := repo.GetTagsByItemID(ctx, testTagItemID, testTagUserID)
+	if err != nil {
+		t.Fatalf("GetTagsByItemID() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"苦手"}, tagNames(itemTags)); diff != "" {
+		t.Errorf("削除後の復習物のタグ mismatch (-want +got):\n%s", diff)
+	}
+
+	// 他のユーザーのタグは削除されない
+	if err := repo.Delete(ctx, testOtherTagID, testTagUserID); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	otherTags, err := repo.GetAllByUserID(ctx, "550e8400-e29b-41d4-a716-446655440002")
+	if err != nil {
+		t.Fatalf("GetAllByUserID() error = %v", err)
+	}
+	if len(otherTags) != 1 {
+		t.Errorf("他のユーザーのタグ数 = %d, want 1", len(otherTags))
+	}
+}
+
+func TestTagRepository_ExistsItemByID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name    string
+		itemID  string
+		userID  string
+		want    bool
+		wantErr bool
+	}{
+		{name: "ユーザーの復習物の場合", itemID: testTagItemID, userID: testTagUserID, want: true},
+		{name: "他のユーザーの復習物の場合", itemID: "a50e8400-e29b-41d4-a716-446655440004", userID: testTagUserID, want: false},
+		{name: "無効なUUIDの場合", itemID: "invalid-uuid", userID: testTagUserID, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewTagRepository()
+
+			got, err := repo.ExistsItemByID(ctx, tc.itemID, tc.userID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+			if got != tc.want {
+				t.Errorf("ExistsItemByID() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTagRepository_ReplaceItemTags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	tests := []struct {
+		name    string
+		itemID  string
+		userID  string
+		tagIDs  []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "タグを付け直す場合",
+			itemID: testTagItemID,
+			userID: testTagUserID,
+			tagIDs: []string{testTagID2},
+			want:   []string{"苦手"},
+		},
+		{
+			name:   "タグを全て外す場合",
+			itemID: testTagItemID,
+			userID: testTagUserID,
+			tagIDs: []string{},
+			want:   []string{},
+		},
+		{
+			name:   "他のユーザーのタグは付かない場合",
+			itemID: testTagItemID,
+			userID: testTagUserID,
+			tagIDs: []string{testTagID1, testOtherTagID},
+			want:   []string{"重要"},
+		},
+		{
+			name:    "無効なUUIDの場合",
+			itemID:  testTagItemID,
+			userID:  testTagUserID,
+			tagIDs:  []string{"invalid-uuid"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			PrepareTestDatabase(t)
+			defer CleanupTestDatabase(t)
+
+			ctx := GetTestContext()
+			repo := NewTagRepository()
+
+			err := repo.ReplaceItemTags(ctx, tc.itemID, tc.userID, tc.tagIDs)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			got, err := repo.GetTagsByItemID(ctx, tc.itemID, tc.userID)
+			if err != nil {
+				t.Fatalf("GetTagsByItemID() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.want, tagNames(got)); diff != "" {
+				t.Errorf("ReplaceItemTags() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -158,3 +158,174 @@ func (r *userRepository) UpdateVerifiedAt(ctx context.Context, verifiedAt *time.
 	}
 	return q.UpdateVerifiedAt(ctx, params)
 }
+
+func (r *userRepository) GetRestDaysByUserID(ctx context.Context, userID string) (*userDomain.RestDays, error) {
+	q := db.GetQuery(ctx)
+
+	pgID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	weekdayRows, err := q.GetRestWeekdaysByUserID(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	weekdays := make([]time.Weekday, len(weekdayRows))
+	for i, wd := range weekdayRows {
+		weekdays[i] = time.Weekday(wd)
+	}
+
+	dateRows, err := q.GetRestDatesByUserID(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	dates := make([]time.Time, 0, len(dateRows))
+	for _, d := range dateRows {
+		if d.Valid {
+			dates = append(dates, d.Time)
+		}
+	}
+
+	return userDomain.ReconstructRestDays(userID, weekdays, dates)
+}
+
+// 休息日の曜日を更新し、休息日の日付は全て入れ替える
+func (r *userRepository) UpdateRestDays(ctx context.Context, restDays *userDomain.RestDays) error {
+	q := db.GetQuery(ctx)
+
+	pgID, err := toUUID(restDays.UserID)
+	if err != nil {
+		return err
+	}
+
+	weekdays := make([]int16, len(restDays.Weekdays))
+	for i, wd := range restDays.Weekdays {
+		weekdays[i] = int16(wd)
+	}
+	if err := q.UpdateRestWeekdays(ctx, dbgen.UpdateRestWeekdaysParams{
+		RestWeekdays: weekdays,
+		ID:           pgID,
+	}); err != nil {
+		return err
+	}
+
+	if err := q.DeleteRestDatesByUserID(ctx, pgID); err != nil {
+		return err
+	}
+	if len(restDays.Dates) == 0 {
+		return nil
+	}
+
+	dates := make([]pgtype.Date, len(restDays.Dates))
+	for i, d := range restDays.Dates {
+		dates[i] = pgtype.Date{Time: d, Valid: true}
+	}
+	return q.CreateRestDates(ctx, dbgen.CreateRestDatesParams{
+		UserID:    pgID,
+		RestDates: dates,
+	})
+}
+
+func (r *userRepository) GetReviewLimitByUserID(ctx context.Context, userID string) (*userDomain.ReviewLimit, error) {
+	q := db.GetQuery(ctx)
+
+	pgID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	maxReviewsPerDay, err := q.GetMaxReviewsPerDayByUserID(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	return userDomain.ReconstructReviewLimit(userID, int(maxReviewsPerDay))
+}
+
+func (r *userRepository) UpdateReviewLimit(ctx context.Context, reviewLimit *userDomain.ReviewLimit) error {
+	q := db.GetQuery(ctx)
+
+	pgID, err := toUUID(reviewLimit.UserID)
+	if err != nil {
+		return err
+	}
+
+	return q.UpdateMaxReviewsPerDay(ctx, dbgen.UpdateMaxReviewsPerDayParams{
+		MaxReviewsPerDay: int32(reviewLimit.MaxReviewsPerDay),
+		ID:               pgID,
+	})
+}
+
+func (r *userRepository) CreateVacation(ctx context.Context, vacation *userDomain.Vacation) error {
+	q := db.GetQuery(ctx)
+
+	pgID, err := toUUID(vacation.VacationID)
+	if err != nil {
+		return err
+	}
+	pgUserID, err := toUUID(vacation.UserID)
+	if err != nil {
+		return err
+	}
+
+	return q.CreateVacation(ctx, dbgen.CreateVacationParams{
+		ID:        pgID,
+		UserID:    pgUserID,
+		StartDate: pgtype.Date{Time: vacation.StartDate, Valid: true},
+		EndDate:   pgtype.Date{Time: vacation.EndDate, Valid: true},
+	})
+}
+
+func (r *userRepository) HasOverlappingVacation(ctx context.Context, userID string, startDate, endDate time.Time) (bool, error) {
+	q := db.GetQuery(ctx)
+
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	return q.HasOverlappingVacation(ctx, dbgen.HasOverlappingVacationParams{
+		UserID:    pgUserID,
+		EndDate:   pgtype.Date{Time: endDate, Valid: true},
+		StartDate: pgtype.Date{Time: startDate, Valid: true},
+	})
+}
+
+// キーは"2006-01-02"形式の日付
+func (r *userRepository) CountIncompleteReviewDatesFromDate(ctx context.Context, userID string, fromDate time.Time) (map[string]int, error) {
+	q := db.GetQuery(ctx)
+
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.CountIncompleteReviewDatesFromDate(ctx, dbgen.CountIncompleteReviewDatesFromDateParams{
+		UserID:   pgUserID,
+		FromDate: pgtype.Date{Time: fromDate, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.ScheduledDate.Time.Format("2006-01-02")] = int(row.Count)
+	}
+	return counts, nil
+}
+
+func (r *userRepository) ShiftIncompleteReviewDatesFromDate(ctx context.Context, userID string, fromDate time.Time, days int) error {
+	q := db.GetQuery(ctx)
+
+	pgUserID, err := toUUID(userID)
+	if err != nil {
+		return err
+	}
+
+	return q.ShiftIncompleteReviewDatesFromDate(ctx, dbgen.ShiftIncompleteReviewDatesFromDateParams{
+		Days:     int32(days), // #nosec G115
+		UserID:   pgUserID,
+		FromDate: pgtype.Date{Time: fromDate, Valid: true},
+	})
+}
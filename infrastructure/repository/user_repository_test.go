@@ -161,6 +161,64 @@ func TestUserRepository_FindByEmailSearchKey(t *testing.T) {
 	}
 }
 
+func TestUserRepository_GetSettingByID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	tests := []struct {
+		name    string
+		userID  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "東京のユーザー",
+			userID: "550e8400-e29b-41d4-a716-446655440001",
+			want:   "Asia/Tokyo",
+		},
+		{
+			name:   "ニューヨークのユーザー",
+			userID: "550e8400-e29b-41d4-a716-446655440002",
+			want:   "America/New_York",
+		},
+		{
+			name:    "存在しないユーザー",
+			userID:  "550e8400-e29b-41d4-a716-446655440999",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewUserRepository()
+
+			got, err := repo.GetSettingByID(ctx, tc.userID)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if got.Timezone != tc.want {
+				t.Errorf("GetSettingByID().Timezone = %s, want %s", got.Timezone, tc.want)
+			}
+		})
+	}
+}
+
 func TestUserRepository_Update(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -376,3 +434,224 @@ func TestUserRepository_UpdateVerifiedAt(t *testing.T) {
 		})
 	}
 }
+
+func TestUserRepository_UpdateRestDays(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	userID := "550e8400-e29b-41d4-a716-446655440001"
+
+	tests := []struct {
+		name     string
+		restDays *userDomain.RestDays
+		want     *userDomain.RestDays
+	}{
+		{
+			name: "曜日と日付を登録する場合",
+			restDays: &userDomain.RestDays{
+				UserID:   userID,
+				Weekdays: []time.Weekday{time.Sunday, time.Saturday},
+				Dates: []time.Time{
+					time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC),
+					time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			// 日付は昇順で取得される
+			want: &userDomain.RestDays{
+				UserID:   userID,
+				Weekdays: []time.Weekday{time.Sunday, time.Saturday},
+				Dates: []time.Time{
+					time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC),
+					time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "休息日を全て解除する場合",
+			restDays: &userDomain.RestDays{
+				UserID:   userID,
+				Weekdays: []time.Weekday{},
+				Dates:    []time.Time{},
+			},
+			want: &userDomain.RestDays{
+				UserID:   userID,
+				Weekdays: []time.Weekday{},
+				Dates:    []time.Time{},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewUserRepository()
+
+			if err := repo.UpdateRestDays(ctx, tc.restDays); err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			got, err := repo.GetRestDaysByUserID(ctx, userID)
+			if err != nil {
+				t.Errorf("failed to get rest days: %v", err)
+				return
+			}
+
+			// 期待値との比較
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("UpdateRestDays() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserRepository_UpdateReviewLimit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	userID := "550e8400-e29b-41d4-a716-446655440001"
+
+	tests := []struct {
+		name        string
+		reviewLimit *userDomain.ReviewLimit
+		want        *userDomain.ReviewLimit
+	}{
+		{
+			name:        "上限を設定する場合",
+			reviewLimit: &userDomain.ReviewLimit{UserID: userID, MaxReviewsPerDay: 40},
+			want:        &userDomain.ReviewLimit{UserID: userID, MaxReviewsPerDay: 40},
+		},
+		{
+			name:        "上限を解除する場合",
+			reviewLimit: &userDomain.ReviewLimit{UserID: userID, MaxReviewsPerDay: 0},
+			want:        &userDomain.ReviewLimit{UserID: userID, MaxReviewsPerDay: 0},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := GetTestContext()
+			repo := NewUserRepository()
+
+			if err := repo.UpdateReviewLimit(ctx, tc.reviewLimit); err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			got, err := repo.GetReviewLimitByUserID(ctx, userID)
+			if err != nil {
+				t.Errorf("failed to get review limit: %v", err)
+				return
+			}
+
+			// 期待値との比較
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("UpdateReviewLimit() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserRepository_CreateVacation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	ctx := GetTestContext()
+	repo := NewUserRepository()
+	userID := "550e8400-e29b-41d4-a716-446655440001"
+
+	vacation := &userDomain.Vacation{
+		VacationID: uuid.New().String(),
+		UserID:     userID,
+		StartDate:  time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+	}
+	if err := repo.CreateVacation(ctx, vacation); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		startDate time.Time
+		endDate   time.Time
+		want      bool
+	}{
+		{
+			name:      "期間が重なる場合",
+			startDate: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			want:      true,
+		},
+		{
+			name:      "期間が重ならない場合",
+			startDate: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			want:      false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := repo.HasOverlappingVacation(ctx, userID, tc.startDate, tc.endDate)
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+			if got != tc.want {
+				t.Errorf("HasOverlappingVacation() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUserRepository_ShiftIncompleteReviewDatesFromDate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	PrepareTestDatabase(t)
+	defer CleanupTestDatabase(t)
+
+	ctx := GetTestContext()
+	repo := NewUserRepository()
+	userID := "550e8400-e29b-41d4-a716-446655440001"
+	fromDate := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	before, err := repo.CountIncompleteReviewDatesFromDate(ctx, userID, fromDate)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	// 完了済みの2024-01-03と、指定日より前の2024-01-02は含まない
+	wantBefore := map[string]int{"2024-01-04": 1, "2024-01-06": 1}
+	if diff := cmp.Diff(wantBefore, before); diff != "" {
+		t.Errorf("CountIncompleteReviewDatesFromDate() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := repo.ShiftIncompleteReviewDatesFromDate(ctx, userID, fromDate, 3); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	after, err := repo.CountIncompleteReviewDatesFromDate(ctx, userID, fromDate)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	wantAfter := map[string]int{"2024-01-07": 1, "2024-01-09": 1}
+	if diff := cmp.Diff(wantAfter, after); diff != "" {
+		t.Errorf("ShiftIncompleteReviewDatesFromDate() mismatch (-want +got):\n%s", diff)
+	}
+}
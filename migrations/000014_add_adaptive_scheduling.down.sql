@@ -0,0 +1,7 @@
+ALTER TABLE review_items
+    DROP COLUMN IF EXISTS ease_factor;
+
+ALTER TABLE review_patterns
+    DROP COLUMN IF EXISTS scheduler_kind;
+
+DROP TYPE IF EXISTS scheduler_kind_enum;
@@ -0,0 +1,9 @@
+-- 復習パターン毎のスケジューリング方式（固定ステップ or 想起度に応じた適応型）
+CREATE TYPE scheduler_kind_enum AS ENUM ('fixed_steps', 'adaptive');
+
+ALTER TABLE review_patterns
+    ADD COLUMN scheduler_kind scheduler_kind_enum NOT NULL DEFAULT 'fixed_steps';
+
+-- 適応型（SM-2）で使う復習物毎の易しさ係数
+ALTER TABLE review_items
+    ADD COLUMN ease_factor DOUBLE PRECISION NOT NULL DEFAULT 2.5;
@@ -0,0 +1,22 @@
+ALTER TABLE review_items
+    DROP COLUMN IF EXISTS stability,
+    DROP COLUMN IF EXISTS difficulty;
+
+ALTER TABLE review_patterns
+    DROP COLUMN IF EXISTS target_retention;
+
+-- enumから値を削除できないため、'fsrs'を含まない型を作り直す
+UPDATE review_patterns SET scheduler_kind = 'fixed_steps' WHERE scheduler_kind = 'fsrs';
+
+ALTER TABLE review_patterns
+    ALTER COLUMN scheduler_kind DROP DEFAULT;
+
+ALTER TYPE scheduler_kind_enum RENAME TO scheduler_kind_enum_old;
+
+CREATE TYPE scheduler_kind_enum AS ENUM ('fixed_steps', 'adaptive');
+
+ALTER TABLE review_patterns
+    ALTER COLUMN scheduler_kind TYPE scheduler_kind_enum USING scheduler_kind::text::scheduler_kind_enum,
+    ALTER COLUMN scheduler_kind SET DEFAULT 'fixed_steps';
+
+DROP TYPE scheduler_kind_enum_old;
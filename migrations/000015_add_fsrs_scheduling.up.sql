@@ -0,0 +1,11 @@
+-- 目標記憶保持率から復習間隔を決めるFSRS方式を追加
+ALTER TYPE scheduler_kind_enum ADD VALUE IF NOT EXISTS 'fsrs';
+
+-- FSRSで目標とする記憶保持率（0.9なら想起できる確率が90%まで下がった時点で復習する）
+ALTER TABLE review_patterns
+    ADD COLUMN target_retention DOUBLE PRECISION NOT NULL DEFAULT 0.9;
+
+-- FSRSで使う復習物毎の記憶の安定度と難しさ（0は未初期化）
+ALTER TABLE review_items
+    ADD COLUMN stability DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN difficulty DOUBLE PRECISION NOT NULL DEFAULT 0;
@@ -0,0 +1,15 @@
+-- 想起に失敗したら最初のステップからやり直すライトナー方式を追加
+ALTER TYPE scheduler_kind_enum ADD VALUE IF NOT EXISTS 'leitner';
+
+-- 復習日に想起失敗した記録（ライトナー方式では失敗した復習日も含めて復習日を作り直すため、別テーブルに残す）
+CREATE TABLE review_failures (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    item_id UUID NOT NULL REFERENCES review_items(id) ON DELETE CASCADE,
+    step_number SMALLINT NOT NULL,
+    scheduled_date DATE NOT NULL,
+    failed_date DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_review_failures_item_id ON review_failures (item_id);
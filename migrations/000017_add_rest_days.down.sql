@@ -0,0 +1,6 @@
+DROP FUNCTION IF EXISTS next_available_review_date(UUID, DATE);
+
+DROP TABLE IF EXISTS user_rest_dates;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS rest_weekdays;
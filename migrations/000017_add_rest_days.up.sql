@@ -0,0 +1,35 @@
+-- ユーザー毎の休息日（復習日を置かない曜日。0:日曜日〜6:土曜日）
+ALTER TABLE users
+    ADD COLUMN rest_weekdays SMALLINT[] NOT NULL DEFAULT '{}';
+
+-- ユーザー毎の休息日（祝日など特定の日付）
+CREATE TABLE user_rest_dates (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rest_date DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, rest_date)
+);
+
+-- 指定日がユーザーの休息日なら、休息日でない次の日を返す（バッチでの期限切れ復習日のスライドで使う）
+CREATE FUNCTION next_available_review_date(p_user_id UUID, p_date DATE)
+RETURNS DATE AS $$
+DECLARE
+    v_weekdays SMALLINT[];
+    v_date DATE := p_date;
+BEGIN
+    SELECT rest_weekdays INTO v_weekdays FROM users WHERE id = p_user_id;
+
+    -- 全ての曜日を休息日にはできないため、1年以内に必ず見つかる
+    FOR i IN 1..366 LOOP
+        IF NOT (EXTRACT(DOW FROM v_date)::SMALLINT = ANY(COALESCE(v_weekdays, '{}')))
+           AND NOT EXISTS (
+               SELECT 1 FROM user_rest_dates WHERE user_id = p_user_id AND rest_date = v_date
+           ) THEN
+            RETURN v_date;
+        END IF;
+        v_date := v_date + 1;
+    END LOOP;
+
+    RETURN p_date;
+END;
+$$ LANGUAGE plpgsql STABLE;
@@ -0,0 +1,3 @@
+-- ユーザー毎の1日の最大復習数（0は上限なし）
+ALTER TABLE users
+    ADD COLUMN max_reviews_per_day INTEGER NOT NULL DEFAULT 0 CHECK (max_reviews_per_day >= 0);
@@ -0,0 +1,3 @@
+-- 復習パターン毎に、復習物IDをシードにした揺らぎを復習日間隔に加えるかどうか
+ALTER TABLE review_patterns
+    ADD COLUMN interval_fuzz BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,5 @@
+ALTER TABLE review_patterns
+    DROP COLUMN IF EXISTS overdue_spread_days,
+    DROP COLUMN IF EXISTS overdue_policy;
+
+DROP TYPE IF EXISTS overdue_policy_enum;
@@ -0,0 +1,10 @@
+-- 期限切れの復習日の扱い方（バッチで復習パターン毎に切り替える）
+-- slide_all: 期限切れの復習日以降を全て今日に合わせてずらす（従来の挙動）
+-- slide_overdue: 期限切れの復習日だけを今日にずらす
+-- keep: ずらさずに期限切れのまま残す
+-- spread: 溜まった期限切れの復習物を今日からN日間に振り分けてずらす
+CREATE TYPE overdue_policy_enum AS ENUM ('slide_all', 'slide_overdue', 'keep', 'spread');
+
+ALTER TABLE review_patterns
+    ADD COLUMN overdue_policy overdue_policy_enum NOT NULL DEFAULT 'slide_all',
+    ADD COLUMN overdue_spread_days SMALLINT NOT NULL DEFAULT 7 CHECK (overdue_spread_days BETWEEN 1 AND 30);
@@ -0,0 +1,11 @@
+-- ユーザーの休暇（期間中はバッチで期限切れの復習日をずらさない）
+CREATE TABLE user_vacations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (start_date <= end_date)
+);
+
+CREATE INDEX idx_user_vacations_user_id ON user_vacations (user_id);
@@ -0,0 +1,20 @@
+ALTER TABLE review_items
+    DROP COLUMN IF EXISTS pattern_version;
+
+-- 最新でないバージョンのステップを削除してから一意制約を元に戻す
+DELETE FROM pattern_steps ps
+USING review_patterns rp
+WHERE ps.pattern_id = rp.id
+AND ps.version <> rp.version;
+
+ALTER TABLE pattern_steps
+    DROP CONSTRAINT IF EXISTS pattern_steps_pattern_id_version_step_number_key;
+
+ALTER TABLE pattern_steps
+    ADD CONSTRAINT pattern_steps_pattern_id_step_number_key UNIQUE (pattern_id, step_number);
+
+ALTER TABLE pattern_steps
+    DROP COLUMN IF EXISTS version;
+
+ALTER TABLE review_patterns
+    DROP COLUMN IF EXISTS version;
@@ -0,0 +1,18 @@
+-- 復習パターンのバージョン（復習物が紐づいているパターンのステップを変更する度に新しいバージョンを作り、古いバージョンのステップは残す）
+ALTER TABLE review_patterns
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1 CHECK (version > 0);
+
+ALTER TABLE pattern_steps
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1 CHECK (version > 0);
+
+ALTER TABLE pattern_steps
+    DROP CONSTRAINT IF EXISTS pattern_steps_pattern_id_step_number_key;
+
+ALTER TABLE pattern_steps
+    ADD CONSTRAINT pattern_steps_pattern_id_version_step_number_key UNIQUE (pattern_id, version, step_number);
+
+-- 復習物の復習日を計算した時点の復習パターンのバージョン（復習パターンがない場合はNULL）
+ALTER TABLE review_items
+    ADD COLUMN pattern_version INTEGER;
+
+UPDATE review_items SET pattern_version = 1 WHERE pattern_id IS NOT NULL;
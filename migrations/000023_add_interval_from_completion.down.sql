@@ -0,0 +1,5 @@
+ALTER TABLE review_dates
+    DROP COLUMN IF EXISTS completed_date;
+
+ALTER TABLE review_patterns
+    DROP COLUMN IF EXISTS interval_from_completion;
@@ -0,0 +1,7 @@
+-- 復習パターン毎に、各ステップの間隔を学習日ではなく直前の復習を実際に完了した日から数えるかどうか
+ALTER TABLE review_patterns
+    ADD COLUMN interval_from_completion BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- 復習日を完了した日（未完了の場合はNULL）
+ALTER TABLE review_dates
+    ADD COLUMN completed_date DATE;
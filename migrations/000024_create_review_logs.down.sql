@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS review_logs;
+
+DROP TYPE IF EXISTS review_outcome_enum;
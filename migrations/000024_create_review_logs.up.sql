@@ -0,0 +1,18 @@
+CREATE TYPE review_outcome_enum AS ENUM ('completed', 'uncompleted');
+
+-- 復習日を完了・未完了にした履歴（復習日を作り直しても履歴は残すため、復習日が削除されたらreview_date_idをNULLにする）
+CREATE TABLE review_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    item_id UUID NOT NULL REFERENCES review_items(id) ON DELETE CASCADE,
+    review_date_id UUID REFERENCES review_dates(id) ON DELETE SET NULL,
+    step_number SMALLINT NOT NULL,
+    outcome review_outcome_enum NOT NULL,
+    grade SMALLINT CHECK (grade BETWEEN 0 AND 5),
+    duration_seconds INTEGER CHECK (duration_seconds >= 0),
+    reviewed_date DATE NOT NULL,
+    reviewed_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_review_logs_item_id_reviewed_at ON review_logs (item_id, reviewed_at);
@@ -0,0 +1,2 @@
+ALTER TABLE review_items
+    DROP COLUMN IF EXISTS slip_count;
@@ -0,0 +1,3 @@
+-- 期限切れのバッチで復習日をずらされた回数（想起に失敗した回数はreview_failuresから数える）
+ALTER TABLE review_items
+    ADD COLUMN slip_count INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS item_operation_snapshots;
+
+DROP TYPE IF EXISTS item_operation_kind_enum;
@@ -0,0 +1,31 @@
+CREATE TYPE item_operation_kind_enum AS ENUM (
+    'create_item',
+    'update_item',
+    'delete_item',
+    'update_review_dates',
+    'complete_review_date',
+    'incomplete_review_date',
+    'fail_review_date',
+    'finish_item',
+    'unfinish_item',
+    'upgrade_item_pattern'
+);
+
+-- 操作を取り消すために、操作の直前の復習物と復習日の行をそのまま保存する
+-- 1回の操作で複数の復習物を変更した場合は、同じoperation_idで復習物毎に1行ずつ保存する
+CREATE TABLE item_operation_snapshots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    operation_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- 削除した復習物も元に戻せるように、review_itemsへの外部キーは張らない
+    item_id UUID NOT NULL,
+    kind item_operation_kind_enum NOT NULL,
+    -- 操作の前に復習物がなかった（作成した）場合はNULL
+    item_snapshot JSONB,
+    review_dates_snapshot JSONB NOT NULL DEFAULT '[]',
+    operated_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_item_operation_snapshots_user_id_operated_at ON item_operation_snapshots (user_id, operated_at);
+CREATE INDEX idx_item_operation_snapshots_operation_id ON item_operation_snapshots (operation_id);
@@ -0,0 +1,22 @@
+-- enumから値を削除できないため、'snooze_review_date'を含まない型を作り直す
+DELETE FROM item_operation_snapshots WHERE kind = 'snooze_review_date';
+
+ALTER TYPE item_operation_kind_enum RENAME TO item_operation_kind_enum_old;
+
+CREATE TYPE item_operation_kind_enum AS ENUM (
+    'create_item',
+    'update_item',
+    'delete_item',
+    'update_review_dates',
+    'complete_review_date',
+    'incomplete_review_date',
+    'fail_review_date',
+    'finish_item',
+    'unfinish_item',
+    'upgrade_item_pattern'
+);
+
+ALTER TABLE item_operation_snapshots
+    ALTER COLUMN kind TYPE item_operation_kind_enum USING kind::text::item_operation_kind_enum;
+
+DROP TYPE item_operation_kind_enum_old;
@@ -0,0 +1,2 @@
+-- 復習日の先送りも取り消せるように操作の種類に追加する
+ALTER TYPE item_operation_kind_enum ADD VALUE IF NOT EXISTS 'snooze_review_date';
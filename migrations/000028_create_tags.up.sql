@@ -0,0 +1,26 @@
+CREATE TABLE tags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    registered_at TIMESTAMPTZ NOT NULL,
+    edited_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- タグ名はユーザー毎に一意
+    CONSTRAINT uq_tags_user_id_name UNIQUE (user_id, name)
+);
+
+-- 復習物とタグの多対多の紐付け（復習物・タグのどちらを削除しても紐付けは消える）
+CREATE TABLE review_item_tags (
+    item_id UUID NOT NULL REFERENCES review_items(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (item_id, tag_id)
+);
+
+CREATE INDEX idx_review_item_tags_tag_id ON review_item_tags (tag_id);
+
+DROP TRIGGER IF EXISTS trigger_set_updated_at ON tags;
+CREATE TRIGGER trigger_set_updated_at
+    BEFORE UPDATE ON tags
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
@@ -0,0 +1,2 @@
+ALTER TABLE review_dates
+    DROP COLUMN IF EXISTS original_scheduled_date;
@@ -0,0 +1,20 @@
+-- 復習日を作成した時点の予定日。期限切れのずらしや想起度による再計算でも変更せず、完了率と遅れの集計に使う
+ALTER TABLE review_dates
+    ADD COLUMN original_scheduled_date DATE;
+
+UPDATE review_dates
+SET
+    original_scheduled_date = initial_scheduled_date;
+
+ALTER TABLE review_dates
+    ALTER COLUMN original_scheduled_date SET NOT NULL;
+
+-- 取り消し用に保存済みの復習日の行にも同じ値を入れておく（元に戻す時にNOT NULL制約に違反しないように）
+UPDATE item_operation_snapshots
+SET
+    review_dates_snapshot = (
+        SELECT
+            COALESCE(jsonb_agg(e.d || jsonb_build_object('original_scheduled_date', e.d->'initial_scheduled_date') ORDER BY e.i), '[]'::jsonb)
+        FROM
+            jsonb_array_elements(review_dates_snapshot) WITH ORDINALITY AS e(d, i)
+    );
@@ -0,0 +1,4 @@
+ALTER TABLE item_operation_snapshots
+    DROP COLUMN IF EXISTS item_tags_snapshot,
+    DROP COLUMN IF EXISTS review_failures_snapshot,
+    DROP COLUMN IF EXISTS review_logs_snapshot;
@@ -0,0 +1,5 @@
+-- 復習物を削除すると履歴・想起失敗・タグの紐付けもカスケードで消えるため、取り消しで戻せるように操作の直前の行を保存する
+ALTER TABLE item_operation_snapshots
+    ADD COLUMN review_logs_snapshot JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN review_failures_snapshot JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN item_tags_snapshot JSONB NOT NULL DEFAULT '[]';
@@ -12,6 +12,7 @@ import (
 	itemController "github.com/minminseo/recall-setter/controller/item"
 
 	patternController "github.com/minminseo/recall-setter/controller/pattern"
+	tagController "github.com/minminseo/recall-setter/controller/tag"
 	userController "github.com/minminseo/recall-setter/controller/user"
 )
 
@@ -21,6 +22,7 @@ func NewRouter(
 	bc boxController.IBoxController,
 	pc patternController.IPatternController,
 	ic itemController.IItemController,
+	tc tagController.ITagController,
 ) *echo.Echo {
 	e := echo.New()
 	e.Use(middleware.Logger())
@@ -66,6 +68,12 @@ func NewRouter(
 		userGroup.GET("", uc.GetUserSetting)
 		userGroup.PUT("", uc.UpdateSetting)
 		userGroup.PUT("/password", uc.UpdatePassword)
+		userGroup.GET("/rest-days", uc.GetRestDays)
+		userGroup.PUT("/rest-days", uc.UpdateRestDays)
+		userGroup.GET("/review-limit", uc.GetReviewLimit)
+		userGroup.PUT("/review-limit", uc.UpdateReviewLimit)
+		userGroup.POST("/vacations/preview", uc.PreviewVacation)
+		userGroup.POST("/vacations", uc.CreateVacation)
 	}
 
 	// カテゴリー系
@@ -96,6 +104,18 @@ func NewRouter(
 		patternGroup.GET("", pc.GetPatterns)
 		patternGroup.PUT("/:id", pc.UpdatePattern)
 		patternGroup.DELETE("/:id", pc.DeletePattern)
+		patternGroup.GET("/presets", pc.GetPatternPresets)
+		patternGroup.POST("/presets/:key/install", pc.InstallPatternPreset)
+	}
+
+	// タグ系
+	tagGroup := e.Group("/tags")
+	tagGroup.Use(authMiddleware)
+	{
+		tagGroup.POST("", tc.CreateTag)
+		tagGroup.GET("", tc.GetTags)
+		tagGroup.PUT("/:id", tc.UpdateTag)
+		tagGroup.DELETE("/:id", tc.DeleteTag)
 	}
 
 	// 復習打つ形
@@ -104,12 +124,21 @@ func NewRouter(
 	{
 		// 復習物の作成
 		itemGroup.POST("", ic.CreateItem)
+		// 復習物の作成のプレビュー（永続化しない）
+		itemGroup.POST("/preview", ic.PreviewCreateItem)
 
 		// 復習物一覧取得系
 		itemGroup.GET("/unclassified", ic.GetAllUnFinishedUnclassifiedItemsByUserID)
 		itemGroup.GET("/:box_id", ic.GetAllUnFinishedItemsByBoxID)
 		itemGroup.GET("/unclassified/:category_id", ic.GetAllUnFinishedUnclassifiedItemsByCategoryID)
 		itemGroup.GET("/today", ic.GetAllDailyReviewDates)
+		// 今日の復習日をまとめて完了・先送り（範囲はカテゴリー・ボックス・未分類、または復習日IDで指定）
+		itemGroup.POST("/today/complete", ic.BulkCompleteReviewDates)
+		itemGroup.POST("/today/snooze", ic.BulkSnoozeReviewDates)
+		// 何度もずらされたり想起に失敗したりしている復習物（リーチ）
+		itemGroup.GET("/leeches", ic.GetLeechItems)
+		// 名前と詳細から復習物を検索（完了済み・未完了の両方が対象）
+		itemGroup.GET("/search", ic.SearchItems)
 
 		// 完了済み復習物一覧取得系
 		itemGroup.GET("/finished/unclassified", ic.GetUnclassfiedFinishedItemsByUserID)
@@ -120,9 +149,17 @@ func NewRouter(
 		itemDetailGroup := itemGroup.Group("/:item_id")
 		{
 			itemDetailGroup.PUT("", ic.UpdateItem)
+			// 復習物の更新のプレビュー（永続化しない）
+			itemDetailGroup.POST("/preview", ic.PreviewUpdateItem)
 			itemDetailGroup.DELETE("", ic.DeleteItem)
 			itemDetailGroup.PATCH("/finish", ic.UpdateItemAsFinishedForce)
 			itemDetailGroup.PATCH("/unfinish", ic.UpdateItemAsUnFinishedForce)
+			itemDetailGroup.POST("/upgrade-pattern", ic.UpgradeItemPattern)
+			// 復習日を完了・未完了にした履歴
+			itemDetailGroup.GET("/history", ic.GetItemHistory)
+			// 復習物に付けるタグ（PUTは付いているタグを丸ごと置き換える）
+			itemDetailGroup.GET("/tags", tc.GetItemTags)
+			itemDetailGroup.PUT("/tags", tc.SetItemTags)
 
 			// 特定復習物に属する復習日への操作
 			reviewDateGroup := itemDetailGroup.Group("/review-dates/:review_date_id")
@@ -132,10 +169,21 @@ func NewRouter(
 				// 復習日の完了状態を変更
 				reviewDateGroup.PATCH("/complete", ic.UpdateReviewDateAsCompleted)
 				reviewDateGroup.PATCH("/incomplete", ic.UpdateReviewDateAsInCompleted)
+				// 想起失敗（ライトナー方式では最初のステップからやり直す）
+				reviewDateGroup.PATCH("/fail", ic.UpdateReviewDateAsFailed)
+				// 後続の復習日を再計算せずに先送り（この復習日だけか、以降の未完了の復習日もまとめてずらす）
+				reviewDateGroup.PATCH("/snooze", ic.SnoozeReviewDate)
 			}
 		}
 	}
 
+	// 操作の取り消し（復習物・復習日への直近の操作を、操作前の状態に戻す）
+	undoGroup := e.Group("/undo")
+	undoGroup.Use(authMiddleware)
+	{
+		undoGroup.POST("", ic.UndoItemOperations)
+	}
+
 	// データ概要系
 	summaryGroup := e.Group("/summary")
 	summaryGroup.Use(authMiddleware)
@@ -152,6 +200,15 @@ func NewRouter(
 
 		// 今日の全復習日数を取得
 		summaryGroup.GET("/daily-reviews/count", ic.CountAllDailyReviewDates)
+
+		// 指定日から指定日数分の日毎の復習数（負荷予測）を取得
+		summaryGroup.GET("/forecast", ic.GetReviewForecast)
+
+		// 指定日から指定日数分の日毎の完了した復習数・学習した復習物数（ヒートマップ）を取得（category_id・box_id・unclassifiedで絞り込み可）
+		summaryGroup.GET("/heatmap", ic.GetActivityHeatmap)
+
+		// 連続学習日数・直近の完了率・平均の遅れを取得（今日の日付は省略するとユーザーのタイムゾーンで決める）
+		summaryGroup.GET("/stats", ic.GetReviewStats)
 	}
 
 	return e
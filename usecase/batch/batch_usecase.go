@@ -3,12 +3,15 @@ package batch
 import (
 	"context"
 	"log/slog"
+	"time"
 
+	itemDomain "github.com/minminseo/recall-setter/domain/item"
 	"github.com/minminseo/recall-setter/infrastructure/repository"
 )
 
 type IBatchUsecase interface {
 	ExecuteUpdateOverdueScheduledDates(ctx context.Context) error
+	ExecuteDeleteExpiredItemOperations(ctx context.Context) error
 }
 
 type batchUsecase struct {
@@ -31,3 +34,18 @@ func (u *batchUsecase) ExecuteUpdateOverdueScheduledDates(ctx context.Context) e
 	slog.Info("未完了復習日の更新処理が正常に完了しました。")
 	return nil
 }
+
+// 取り消せる期間を過ぎた操作の保存内容を削除する
+func (u *batchUsecase) ExecuteDeleteExpiredItemOperations(ctx context.Context) error {
+	slog.Info("取り消し期限切れの操作の削除処理を開始します。")
+
+	operatedBefore := time.Now().UTC().Add(-itemDomain.UndoWindow)
+	err := u.batchRepo.ExecuteDeleteExpiredItemOperations(ctx, operatedBefore)
+	if err != nil {
+		slog.Error("取り消し期限切れの操作の削除に失敗しました。", "error", err)
+		return err
+	}
+
+	slog.Info("取り消し期限切れの操作の削除処理が正常に完了しました。")
+	return nil
+}
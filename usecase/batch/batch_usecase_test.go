@@ -12,6 +12,8 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+
+	itemDomain "github.com/minminseo/recall-setter/domain/item"
 )
 
 type MockBatchRepository struct {
@@ -23,6 +25,11 @@ func (m *MockBatchRepository) ExecuteUpdateOverdueScheduledDates(ctx context.Con
 	return args.Error(0)
 }
 
+func (m *MockBatchRepository) ExecuteDeleteExpiredItemOperations(ctx context.Context, operatedBefore time.Time) error {
+	args := m.Called(ctx, operatedBefore)
+	return args.Error(0)
+}
+
 func TestNewBatchUsecase(t *testing.T) {
 	tests := []struct {
 		name string
@@ -259,3 +266,48 @@ func TestBatchUsecase_ExecuteUpdateOverdueScheduledDates_ErrorTypes(t *testing.T
 		})
 	}
 }
+
+func TestBatchUsecase_ExecuteDeleteExpiredItemOperations(t *testing.T) {
+	tests := []struct {
+		name    string
+		repoErr error
+		wantErr bool
+	}{
+		{
+			name:    "取り消せる期間より前の操作を削除する場合",
+			repoErr: nil,
+			wantErr: false,
+		},
+		{
+			name:    "リポジトリでエラーが発生する場合",
+			repoErr: errors.New("delete failed"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := &MockBatchRepository{}
+			usecase := NewBatchUsecase(mockRepo)
+			ctx := context.Background()
+
+			before := time.Now().UTC().Add(-itemDomain.UndoWindow)
+			mockRepo.On("ExecuteDeleteExpiredItemOperations", ctx, mock.MatchedBy(func(operatedBefore time.Time) bool {
+				// 実行時刻から取り消せる期間を引いた日時より前を削除する
+				return !operatedBefore.Before(before) && operatedBefore.Before(before.Add(time.Minute))
+			})).Return(tt.repoErr)
+
+			err := usecase.ExecuteDeleteExpiredItemOperations(ctx)
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
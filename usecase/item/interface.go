@@ -5,17 +5,28 @@ import "context"
 type IItemUsecase interface {
 	CreateItem(ctx context.Context, item CreateItemInput) (*CreateItemOutput, error)
 	UpdateItem(ctx context.Context, item UpdateItemInput) (*UpdateItemOutput, error)
+	// 作成・更新を永続化せずに、計算される復習日と即時完了になるかを返す
+	PreviewCreateItem(ctx context.Context, item CreateItemInput) (*PreviewItemScheduleOutput, error)
+	PreviewUpdateItem(ctx context.Context, item UpdateItemInput) (*PreviewItemScheduleOutput, error)
 	UpdateReviewDates(ctx context.Context, input UpdateBackReviewDateInput) (*UpdateBackReviewDateOutput, error)
 	UpdateItemAsFinishedForce(ctx context.Context, input UpdateItemAsFinishedForceInput) (*UpdateItemAsFinishedForceOutput, error)
 	UpdateReviewDateAsCompleted(ctx context.Context, input UpdateReviewDateAsCompletedInput) (*UpdateReviewDateAsCompletedOutput, error)
+	UpdateReviewDateAsFailed(ctx context.Context, input UpdateReviewDateAsFailedInput) (*UpdateReviewDateAsFailedOutput, error)
+	SnoozeReviewDate(ctx context.Context, input SnoozeReviewDateInput) (*SnoozeReviewDateOutput, error)
+	BulkCompleteReviewDates(ctx context.Context, input BulkCompleteReviewDatesInput) (*BulkCompleteReviewDatesOutput, error)
+	BulkSnoozeReviewDates(ctx context.Context, input BulkSnoozeReviewDatesInput) (*BulkSnoozeReviewDatesOutput, error)
 	UpdateReviewDateAsInCompleted(ctx context.Context, input UpdateReviewDateAsInCompletedInput) (*UpdateReviewDateAsInCompletedOutput, error)
 	UpdateItemAsUnFinishedForce(ctx context.Context, input UpdateItemAsUnFinishedForceInput) (*UpdateItemAsUnFinishedForceOutput, error)
+	// 復習物が使用する復習パターンを最新バージョンにし、未完了かつ今日以降の復習日に新しいステップを反映する
+	UpgradeItemPattern(ctx context.Context, input UpgradeItemPatternInput) (*UpgradeItemPatternOutput, error)
 	DeleteItem(ctx context.Context, itemID string, userID string) error
+	// 直近の復習物への操作を新しい順に指定した数だけまとめて取り消す
+	UndoItemOperations(ctx context.Context, input UndoItemOperationsInput) (*UndoItemOperationsOutput, error)
 
 	/* ボックス内の復習物一覧表示のための取得メソッド*/
-	GetAllUnFinishedItemsByBoxID(ctx context.Context, boxID string, userID string) ([]*GetItemOutput, error)
-	GetAllUnFinishedUnclassifiedItemsByUserID(ctx context.Context, userID string) ([]*GetItemOutput, error)
-	GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx context.Context, userID string, categoryID string) ([]*GetItemOutput, error)
+	GetAllUnFinishedItemsByBoxID(ctx context.Context, boxID string, userID string, tagID string) ([]*GetItemOutput, error)
+	GetAllUnFinishedUnclassifiedItemsByUserID(ctx context.Context, userID string, tagID string) ([]*GetItemOutput, error)
+	GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx context.Context, userID string, categoryID string, tagID string) ([]*GetItemOutput, error)
 
 	// アプリ内に存在するデータたちの概要を表示するための取得メソッド
 	// 復習物数系
@@ -24,18 +35,36 @@ type IItemUsecase interface {
 	CountUnclassifiedItemsByUserID(ctx context.Context, userID string) (int, error)
 
 	// 今日の復習物（復習日）数系
-	CountDailyDatesGroupedByBoxByUserID(ctx context.Context, userID string, today string) ([]*DailyCountGroupedByBoxOutput, error)
-	CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, userID string, today string) ([]*UnclassifiedDailyDatesCountGroupedByCategoryOutput, error)
-	CountDailyDatesUnclassifiedByUserID(ctx context.Context, userID string, today string) (int, error)
+	CountDailyDatesGroupedByBoxByUserID(ctx context.Context, userID string, today string, tagID string) ([]*DailyCountGroupedByBoxOutput, error)
+	CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, userID string, today string, tagID string) ([]*UnclassifiedDailyDatesCountGroupedByCategoryOutput, error)
+	CountDailyDatesUnclassifiedByUserID(ctx context.Context, userID string, today string, tagID string) (int, error)
 
 	// 今日の全復習日数を取得する
-	CountAllDailyReviewDates(ctx context.Context, userID string, today string) (int, error)
+	CountAllDailyReviewDates(ctx context.Context, userID string, today string, tagID string) (int, error)
 
 	// 今日の復習日一覧を取得する
-	GetAllDailyReviewDates(ctx context.Context, userID string, today string) (*GetDailyReviewDatesOutput, error)
+	GetAllDailyReviewDates(ctx context.Context, userID string, today string, limit int, order string, tagID string) (*GetDailyReviewDatesOutput, error)
+
+	// 連続学習日数・直近の完了率・平均の遅れを取得する
+	GetReviewStats(ctx context.Context, userID string, today string) (*GetReviewStatsOutput, error)
+
+	// 復習物の復習日を完了・未完了にした履歴を取得する
+	GetItemHistory(ctx context.Context, itemID string, userID string) (*GetItemHistoryOutput, error)
+
+	// ずらされた回数か想起に失敗した回数が基準以上の復習物を取得する
+	GetLeechItems(ctx context.Context, userID string) (*GetLeechItemsOutput, error)
+
+	// fromから指定日数分の日毎の復習数（負荷予測）を取得する
+	GetReviewForecast(ctx context.Context, userID string, from string, days int, tagID string) (*GetReviewForecastOutput, error)
+
+	// fromから指定日数分の日毎の活動量（完了した復習日数・学習した復習物数）を取得する
+	GetActivityHeatmap(ctx context.Context, input GetActivityHeatmapInput) (*GetActivityHeatmapOutput, error)
+
+	// 名前か詳細に検索語を含む復習物を関連度の高い順に取得する
+	SearchItems(ctx context.Context, input SearchItemsInput) (*SearchItemsOutput, error)
 
 	// 完了済み復習物を取得する系
-	GetFinishedItemsByBoxID(ctx context.Context, boxID string, userID string) ([]*GetItemOutput, error)
-	GetUnclassfiedFinishedItemsByCategoryID(ctx context.Context, userID string, categoryID string) ([]*GetItemOutput, error)
-	GetUnclassfiedFinishedItemsByUserID(ctx context.Context, userID string) ([]*GetItemOutput, error)
+	GetFinishedItemsByBoxID(ctx context.Context, boxID string, userID string, tagID string) ([]*GetItemOutput, error)
+	GetUnclassfiedFinishedItemsByCategoryID(ctx context.Context, userID string, categoryID string, tagID string) ([]*GetItemOutput, error)
+	GetUnclassfiedFinishedItemsByUserID(ctx context.Context, userID string, tagID string) ([]*GetItemOutput, error)
 }
@@ -3,6 +3,7 @@ package item
 import "time"
 
 type CreateItemInput struct {
+	ItemID                   string // 空の場合は新しく発行する。プレビューで返されたIDを渡すと、プレビューと同じ復習日で作成される
 	UserID                   string
 	CategoryID               *string
 	BoxID                    *string
@@ -25,18 +26,19 @@ type CreateReviewdateOutput struct {
 }
 
 type CreateItemOutput struct {
-	ItemID       string
-	UserID       string
-	CategoryID   *string
-	BoxID        *string
-	PatternID    *string
-	Name         string
-	Detail       string
-	LearnedDate  string
-	IsCompleted  bool
-	RegisteredAt time.Time
-	EditedAt     time.Time
-	Reviewdates  []CreateReviewdateOutput
+	ItemID         string
+	UserID         string
+	CategoryID     *string
+	BoxID          *string
+	PatternID      *string
+	PatternVersion int
+	Name           string
+	Detail         string
+	LearnedDate    string
+	IsCompleted    bool
+	RegisteredAt   time.Time
+	EditedAt       time.Time
+	Reviewdates    []CreateReviewdateOutput
 }
 
 // 分類済みボックスの復習物の更新リクエスト用のDTO
@@ -68,17 +70,49 @@ type UpdateReviewDateOutput struct {
 }
 
 type UpdateItemOutput struct {
-	ItemID      string
-	UserID      string
-	CategoryID  *string
-	BoxID       *string
-	PatternID   *string
-	Name        string
-	Detail      string
-	LearnedDate string
+	ItemID         string
+	UserID         string
+	CategoryID     *string
+	BoxID          *string
+	PatternID      *string
+	PatternVersion int
+	Name           string
+	Detail         string
+	LearnedDate    string
+	IsFinished     bool
+	EditedAt       time.Time
+	ReviewDates    []UpdateReviewDateOutput
+}
+
+// 復習物作成・更新のプレビュー（永続化せずに計算結果だけ返す）用のDTO
+type PreviewReviewDateOutput struct {
+	StepNumber           int
+	InitialScheduledDate string
+	ScheduledDate        string
+	IsCompleted          bool
+}
+
+type PreviewItemScheduleOutput struct {
+	ItemID      string // 復習日の計算に使った復習物ID
 	IsFinished  bool
-	EditedAt    time.Time
-	ReviewDates []UpdateReviewDateOutput
+	ReviewDates []PreviewReviewDateOutput
+}
+
+// 復習物を最新バージョンの復習パターンに更新するリクエスト用のDTO
+type UpgradeItemPatternInput struct {
+	ItemID string
+	UserID string
+	Today  string
+}
+
+type UpgradeItemPatternOutput struct {
+	ItemID         string
+	UserID         string
+	PatternID      string
+	PatternVersion int
+	IsFinished     bool
+	EditedAt       time.Time
+	ReviewDates    []UpdateReviewDateOutput // 復習物更新のDTO共有
 }
 
 // 復習物の途中完了（手動）リクエスト用のDTO
@@ -114,19 +148,98 @@ type UpdateItemAsUnFinishedForceOutput struct {
 }
 
 type UpdateReviewDateAsCompletedInput struct {
-	ReviewDateID string
-	UserID       string
-	ItemID       string
-	StepNumber   int
+	ReviewDateID    string
+	UserID          string
+	ItemID          string
+	StepNumber      int
+	Grade           *int   // 想起度（0〜5）。適応型・FSRSのパターンでのみ使う
+	Today           string // 復習日を完了した日。想起度や完了日で再計算する際の起点にもなる（空の場合はユーザーのタイムゾーンでの今日）
+	DurationSeconds *int   // 復習にかかった秒数（任意）。履歴にのみ記録する
 }
 
 // 全ての復習日が完了したかどうかも返す（IsFinished）
+// 再計算した場合は再計算した復習日も返し、想起度で再計算した場合は更新後の記憶の状態も返す
 type UpdateReviewDateAsCompletedOutput struct {
 	ReviewDateID string
 	UserID       string
 	IsCompleted  bool
 	IsFinished   bool
 	EditedAt     time.Time
+	EaseFactor   *float64
+	Stability    *float64
+	Difficulty   *float64
+	ReviewDates  []UpdateReviewDateOutput
+}
+
+type UpdateReviewDateAsFailedInput struct {
+	ReviewDateID string
+	UserID       string
+	ItemID       string
+	Today        string // 想起失敗した日（ライトナー方式ではこの日から最初のステップに戻す）
+}
+
+// 復習日を作り直した場合（ライトナー方式）は、作り直した全ての復習日も返す
+type UpdateReviewDateAsFailedOutput struct {
+	ReviewDateID string
+	UserID       string
+	ItemID       string
+	EditedAt     time.Time
+	ReviewDates  []UpdateReviewDateOutput
+}
+
+type SnoozeReviewDateInput struct {
+	ReviewDateID string
+	UserID       string
+	ItemID       string
+	Days         int
+	Policy       string // only_this: この復習日だけ、shift_later: 以降の未完了の復習日もまとめて先送りする
+}
+
+// 先送りした復習日だけを返す
+type SnoozeReviewDateOutput struct {
+	ReviewDateID string
+	UserID       string
+	ItemID       string
+	EditedAt     time.Time
+	ReviewDates  []UpdateReviewDateOutput
+}
+
+// 今日の復習日を範囲（カテゴリー・ボックス・未分類）か復習日IDでまとめて完了する
+type BulkCompleteReviewDatesInput struct {
+	UserID        string
+	Today         string
+	CategoryID    *string
+	BoxID         *string
+	Unclassified  bool
+	ReviewDateIDs []string
+}
+
+type BulkCompletedReviewDateOutput struct {
+	ReviewDateID string
+	ItemID       string
+	StepNumber   int
+	IsFinished   bool // 最後のステップの復習日だったため、復習物も完了済みになった
+}
+
+type BulkCompleteReviewDatesOutput struct {
+	ReviewDates []BulkCompletedReviewDateOutput
+}
+
+// 今日の復習日を範囲（カテゴリー・ボックス・未分類）か復習日IDでまとめて先送りする
+type BulkSnoozeReviewDatesInput struct {
+	UserID        string
+	Today         string
+	CategoryID    *string
+	BoxID         *string
+	Unclassified  bool
+	ReviewDateIDs []string
+	Days          int
+	Policy        string
+}
+
+// 先送りした全ての復習日を返す
+type BulkSnoozeReviewDatesOutput struct {
+	ReviewDates []UpdateReviewDateOutput
 }
 
 type UpdateReviewDateAsInCompletedInput struct {
@@ -134,6 +247,7 @@ type UpdateReviewDateAsInCompletedInput struct {
 	UserID       string
 	ItemID       string
 	StepNumber   int
+	Today        string // 履歴に記録する未完了に戻した日（空の場合はユーザーのタイムゾーンでの今日）
 }
 
 type UpdateReviewDateAsInCompletedOutput struct {
@@ -196,18 +310,19 @@ type GetReviewDateOutput struct {
 }
 
 type GetItemOutput struct {
-	ItemID       string
-	UserID       string
-	CategoryID   *string
-	BoxID        *string
-	PatternID    *string
-	Name         string
-	Detail       string
-	LearnedDate  string
-	IsFinished   bool
-	RegisteredAt time.Time
-	EditedAt     time.Time
-	ReviewDates  []GetReviewDateOutput // ポインタにしたらどうなる？
+	ItemID         string
+	UserID         string
+	CategoryID     *string
+	BoxID          *string
+	PatternID      *string
+	PatternVersion int // 復習日を計算した時点の復習パターンのバージョン（復習パターンがない場合は0）
+	Name           string
+	Detail         string
+	LearnedDate    string
+	IsFinished     bool
+	RegisteredAt   time.Time
+	EditedAt       time.Time
+	ReviewDates    []GetReviewDateOutput // ポインタにしたらどうなる？
 }
 
 // アプリ内に存在するデータたちの概要を表示するための取得メソッド
@@ -316,3 +431,153 @@ type GetDailyReviewDatesOutput struct {
 	Categories                    []DailyReviewDatesGroupedByCategoryOutput
 	DailyReviewDatesGroupedByUser []UnclassifiedDailyReviewDatesGroupedByUserOutput
 }
+
+// 負荷予測（GetAllDailyReviewDatesと同じ単位でグルーピングした日毎の復習数）
+type ReviewForecastBoxOutput struct {
+	BoxID   string
+	BoxName string
+	Count   int
+}
+
+type ReviewForecastCategoryOutput struct {
+	CategoryID        string
+	CategoryName      string
+	Count             int
+	Boxes             []ReviewForecastBoxOutput
+	UnclassifiedCount int // カテゴリー毎の未分類ボックスの復習数
+}
+
+type ReviewForecastDayOutput struct {
+	Date              string
+	Count             int
+	Categories        []ReviewForecastCategoryOutput
+	UnclassifiedCount int // ユーザー直下の未分類ボックスの復習数
+}
+
+type GetReviewStatsOutput struct {
+	Today            string // 基準にした今日の日付
+	CurrentStreak    int    // 今日（今日まだ復習していなければ昨日）まで続いている連続学習日数
+	LongestStreak    int
+	CompletionRates  []CompletionRateOutput
+	AverageDelayDays *float64 // 完了した日を記録した復習日がない場合はnil
+}
+
+type CompletionRateOutput struct {
+	Days           int
+	DueCount       int
+	CompletedCount int
+	Rate           *float64 // 期間内に予定された復習日がない場合はnil
+}
+
+// 復習日を完了・未完了にした履歴（新しい順）
+type GetItemHistoryOutput struct {
+	ItemID string
+	Logs   []ReviewLogOutput
+}
+
+type ReviewLogOutput struct {
+	ReviewLogID     string
+	ReviewDateID    *string
+	StepNumber      int
+	Outcome         string
+	Grade           *int
+	DurationSeconds *int
+	ReviewedDate    string
+	ReviewedAt      time.Time
+}
+
+// なかなか定着しない復習物（ずらされた回数・想起に失敗した回数の多い順）
+type GetLeechItemsOutput struct {
+	SlipThreshold    int
+	FailureThreshold int
+	Items            []LeechItemOutput
+}
+
+type LeechItemOutput struct {
+	ItemID       string
+	CategoryID   *string
+	BoxID        *string
+	Name         string
+	SlipCount    int
+	FailureCount int
+}
+
+type GetReviewForecastOutput struct {
+	From string
+	Days []ReviewForecastDayOutput
+}
+
+// 活動ヒートマップ（日毎に完了した復習日数と学習した復習物数）
+type GetActivityHeatmapInput struct {
+	UserID       string
+	From         string
+	Days         int
+	CategoryID   *string
+	BoxID        *string
+	Unclassified bool
+}
+
+type HeatmapDayOutput struct {
+	Date         string
+	ReviewCount  int
+	LearnedCount int
+}
+
+type GetActivityHeatmapOutput struct {
+	From string
+	Days []HeatmapDayOutput
+}
+
+// 復習物の検索（名前と詳細の部分一致。完了済み・未完了の両方が対象）
+type SearchItemsInput struct {
+	UserID      string
+	Query       string
+	CategoryID  *string
+	BoxID       *string
+	LearnedFrom string // YYYY-MM-DD形式（空の場合は指定なし）
+	LearnedTo   string // YYYY-MM-DD形式（空の場合は指定なし）
+	IsFinished  *bool
+	Limit       int // 0の場合はDefaultSearchLimit件
+}
+
+type HighlightSegmentOutput struct {
+	Text    string
+	Matched bool
+}
+
+type SearchItemOutput struct {
+	ItemID        string
+	CategoryID    *string
+	BoxID         *string
+	Name          string
+	Detail        string
+	LearnedDate   string
+	IsFinished    bool
+	EditedAt      time.Time
+	Score         int
+	NameHighlight []HighlightSegmentOutput
+	DetailSnippet []HighlightSegmentOutput
+}
+
+type SearchItemsOutput struct {
+	Terms []string
+	Total int // 一致した件数（順位付けの候補の上限を超えても全件を数える）
+	Items []SearchItemOutput
+}
+
+// 直近の操作の取り消し
+type UndoItemOperationsInput struct {
+	UserID string
+	Count  int
+}
+
+type UndoneItemOperationOutput struct {
+	OperationID string
+	Kind        string
+	ItemIDs     []string
+	OperatedAt  time.Time
+}
+
+type UndoItemOperationsOutput struct {
+	Operations []UndoneItemOperationOutput // 取り消した順（新しい順）
+}
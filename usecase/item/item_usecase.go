@@ -9,6 +9,7 @@ import (
 	CategoryDomain "github.com/minminseo/recall-setter/domain/category"
 	ItemDomain "github.com/minminseo/recall-setter/domain/item"
 	PatternDomain "github.com/minminseo/recall-setter/domain/pattern"
+	UserDomain "github.com/minminseo/recall-setter/domain/user"
 	"github.com/minminseo/recall-setter/usecase/transaction"
 )
 
@@ -17,8 +18,9 @@ type ItemUsecase struct {
 	boxRepo            BoxDomain.IBoxRepository
 	itemRepo           ItemDomain.IItemRepository
 	patternRepo        PatternDomain.IPatternRepository
+	userRepo           UserDomain.UserRepository
 	transactionManager transaction.ITransactionManager
-	scheduler          ItemDomain.IScheduler
+	schedulers         *ItemDomain.SchedulerRegistry // 復習パターンのscheduler_kindに応じて使うスケジューラーを切り替える
 }
 
 func NewItemUsecase(
@@ -26,25 +28,32 @@ func NewItemUsecase(
 	boxRepo BoxDomain.IBoxRepository,
 	itemRepo ItemDomain.IItemRepository,
 	patternRepo PatternDomain.IPatternRepository,
+	userRepo UserDomain.UserRepository,
 	transactionManager transaction.ITransactionManager,
-	scheduler ItemDomain.IScheduler,
+	schedulers *ItemDomain.SchedulerRegistry,
 ) *ItemUsecase {
 	return &ItemUsecase{
 		categoryRepo:       categoryRepo,
 		boxRepo:            boxRepo,
 		itemRepo:           itemRepo,
 		patternRepo:        patternRepo,
+		userRepo:           userRepo,
 		transactionManager: transactionManager,
-		scheduler:          scheduler,
+		schedulers:         schedulers,
 	}
 }
 
-// 復習物作成
-func (iu *ItemUsecase) CreateItem(ctx context.Context, in CreateItemInput) (*CreateItemOutput, error) {
-	ItemID := uuid.NewString()
+// 復習物作成時の復習物と復習日を組み立てる（永続化はしない）
+func (iu *ItemUsecase) buildCreateItem(ctx context.Context, in CreateItemInput) (*ItemDomain.Item, []*ItemDomain.Reviewdate, error) {
+	ItemID := in.ItemID
+	if ItemID == "" {
+		ItemID = uuid.NewString()
+	} else if _, err := uuid.Parse(ItemID); err != nil {
+		return nil, nil, ItemDomain.ErrInvalidItemID
+	}
 	parsedLearnedDate, err := time.Parse("2006-01-02", in.LearnedDate)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	registeredAt := time.Now().UTC()
 	editedAt := registeredAt
@@ -63,46 +72,28 @@ func (iu *ItemUsecase) CreateItem(ctx context.Context, in CreateItemInput) (*Cre
 		editedAt,
 	)
 	if err != nil {
-		return nil, err
-	}
-
-	// 永続化
-	// patternIDがnilの場合は、復習物のみ永続化してreturn
-	if in.PatternID == nil {
-		err = iu.itemRepo.CreateItem(ctx, newItem)
-		if err != nil {
-			return nil, err
-		}
-		out := &CreateItemOutput{
-			ItemID:       newItem.ItemID,
-			UserID:       newItem.UserID,
-			CategoryID:   newItem.CategoryID,
-			BoxID:        newItem.BoxID,
-			PatternID:    newItem.PatternID,
-			Name:         newItem.Name,
-			Detail:       newItem.Detail,
-			LearnedDate:  newItem.LearnedDate.Format("2006-01-02"),
-			IsCompleted:  newItem.IsFinished,
-			RegisteredAt: newItem.RegisteredAt,
-			EditedAt:     newItem.EditedAt,
-		}
-		return out, nil
+		return nil, nil, err
 	}
 
 	var newReviewdates []*ItemDomain.Reviewdate
 	if in.PatternID != nil {
 		targetPatternSteps, err := iu.patternRepo.GetAllPatternStepsByPatternID(ctx, *in.PatternID, in.UserID)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		newItem.PatternVersion = PatternDomain.StepsVersion(targetPatternSteps)
 		parsedToday, err := time.Parse("2006-01-02", in.Today)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		scheduler, err := iu.resolveScheduler(ctx, *in.PatternID, in.UserID, newItem.ItemID)
+		if err != nil {
+			return nil, nil, err
 		}
 
 		if in.IsMarkOverdueAsCompleted {
 			var isFinished bool
-			newReviewdates, isFinished, err = iu.scheduler.FormatWithOverdueMarkedCompleted(
+			newReviewdates, isFinished, err = scheduler.FormatWithOverdueMarkedCompleted(
 				targetPatternSteps,
 				in.UserID,
 				in.CategoryID,
@@ -112,14 +103,14 @@ func (iu *ItemUsecase) CreateItem(ctx context.Context, in CreateItemInput) (*Cre
 				parsedToday,
 			)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			// もし最後のステップが今日より前なら（復習物作成の時点で全復習日完了扱いなら）、newItem.isFinishedをtrueにする
 			if isFinished {
 				newItem.IsFinished = true
 			}
 		} else {
-			newReviewdates, err = iu.scheduler.FormatWithOverdueMarkedInCompleted(
+			newReviewdates, err = scheduler.FormatWithOverdueMarkedInCompleted(
 				targetPatternSteps,
 				in.UserID,
 				in.CategoryID,
@@ -129,14 +120,57 @@ func (iu *ItemUsecase) CreateItem(ctx context.Context, in CreateItemInput) (*Cre
 				parsedToday,
 			)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
+			}
+		}
+	}
+	return newItem, newReviewdates, nil
+}
+
+// 復習物作成
+func (iu *ItemUsecase) CreateItem(ctx context.Context, in CreateItemInput) (*CreateItemOutput, error) {
+	newItem, newReviewdates, err := iu.buildCreateItem(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	// 永続化
+	// patternIDがnilの場合は、復習物のみ永続化してreturn
+	if in.PatternID == nil {
+		err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+			err = iu.saveItemOperation(ctx, newItem.UserID, ItemDomain.ItemOperationKindCreateItem, newItem.ItemID)
+			if err != nil {
+				return err
 			}
+			return iu.itemRepo.CreateItem(ctx, newItem)
+		})
+		if err != nil {
+			return nil, err
+		}
+		out := &CreateItemOutput{
+			ItemID:         newItem.ItemID,
+			UserID:         newItem.UserID,
+			CategoryID:     newItem.CategoryID,
+			BoxID:          newItem.BoxID,
+			PatternID:      newItem.PatternID,
+			PatternVersion: newItem.PatternVersion,
+			Name:           newItem.Name,
+			Detail:         newItem.Detail,
+			LearnedDate:    newItem.LearnedDate.Format("2006-01-02"),
+			IsCompleted:    newItem.IsFinished,
+			RegisteredAt:   newItem.RegisteredAt,
+			EditedAt:       newItem.EditedAt,
 		}
+		return out, nil
 	}
 
 	// 永続化
 	// ItemとReviewDatesは別テーブルなので同一トランザクションで永続化
 	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err = iu.saveItemOperation(ctx, newItem.UserID, ItemDomain.ItemOperationKindCreateItem, newItem.ItemID)
+		if err != nil {
+			return err
+		}
 
 		err = iu.itemRepo.CreateItem(ctx, newItem)
 		if err != nil {
@@ -155,17 +189,18 @@ func (iu *ItemUsecase) CreateItem(ctx context.Context, in CreateItemInput) (*Cre
 	}
 
 	out := &CreateItemOutput{
-		ItemID:       newItem.ItemID,
-		UserID:       newItem.UserID,
-		CategoryID:   newItem.CategoryID,
-		BoxID:        newItem.BoxID,
-		PatternID:    newItem.PatternID,
-		Name:         newItem.Name,
-		Detail:       newItem.Detail,
-		LearnedDate:  (newItem.LearnedDate).Format("2006-01-02"),
-		IsCompleted:  newItem.IsFinished,
-		RegisteredAt: newItem.RegisteredAt,
-		EditedAt:     newItem.EditedAt,
+		ItemID:         newItem.ItemID,
+		UserID:         newItem.UserID,
+		CategoryID:     newItem.CategoryID,
+		BoxID:          newItem.BoxID,
+		PatternID:      newItem.PatternID,
+		PatternVersion: newItem.PatternVersion,
+		Name:           newItem.Name,
+		Detail:         newItem.Detail,
+		LearnedDate:    (newItem.LearnedDate).Format("2006-01-02"),
+		IsCompleted:    newItem.IsFinished,
+		RegisteredAt:   newItem.RegisteredAt,
+		EditedAt:       newItem.EditedAt,
 	}
 	out.Reviewdates = make([]CreateReviewdateOutput, len(newReviewdates))
 	for i, rs := range newReviewdates {
@@ -182,11 +217,17 @@ func (iu *ItemUsecase) CreateItem(ctx context.Context, in CreateItemInput) (*Cre
 	return out, nil
 }
 
-// 復習物更新
-// <前提条件>
-// 復習物は必ず学習日を持つ。
+// 復習物更新時に発行するクエリと、更新後の復習物・復習日
+type itemUpdatePlan struct {
+	item                *ItemDomain.Item
+	reviewdates         []*ItemDomain.Reviewdate
+	isDeleteReviewdates bool
+	isCreateReviewdates bool
+	isUpdateReviewdates bool
+}
 
-func (iu *ItemUsecase) UpdateItem(ctx context.Context, input UpdateItemInput) (*UpdateItemOutput, error) {
+// 復習物更新時の復習物と復習日を組み立てる（永続化はしない）
+func (iu *ItemUsecase) planUpdateItem(ctx context.Context, input UpdateItemInput) (*itemUpdatePlan, error) {
 
 	/*--------- ここで行う処理の概要 ---------*/
 	// 0. 準備：下記の3つの処理で使われるフラグを最初に用意。各フラグがどの番号の処理で使われるか分かりやすいように、各フラグに番号をつける。
@@ -293,13 +334,18 @@ func (iu *ItemUsecase) UpdateItem(ctx context.Context, input UpdateItemInput) (*
 			という3つのフラグを生成する。
 		*/
 
-		currentSelectedPatternSteps, err = iu.patternRepo.GetAllPatternStepsByPatternID(ctx, *currentItem.PatternID, currentItem.UserID)
+		currentSelectedPatternSteps, err = iu.getPatternStepsOfItem(ctx, currentItem)
 		if err != nil {
 			return nil, err
 		}
-		requstedSelectedPatternSteps, err = iu.patternRepo.GetAllPatternStepsByPatternID(ctx, *input.PatternID, input.UserID)
-		if err != nil {
-			return nil, err
+		// 同じ復習パターンのままなら、復習日を計算した時点のバージョンのステップを使い続ける
+		if isSamePatternID {
+			requstedSelectedPatternSteps = currentSelectedPatternSteps
+		} else {
+			requstedSelectedPatternSteps, err = iu.patternRepo.GetAllPatternStepsByPatternID(ctx, *input.PatternID, input.UserID)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		// a. pattern_idを外部キーに持つpattern_stepsのレコード数の長さが異なるか
@@ -352,9 +398,13 @@ func (iu *ItemUsecase) UpdateItem(ctx context.Context, input UpdateItemInput) (*
 
 	if isPatternNilToNotNil || isPatternStepsLengthDiff {
 		//　IDを新規作成
+		scheduler, err := iu.resolveScheduler(ctx, *input.PatternID, input.UserID, input.ItemID)
+		if err != nil {
+			return nil, err
+		}
 		if input.IsMarkOverdueAsCompleted {
 			var isFinished bool
-			newReviewdates, isFinished, err = iu.scheduler.FormatWithOverdueMarkedCompleted(
+			newReviewdates, isFinished, err = scheduler.FormatWithOverdueMarkedCompleted(
 				requstedSelectedPatternSteps,
 				input.UserID,
 				input.CategoryID,
@@ -371,7 +421,7 @@ func (iu *ItemUsecase) UpdateItem(ctx context.Context, input UpdateItemInput) (*
 				currentItem.IsFinished = true
 			}
 		} else {
-			newReviewdates, err = iu.scheduler.FormatWithOverdueMarkedInCompleted(
+			newReviewdates, err = scheduler.FormatWithOverdueMarkedInCompleted(
 				requstedSelectedPatternSteps,
 				input.UserID,
 				input.CategoryID,
@@ -395,9 +445,13 @@ func (iu *ItemUsecase) UpdateItem(ctx context.Context, input UpdateItemInput) (*
 		if err != nil {
 			return nil, err
 		}
+		scheduler, err := iu.resolveScheduler(ctx, *input.PatternID, input.UserID, input.ItemID)
+		if err != nil {
+			return nil, err
+		}
 		var isFinished bool
 		if input.IsMarkOverdueAsCompleted {
-			newReviewdates, isFinished, err = iu.scheduler.FormatWithOverdueMarkedCompletedWithIDs(
+			newReviewdates, isFinished, err = scheduler.FormatWithOverdueMarkedCompletedWithIDs(
 				requstedSelectedPatternSteps,
 				reviewDateIDs,
 				input.UserID,
@@ -415,7 +469,7 @@ func (iu *ItemUsecase) UpdateItem(ctx context.Context, input UpdateItemInput) (*
 				currentItem.IsFinished = true
 			}
 		} else {
-			newReviewdates, err = iu.scheduler.FormatWithOverdueMarkedInCompletedWithIDs(
+			newReviewdates, err = scheduler.FormatWithOverdueMarkedInCompletedWithIDs(
 				requstedSelectedPatternSteps,
 				reviewDateIDs,
 				input.UserID,
@@ -465,30 +519,57 @@ func (iu *ItemUsecase) UpdateItem(ctx context.Context, input UpdateItemInput) (*
 	if err != nil {
 		return nil, err
 	}
+	currentItem.PatternVersion = PatternDomain.StepsVersion(requstedSelectedPatternSteps)
+
+	plan := &itemUpdatePlan{
+		item:                currentItem,
+		reviewdates:         newReviewdates,
+		isDeleteReviewdates: isPatternNotNilToNil || isPatternStepsLengthDiff,
+		isCreateReviewdates: isPatternNilToNotNil || isPatternStepsLengthDiff,
+		isUpdateReviewdates: (isSamePatternID && isLearnedDateChanged) ||
+			(isSamePatternStepsStructure && isLearnedDateChanged) ||
+			isOnlyPatternStepsIntervalDaysDiff ||
+			isOnlyCategoryIDBoxIDUpdate,
+	}
+	return plan, nil
+}
+
+// 復習物更新
+// <前提条件>
+// 復習物は必ず学習日を持つ。
+
+func (iu *ItemUsecase) UpdateItem(ctx context.Context, input UpdateItemInput) (*UpdateItemOutput, error) {
+	plan, err := iu.planUpdateItem(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	currentItem := plan.item
+	newReviewdates := plan.reviewdates
 
 	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err = iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindUpdateItem, input.ItemID)
+		if err != nil {
+			return err
+		}
+
 		err = iu.itemRepo.UpdateItem(ctx, currentItem)
 		if err != nil {
 			return err
 		}
 
-		if isPatternNotNilToNil || isPatternStepsLengthDiff {
+		if plan.isDeleteReviewdates {
 			err = iu.itemRepo.DeleteReviewDates(ctx, input.ItemID, input.UserID)
 			if err != nil {
 				return err
 			}
 		}
-		if isPatternNilToNotNil || isPatternStepsLengthDiff {
+		if plan.isCreateReviewdates {
 			_, err = iu.itemRepo.CreateReviewdates(ctx, newReviewdates)
 			if err != nil {
 				return err
 			}
 		}
-		if (isSamePatternID && isLearnedDateChanged) ||
-			(isSamePatternStepsStructure && isLearnedDateChanged) ||
-			isOnlyPatternStepsIntervalDaysDiff ||
-			isOnlyCategoryIDBoxIDUpdate {
-
+		if plan.isUpdateReviewdates {
 			err = iu.itemRepo.UpdateReviewDates(ctx, newReviewdates, input.UserID)
 			if err != nil {
 				return err
@@ -501,16 +582,17 @@ func (iu *ItemUsecase) UpdateItem(ctx context.Context, input UpdateItemInput) (*
 	}
 
 	resItem := &UpdateItemOutput{
-		ItemID:      currentItem.ItemID,
-		UserID:      currentItem.UserID,
-		CategoryID:  currentItem.CategoryID,
-		BoxID:       currentItem.BoxID,
-		PatternID:   currentItem.PatternID,
-		Name:        currentItem.Name,
-		Detail:      currentItem.Detail,
-		LearnedDate: (currentItem.LearnedDate).Format("2006-01-02"),
-		IsFinished:  currentItem.IsFinished,
-		EditedAt:    currentItem.EditedAt,
+		ItemID:         currentItem.ItemID,
+		UserID:         currentItem.UserID,
+		CategoryID:     currentItem.CategoryID,
+		BoxID:          currentItem.BoxID,
+		PatternID:      currentItem.PatternID,
+		PatternVersion: currentItem.PatternVersion,
+		Name:           currentItem.Name,
+		Detail:         currentItem.Detail,
+		LearnedDate:    (currentItem.LearnedDate).Format("2006-01-02"),
+		IsFinished:     currentItem.IsFinished,
+		EditedAt:       currentItem.EditedAt,
 	}
 	resItem.ReviewDates = make([]UpdateReviewDateOutput, len(newReviewdates))
 	for i, rs := range newReviewdates {
@@ -530,6 +612,51 @@ func (iu *ItemUsecase) UpdateItem(ctx context.Context, input UpdateItemInput) (*
 	return resItem, nil
 }
 
+// 復習物作成のプレビュー。CreateItemと同じ計算を行い、永続化はしない。
+// 間隔の揺らぎは復習物IDから決まるため、計算に使ったIDを返す。作成時にこのIDを渡せばプレビューと同じ復習日になる
+func (iu *ItemUsecase) PreviewCreateItem(ctx context.Context, in CreateItemInput) (*PreviewItemScheduleOutput, error) {
+	newItem, newReviewdates, err := iu.buildCreateItem(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return toPreviewItemScheduleOutput(newItem, newReviewdates), nil
+}
+
+// 復習物更新のプレビュー。UpdateItemと同じ計算を行い、永続化はしない。
+func (iu *ItemUsecase) PreviewUpdateItem(ctx context.Context, input UpdateItemInput) (*PreviewItemScheduleOutput, error) {
+	plan, err := iu.planUpdateItem(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// 復習日に変更がない場合は現在の復習日がそのまま残る
+	reviewdates := plan.reviewdates
+	if plan.item.PatternID != nil && !plan.isDeleteReviewdates && !plan.isCreateReviewdates && !plan.isUpdateReviewdates {
+		reviewdates, err = iu.itemRepo.GetReviewDatesByItemID(ctx, input.ItemID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return toPreviewItemScheduleOutput(plan.item, reviewdates), nil
+}
+
+func toPreviewItemScheduleOutput(item *ItemDomain.Item, reviewdates []*ItemDomain.Reviewdate) *PreviewItemScheduleOutput {
+	out := &PreviewItemScheduleOutput{
+		ItemID:      item.ItemID,
+		IsFinished:  item.IsFinished,
+		ReviewDates: make([]PreviewReviewDateOutput, len(reviewdates)),
+	}
+	for i, rd := range reviewdates {
+		out.ReviewDates[i] = PreviewReviewDateOutput{
+			StepNumber:           rd.StepNumber,
+			InitialScheduledDate: rd.InitialScheduledDate.Format("2006-01-02"),
+			ScheduledDate:        rd.ScheduledDate.Format("2006-01-02"),
+			IsCompleted:          rd.IsCompleted,
+		}
+	}
+	return out
+}
+
 // 　復習日の更新（編集）
 func (iu *ItemUsecase) UpdateReviewDates(ctx context.Context, input UpdateBackReviewDateInput) (*UpdateBackReviewDateOutput, error) {
 
@@ -550,7 +677,11 @@ func (iu *ItemUsecase) UpdateReviewDates(ctx context.Context, input UpdateBackRe
 		return nil, err
 	}
 
-	targetPatternSteps, err := iu.patternRepo.GetAllPatternStepsByPatternID(ctx, input.PatternID, input.UserID)
+	targetItem, err := iu.itemRepo.GetItemByID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	targetPatternSteps, err := iu.getPatternStepsOfItem(ctx, targetItem)
 	if err != nil {
 		return nil, err
 	}
@@ -593,12 +724,16 @@ func (iu *ItemUsecase) UpdateReviewDates(ctx context.Context, input UpdateBackRe
 		if err != nil {
 			return nil, err
 		}
+		scheduler, err := iu.resolveScheduler(ctx, input.PatternID, input.UserID, input.ItemID)
+		if err != nil {
+			return nil, err
+		}
 
 		if input.IsMarkOverdueAsCompleted {
 			calculatedDuration := int(parsedNewScheduledDate.Sub(parsedInitialScheduledDate).Hours() / 24)
 			FakeLearnedDate := parsedLearnedDate.AddDate(0, 0, calculatedDuration) // これでFormat〇〇系の関数を使い回せる
 
-			newReviewdates, isFinished, err = iu.scheduler.FormatWithOverdueMarkedCompletedWithIDs(
+			newReviewdates, isFinished, err = scheduler.FormatWithOverdueMarkedCompletedWithIDs(
 				targetPatternSteps,
 				reviewDateIDs,
 				input.UserID,
@@ -628,7 +763,7 @@ func (iu *ItemUsecase) UpdateReviewDates(ctx context.Context, input UpdateBackRe
 				diff = parsedToday.Sub(calculatedNextScheduledDate)
 				FakeLearnedDate = FakeLearnedDate.AddDate(0, 0, int(diff.Hours()/24))
 			}
-			newReviewdates, err = iu.scheduler.FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates(
+			newReviewdates, err = scheduler.FormatWithOverdueMarkedInCompletedWithIDsForBackReviewDates(
 				targetPatternSteps,
 				reviewDateIDs,
 				input.UserID,
@@ -658,34 +793,29 @@ func (iu *ItemUsecase) UpdateReviewDates(ctx context.Context, input UpdateBackRe
 		}
 	}
 
-	// isFinishedがtrueの場合、review_itemテーブルも操作するため、isFinishedで分岐し適宜トランザクションをはる。
+	// 取り消せるように操作前の状態を保存した上で、isFinishedがtrueの場合はreview_itemテーブルも合わせて更新する
 	targetEditedAt, err := iu.itemRepo.GetEditedAtByItemID(ctx, input.ItemID, input.UserID)
 	if err != nil {
 		return nil, err
 	}
 	resultEditedAt := targetEditedAt
-	if isFinished {
-		err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err = iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindUpdateReviewDates, input.ItemID)
+		if err != nil {
+			return err
+		}
 
+		if isFinished {
 			resultEditedAt = time.Now().UTC()
 			err = iu.itemRepo.UpdateItemAsFinished(ctx, input.ItemID, input.UserID, resultEditedAt)
 			if err != nil {
 				return err
 			}
-			err = iu.itemRepo.UpdateReviewDatesBack(ctx, filteredReviewdates, input.UserID)
-			if err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		err = iu.itemRepo.UpdateReviewDatesBack(ctx, filteredReviewdates, input.UserID)
-		if err != nil {
-			return nil, err
 		}
+		return iu.itemRepo.UpdateReviewDatesBack(ctx, filteredReviewdates, input.UserID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// 最新の復習日たちをDBから取得（クライアントで復習日のうち何回目以降を上書きすべきか考慮せずに済むため）
@@ -726,7 +856,13 @@ func (iu *ItemUsecase) UpdateItemAsFinishedForce(ctx context.Context, input Upda
 		return nil, err
 	}
 	editedAt := time.Now().UTC()
-	err = iu.itemRepo.UpdateItemAsFinished(ctx, input.ItemID, input.UserID, editedAt)
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err = iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindFinishItem, input.ItemID)
+		if err != nil {
+			return err
+		}
+		return iu.itemRepo.UpdateItemAsFinished(ctx, input.ItemID, input.UserID, editedAt)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -748,14 +884,15 @@ func (iu *ItemUsecase) UpdateReviewDateAsCompleted(ctx context.Context, input Up
 		return nil, err
 	}
 
-	// targetReviewdatesの最後の復習日のStepNumberがinput.StepNumberと一致するかどうかを判定するフラグを作成
-	var isLastStepNumberMatch bool
-	// ここで検証
-	lastReviewdate := targetReviewdates[len(targetReviewdates)-1]
-	if lastReviewdate.StepNumber == input.StepNumber {
-		isLastStepNumberMatch = true
-	} else {
-		isLastStepNumberMatch = false
+	// 完了した日（指定がなければユーザーのタイムゾーンでの今日）を記録し、再計算の起点にする
+	reviewedAt := time.Now().UTC()
+	parsedCompletedDate, err := iu.resolveToday(ctx, input.UserID, input.Today, reviewedAt)
+	if err != nil {
+		return nil, err
+	}
+	completion, err := iu.planReviewDateCompletion(ctx, input, targetReviewdates, parsedCompletedDate, reviewedAt)
+	if err != nil {
+		return nil, err
 	}
 
 	targetEditedAt, err := iu.itemRepo.GetEditedAtByItemID(ctx, input.ItemID, input.UserID)
@@ -763,124 +900,782 @@ func (iu *ItemUsecase) UpdateReviewDateAsCompleted(ctx context.Context, input Up
 		return nil, err
 	}
 	resultEditedAt := targetEditedAt
-	// 最後の復習日が完了した場合、復習物を完了済みに更新
-	if isLastStepNumberMatch {
-		err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
-			err = iu.itemRepo.UpdateReviewDateAsCompleted(ctx, input.ReviewDateID, input.UserID)
-			if err != nil {
-				return nil
-			}
-
-			resultEditedAt = time.Now().UTC()
-			err = iu.itemRepo.UpdateItemAsFinished(ctx, input.ItemID, input.UserID, resultEditedAt)
-			if err != nil {
-				return err
-			}
-			return nil
-		})
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err = iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindCompleteReviewDate, input.ItemID)
 		if err != nil {
-			return nil, err
+			return err
 		}
-
-		// 復習物そのものは未完了のまま
-	} else {
-		err = iu.itemRepo.UpdateReviewDateAsCompleted(ctx, input.ReviewDateID, input.UserID)
-		if err != nil {
-			return nil, err
+		if completion.isFinished {
+			resultEditedAt = time.Now().UTC()
 		}
+		return iu.applyReviewDateCompletion(ctx, completion, resultEditedAt)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	resReviewdate := &UpdateReviewDateAsCompletedOutput{
 		ReviewDateID: input.ReviewDateID,
 		UserID:       input.UserID,
 		IsCompleted:  true,
-		IsFinished:   isLastStepNumberMatch,
+		IsFinished:   completion.isFinished,
 		EditedAt:     resultEditedAt,
 	}
+	if completion.isRescheduled {
+		if input.Grade != nil {
+			resReviewdate.EaseFactor = &completion.nextState.EaseFactor
+			resReviewdate.Stability = &completion.nextState.Stability
+			resReviewdate.Difficulty = &completion.nextState.Difficulty
+		}
+		resReviewdate.ReviewDates = make([]UpdateReviewDateOutput, len(completion.rescheduledReviewdates))
+		for i, rd := range completion.rescheduledReviewdates {
+			resReviewdate.ReviewDates[i] = UpdateReviewDateOutput{
+				ReviewDateID:         rd.ReviewdateID,
+				UserID:               rd.UserID,
+				CategoryID:           rd.CategoryID,
+				BoxID:                rd.BoxID,
+				ItemID:               rd.ItemID,
+				StepNumber:           rd.StepNumber,
+				InitialScheduledDate: rd.InitialScheduledDate.Format("2006-01-02"),
+				ScheduledDate:        rd.ScheduledDate.Format("2006-01-02"),
+				IsCompleted:          rd.IsCompleted,
+			}
+		}
+	}
 
 	return resReviewdate, nil
 }
 
-// 復習物の復習日を未完了に更新
-func (iu *ItemUsecase) UpdateReviewDateAsInCompleted(ctx context.Context, input UpdateReviewDateAsInCompletedInput) (*UpdateReviewDateAsInCompletedOutput, error) {
-	targetItem, err := iu.itemRepo.GetItemByID(ctx, input.ItemID, input.UserID)
-	if err != nil {
-		return nil, err
-	}
-
-	var isItemFinished bool
-	if targetItem.IsFinished {
-		isItemFinished = true
-	}
+// 復習日の完了で永続化する内容
+type reviewDateCompletion struct {
+	input                  UpdateReviewDateAsCompletedInput
+	completedDate          time.Time
+	reviewLog              *ItemDomain.ReviewLog
+	failure                *ItemDomain.ReviewFailure // 合格点未満の想起度で完了した場合のみ
+	rescheduledReviewdates []*ItemDomain.Reviewdate
+	nextState              ItemDomain.MemoryState
+	isRescheduled          bool
+	isFinished             bool // 最後のステップの復習日を完了したため、復習物も完了済みにする
+}
 
-	targetEditedAt, err := iu.itemRepo.GetEditedAtByItemID(ctx, input.ItemID, input.UserID)
+// 復習日を完了した時の履歴と、再計算した残りの復習日を求める（永続化はしない）
+func (iu *ItemUsecase) planReviewDateCompletion(ctx context.Context, input UpdateReviewDateAsCompletedInput, targetReviewdates []*ItemDomain.Reviewdate, parsedCompletedDate time.Time, reviewedAt time.Time) (*reviewDateCompletion, error) {
+	lastReviewdate := targetReviewdates[len(targetReviewdates)-1]
+	completion := &reviewDateCompletion{
+		input:         input,
+		completedDate: parsedCompletedDate,
+		isFinished:    lastReviewdate.StepNumber == input.StepNumber,
+	}
+
+	reviewLog, err := ItemDomain.NewReviewLog(
+		uuid.NewString(),
+		input.UserID,
+		input.ItemID,
+		&input.ReviewDateID,
+		input.StepNumber,
+		ItemDomain.ReviewOutcomeCompleted,
+		input.Grade,
+		input.DurationSeconds,
+		parsedCompletedDate,
+		reviewedAt,
+	)
 	if err != nil {
 		return nil, err
 	}
-	resultEditedAt := targetEditedAt
-	if isItemFinished {
-		err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
-			err = iu.itemRepo.UpdateReviewDateAsInCompleted(ctx, input.ReviewDateID, input.UserID)
-			if err != nil {
-				return err
-			}
+	completion.reviewLog = reviewLog
 
-			resultEditedAt = time.Now().UTC()
-			err = iu.itemRepo.UpdateItemAsUnFinished(ctx, input.ItemID, input.UserID, resultEditedAt)
-			if err != nil {
-				return err
+	// 合格点未満の想起度での完了は、想起失敗と同じくリーチの判定に数えるため失敗として記録する
+	if reviewLog.IsLapse() {
+		scheduledDate := parsedCompletedDate
+		for _, rd := range targetReviewdates {
+			if rd.StepNumber == input.StepNumber {
+				scheduledDate = rd.ScheduledDate
+				break
 			}
-			return nil
-		})
+		}
+		completion.failure, err = ItemDomain.NewReviewFailure(
+			uuid.NewString(),
+			input.UserID,
+			input.ItemID,
+			input.StepNumber,
+			scheduledDate,
+			parsedCompletedDate,
+		)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		// 復習物が未完了の場合
-	} else {
-		err = iu.itemRepo.UpdateReviewDateAsInCompleted(ctx, input.ReviewDateID, input.UserID)
+	// 想起度に応じたスケジューリング方式のパターン、または間隔の起点を完了日にするパターンの場合のみ残りの復習日を再計算する
+	if !completion.isFinished {
+		completion.rescheduledReviewdates, completion.nextState, completion.isRescheduled, err = iu.rescheduleAfterCompletion(ctx, input, targetReviewdates, parsedCompletedDate)
 		if err != nil {
 			return nil, err
 		}
 	}
+	return completion, nil
+}
 
-	resReviewdate := &UpdateReviewDateAsInCompletedOutput{
-		ReviewDateID: input.ReviewDateID,
-		UserID:       input.UserID,
-		IsCompleted:  false,
-		IsFinished:   isItemFinished,
-		EditedAt:     resultEditedAt,
+// 完了と再計算の結果を反映した復習日を返す（同じ復習物の復習日を続けて完了する場合に使う）
+func (c *reviewDateCompletion) applyTo(reviewdates []*ItemDomain.Reviewdate) []*ItemDomain.Reviewdate {
+	replaced := replaceReviewdates(reviewdates, c.rescheduledReviewdates)
+	for i, rd := range replaced {
+		if rd.ReviewdateID == c.input.ReviewDateID {
+			completed := *rd
+			completed.IsCompleted = true
+			replaced[i] = &completed
+		}
 	}
-
-	return resReviewdate, nil
+	return replaced
 }
 
-// 途中完了復習物再開リクエスト
-func (iu *ItemUsecase) UpdateItemAsUnFinishedForce(ctx context.Context, input UpdateItemAsUnFinishedForceInput) (*UpdateItemAsUnFinishedForceOutput, error) {
-
-	ReviewDates, err := iu.itemRepo.GetReviewDatesByItemID(ctx, input.ItemID, input.UserID)
+// 復習日の完了と履歴の記録に加えて、最後の復習日が完了した場合は復習物を完了済みに、再計算した場合は残りの復習日と記憶の状態も合わせて更新（トランザクション内で呼ぶ）
+func (iu *ItemUsecase) applyReviewDateCompletion(ctx context.Context, completion *reviewDateCompletion, finishedAt time.Time) error {
+	input := completion.input
+	err := iu.itemRepo.UpdateReviewDateAsCompleted(ctx, input.ReviewDateID, input.UserID, completion.completedDate)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	err = iu.itemRepo.CreateReviewLog(ctx, completion.reviewLog)
+	if err != nil {
+		return err
+	}
+	if completion.failure != nil {
+		err = iu.itemRepo.CreateReviewFailure(ctx, completion.failure)
+		if err != nil {
+			return err
+		}
 	}
 
-	var firstInCompletedScheduledDate time.Time
-	var firstInCompletedInitialScheduledDate time.Time
-	var firstInCompletedStepNumber int
-	for _, ReviewDate := range ReviewDates {
-		if !ReviewDate.IsCompleted {
-			firstInCompletedScheduledDate = ReviewDate.ScheduledDate
-			firstInCompletedInitialScheduledDate = ReviewDate.InitialScheduledDate
-			firstInCompletedStepNumber = ReviewDate.StepNumber
-			break
+	if completion.isRescheduled {
+		if len(completion.rescheduledReviewdates) > 0 {
+			err = iu.itemRepo.UpdateReviewDates(ctx, completion.rescheduledReviewdates, input.UserID)
+			if err != nil {
+				return err
+			}
+		}
+		if input.Grade != nil {
+			err = iu.itemRepo.UpdateMemoryState(ctx, input.ItemID, input.UserID, completion.nextState)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
-	var shouldUpdateScheduledDates bool
-	parsedToday, err := time.Parse("2006-01-02", input.Today)
+	if completion.isFinished {
+		return iu.itemRepo.UpdateItemAsFinished(ctx, input.ItemID, input.UserID, finishedAt)
+	}
+	return nil
+}
+
+// 復習パターンのscheduler_kindに応じたスケジューラーを取得する
+func (iu *ItemUsecase) resolveScheduler(ctx context.Context, patternID string, userID string, itemID string) (ItemDomain.IScheduler, error) {
+	targetPattern, err := iu.patternRepo.FindPatternByPatternID(ctx, patternID, userID)
 	if err != nil {
 		return nil, err
 	}
-	var newReviewdates []*ItemDomain.Reviewdate
+	return iu.schedulerForPattern(ctx, targetPattern, userID, itemID)
+}
+
+// 復習物の復習日を計算した時点のバージョンのステップを取得する（バージョンが記録されていない場合は最新のステップ）
+func (iu *ItemUsecase) getPatternStepsOfItem(ctx context.Context, item *ItemDomain.Item) ([]*PatternDomain.PatternStep, error) {
+	if item.PatternVersion == 0 {
+		return iu.patternRepo.GetAllPatternStepsByPatternID(ctx, *item.PatternID, item.UserID)
+	}
+	return iu.patternRepo.GetPatternStepsByPatternVersion(ctx, *item.PatternID, item.PatternVersion, item.UserID)
+}
+
+// 復習パターンの設定（方式・間隔の揺らぎ）に応じてスケジューラーを組み立てる
+func (iu *ItemUsecase) schedulerForPattern(ctx context.Context, targetPattern *PatternDomain.Pattern, userID string, itemID string) (ItemDomain.IScheduler, error) {
+	scheduler := iu.schedulers.Resolve(targetPattern.SchedulerKind)
+	if targetPattern.IntervalFuzz {
+		scheduler = ItemDomain.NewIntervalFuzzScheduler(scheduler)
+	}
+	return iu.withUserCalendar(ctx, scheduler, userID, itemID)
+}
+
+// ユーザーの休息日に復習日が来ないように、また1日の最大復習数を超えないように、スケジューラーを包む
+func (iu *ItemUsecase) withUserCalendar(ctx context.Context, scheduler ItemDomain.IScheduler, userID string, itemID string) (ItemDomain.IScheduler, error) {
+	restDays, err := iu.userRepo.GetRestDaysByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	load, err := iu.reviewLoad(ctx, userID, &itemID)
+	if err != nil {
+		return nil, err
+	}
+	return ItemDomain.NewLoadBalancedScheduler(ItemDomain.NewCalendarScheduler(scheduler, restDays), restDays, load), nil
+}
+
+// ユーザーの1日の最大復習数と、日付毎の未完了の復習日数をまとめる（excludedItemIDを指定した場合はその復習物の復習日を数えない）
+func (iu *ItemUsecase) reviewLoad(ctx context.Context, userID string, excludedItemID *string) (*ItemDomain.ReviewLoad, error) {
+	reviewLimit, err := iu.userRepo.GetReviewLimitByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := iu.itemRepo.CountIncompleteReviewDatesByUserID(ctx, userID, excludedItemID)
+	if err != nil {
+		return nil, err
+	}
+	return ItemDomain.NewReviewLoad(reviewLimit.MaxReviewsPerDay, counts), nil
+}
+
+// 復習日完了時に残りの復習日を再計算する。
+// 想起度が指定されていれば想起度に応じて記憶の状態と復習日を再計算し、
+// 想起度で復習日が変わらない場合でも、間隔の起点を完了日にするパターンなら完了日から残りの復習日を計算し直す。
+// どちらにも当てはまらない場合は何もしない（isRescheduled=false）。
+func (iu *ItemUsecase) rescheduleAfterCompletion(ctx context.Context, input UpdateReviewDateAsCompletedInput, targetReviewdates []*ItemDomain.Reviewdate, parsedCompletedDate time.Time) ([]*ItemDomain.Reviewdate, ItemDomain.MemoryState, bool, error) {
+	targetItem, err := iu.itemRepo.GetItemByID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, ItemDomain.MemoryState{}, false, err
+	}
+	if targetItem.PatternID == nil {
+		return nil, ItemDomain.MemoryState{}, false, nil
+	}
+
+	targetPattern, err := iu.patternRepo.FindPatternByPatternID(ctx, *targetItem.PatternID, input.UserID)
+	if err != nil {
+		return nil, ItemDomain.MemoryState{}, false, err
+	}
+	if input.Grade == nil && !targetPattern.IntervalFromCompletion {
+		return nil, ItemDomain.MemoryState{}, false, nil
+	}
+	scheduler, err := iu.schedulerForPattern(ctx, targetPattern, input.UserID, input.ItemID)
+	if err != nil {
+		return nil, ItemDomain.MemoryState{}, false, err
+	}
+
+	targetPatternSteps, err := iu.getPatternStepsOfItem(ctx, targetItem)
+	if err != nil {
+		return nil, ItemDomain.MemoryState{}, false, err
+	}
+
+	var rescheduledReviewdates []*ItemDomain.Reviewdate
+	var nextState ItemDomain.MemoryState
+	isStateChanged := false
+	if input.Grade != nil {
+		state, err := iu.itemRepo.GetMemoryStateByItemID(ctx, input.ItemID, input.UserID)
+		if err != nil {
+			return nil, ItemDomain.MemoryState{}, false, err
+		}
+
+		rescheduledReviewdates, nextState, err = scheduler.RescheduleAfterCompletion(
+			targetPattern,
+			targetPatternSteps,
+			targetReviewdates,
+			input.StepNumber,
+			*state,
+			*input.Grade,
+			ItemDomain.LastReviewedDate(targetReviewdates, input.StepNumber, targetItem.LearnedDate),
+			parsedCompletedDate,
+		)
+		if err != nil {
+			return nil, ItemDomain.MemoryState{}, false, err
+		}
+		isStateChanged = nextState != *state
+	}
+
+	if len(rescheduledReviewdates) == 0 && targetPattern.IntervalFromCompletion {
+		rescheduledReviewdates, err = scheduler.RescheduleFromCompletedDate(targetPatternSteps, targetReviewdates, input.StepNumber, parsedCompletedDate)
+		if err != nil {
+			return nil, ItemDomain.MemoryState{}, false, err
+		}
+	}
+
+	// 復習日も記憶の状態も変わらない（固定ステップなど想起度を使わない方式の）場合は更新不要
+	isRescheduled := len(rescheduledReviewdates) > 0 || isStateChanged
+	return rescheduledReviewdates, nextState, isRescheduled, nil
+}
+
+// 指定された今日の日付を解釈する。指定がなければnowのユーザーのタイムゾーンでの日付にする
+func (iu *ItemUsecase) resolveToday(ctx context.Context, userID string, today string, now time.Time) (time.Time, error) {
+	if today != "" {
+		return time.Parse("2006-01-02", today)
+	}
+	user, err := iu.userRepo.GetSettingByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return UserDomain.LocalDate(now, user.Timezone)
+}
+
+// 復習物の復習日を想起失敗にする
+// 失敗は常に記録し、ライトナー方式のパターンでは想起失敗した日から最初のステップに戻して全ての復習日を作り直す
+func (iu *ItemUsecase) UpdateReviewDateAsFailed(ctx context.Context, input UpdateReviewDateAsFailedInput) (*UpdateReviewDateAsFailedOutput, error) {
+	targetItem, err := iu.itemRepo.GetItemByID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if targetItem.PatternID == nil {
+		return nil, ItemDomain.ErrReviewDateNotFound
+	}
+
+	targetReviewdates, err := iu.itemRepo.GetReviewDatesByItemID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	var failedReviewdate *ItemDomain.Reviewdate
+	for _, rd := range targetReviewdates {
+		if rd.ReviewdateID == input.ReviewDateID {
+			failedReviewdate = rd
+			break
+		}
+	}
+	if failedReviewdate == nil {
+		return nil, ItemDomain.ErrReviewDateNotFound
+	}
+	if failedReviewdate.IsCompleted {
+		return nil, ItemDomain.ErrReviewDateAlreadyCompleted
+	}
+
+	parsedToday, err := time.Parse("2006-01-02", input.Today)
+	if err != nil {
+		return nil, err
+	}
+
+	targetPatternSteps, err := iu.getPatternStepsOfItem(ctx, targetItem)
+	if err != nil {
+		return nil, err
+	}
+	scheduler, err := iu.resolveScheduler(ctx, *targetItem.PatternID, input.UserID, input.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	rescheduledReviewdates, err := scheduler.RescheduleAfterFailure(targetPatternSteps, targetReviewdates, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+
+	failure, err := ItemDomain.NewReviewFailure(
+		uuid.NewString(),
+		input.UserID,
+		input.ItemID,
+		failedReviewdate.StepNumber,
+		failedReviewdate.ScheduledDate,
+		parsedToday,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	editedAt, err := iu.itemRepo.GetEditedAtByItemID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err = iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindFailReviewDate, input.ItemID)
+		if err != nil {
+			return err
+		}
+		err = iu.itemRepo.CreateReviewFailure(ctx, failure)
+		if err != nil {
+			return err
+		}
+		if len(rescheduledReviewdates) > 0 {
+			err = iu.itemRepo.UpdateReviewDates(ctx, rescheduledReviewdates, input.UserID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resReviewdates := make([]UpdateReviewDateOutput, len(rescheduledReviewdates))
+	for i, rd := range rescheduledReviewdates {
+		resReviewdates[i] = UpdateReviewDateOutput{
+			ReviewDateID:         rd.ReviewdateID,
+			UserID:               rd.UserID,
+			CategoryID:           rd.CategoryID,
+			BoxID:                rd.BoxID,
+			ItemID:               rd.ItemID,
+			StepNumber:           rd.StepNumber,
+			InitialScheduledDate: rd.InitialScheduledDate.Format("2006-01-02"),
+			ScheduledDate:        rd.ScheduledDate.Format("2006-01-02"),
+			IsCompleted:          rd.IsCompleted,
+		}
+	}
+
+	return &UpdateReviewDateAsFailedOutput{
+		ReviewDateID: input.ReviewDateID,
+		UserID:       input.UserID,
+		ItemID:       input.ItemID,
+		EditedAt:     editedAt,
+		ReviewDates:  resReviewdates,
+	}, nil
+}
+
+// 復習日をdays日先送りする（休息日は避けるが、後続の復習日の再計算はしない）。先送りした回数はずらされた回数として記録する
+func (iu *ItemUsecase) SnoozeReviewDate(ctx context.Context, input SnoozeReviewDateInput) (*SnoozeReviewDateOutput, error) {
+	targetReviewdates, err := iu.itemRepo.GetReviewDatesByItemID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := iu.userRepo.GetRestDaysByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	snoozedReviewdates, err := ItemDomain.SnoozeReviewdates(targetReviewdates, input.ReviewDateID, input.Days, input.Policy, calendar)
+	if err != nil {
+		return nil, err
+	}
+
+	editedAt, err := iu.itemRepo.GetEditedAtByItemID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err = iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindSnoozeReviewDate, input.ItemID)
+		if err != nil {
+			return err
+		}
+		err = iu.itemRepo.UpdateReviewDates(ctx, snoozedReviewdates, input.UserID)
+		if err != nil {
+			return err
+		}
+		return iu.itemRepo.IncrementSlipCount(ctx, input.ItemID, input.UserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resReviewdates := make([]UpdateReviewDateOutput, len(snoozedReviewdates))
+	for i, rd := range snoozedReviewdates {
+		resReviewdates[i] = UpdateReviewDateOutput{
+			ReviewDateID:         rd.ReviewdateID,
+			UserID:               rd.UserID,
+			CategoryID:           rd.CategoryID,
+			BoxID:                rd.BoxID,
+			ItemID:               rd.ItemID,
+			StepNumber:           rd.StepNumber,
+			InitialScheduledDate: rd.InitialScheduledDate.Format("2006-01-02"),
+			ScheduledDate:        rd.ScheduledDate.Format("2006-01-02"),
+			IsCompleted:          rd.IsCompleted,
+		}
+	}
+
+	return &SnoozeReviewDateOutput{
+		ReviewDateID: input.ReviewDateID,
+		UserID:       input.UserID,
+		ItemID:       input.ItemID,
+		EditedAt:     editedAt,
+		ReviewDates:  resReviewdates,
+	}, nil
+}
+
+// 今日の復習日のうち範囲内の未完了の復習日を1つのトランザクションでまとめて完了する
+// 最後のステップの復習日を完了した復習物は完了済みにする
+func (iu *ItemUsecase) BulkCompleteReviewDates(ctx context.Context, input BulkCompleteReviewDatesInput) (*BulkCompleteReviewDatesOutput, error) {
+	targets, parsedToday, err := iu.getBulkTargetReviewDates(ctx, input.UserID, input.Today, input.CategoryID, input.BoxID, input.Unclassified, input.ReviewDateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同じ復習物の復習日が複数ある場合は、先に完了した結果を反映した復習日から次の完了を計算する
+	reviewedAt := time.Now().UTC()
+	completions := make([]*reviewDateCompletion, 0, len(targets))
+	var itemIDs []string
+	var reviewdates []*ItemDomain.Reviewdate
+	for i, target := range targets {
+		if i == 0 || targets[i-1].ItemID != target.ItemID {
+			reviewdates, err = iu.itemRepo.GetReviewDatesByItemID(ctx, target.ItemID, input.UserID)
+			if err != nil {
+				return nil, err
+			}
+			itemIDs = append(itemIDs, target.ItemID)
+		}
+		completion, err := iu.planReviewDateCompletion(ctx, UpdateReviewDateAsCompletedInput{
+			ReviewDateID: target.ReviewdateID,
+			UserID:       input.UserID,
+			ItemID:       target.ItemID,
+			StepNumber:   target.StepNumber,
+			Today:        input.Today,
+		}, reviewdates, parsedToday, reviewedAt)
+		if err != nil {
+			return nil, err
+		}
+		completions = append(completions, completion)
+		reviewdates = completion.applyTo(reviewdates)
+	}
+
+	res := &BulkCompleteReviewDatesOutput{
+		ReviewDates: make([]BulkCompletedReviewDateOutput, len(completions)),
+	}
+	for i, c := range completions {
+		res.ReviewDates[i] = BulkCompletedReviewDateOutput{
+			ReviewDateID: c.input.ReviewDateID,
+			ItemID:       c.input.ItemID,
+			StepNumber:   c.input.StepNumber,
+			IsFinished:   c.isFinished,
+		}
+	}
+	if len(completions) == 0 {
+		return res, nil
+	}
+
+	finishedAt := time.Now().UTC()
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err := iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindCompleteReviewDate, itemIDs...)
+		if err != nil {
+			return err
+		}
+		for _, c := range completions {
+			err = iu.applyReviewDateCompletion(ctx, c, finishedAt)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// 今日の復習日のうち範囲内の未完了の復習日を1つのトランザクションでまとめて先送りする
+// 以降の復習日もずらす場合は、同じ復習物の中で最も前のステップの復習日を基準にずらす
+func (iu *ItemUsecase) BulkSnoozeReviewDates(ctx context.Context, input BulkSnoozeReviewDatesInput) (*BulkSnoozeReviewDatesOutput, error) {
+	if err := ItemDomain.ValidateSnooze(input.Days, input.Policy); err != nil {
+		return nil, err
+	}
+	targets, _, err := iu.getBulkTargetReviewDates(ctx, input.UserID, input.Today, input.CategoryID, input.BoxID, input.Unclassified, input.ReviewDateIDs)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := iu.userRepo.GetRestDaysByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 復習物毎にまとめる（targetsは復習物・ステップ番号順）
+	var itemIDs []string
+	targetsByItem := make(map[string][]*ItemDomain.DailyReviewDate)
+	for _, target := range targets {
+		if _, ok := targetsByItem[target.ItemID]; !ok {
+			itemIDs = append(itemIDs, target.ItemID)
+		}
+		targetsByItem[target.ItemID] = append(targetsByItem[target.ItemID], target)
+	}
+
+	var snoozedReviewdates []*ItemDomain.Reviewdate
+	for _, itemID := range itemIDs {
+		reviewdates, err := iu.itemRepo.GetReviewDatesByItemID(ctx, itemID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		itemTargets := targetsByItem[itemID]
+		if input.Policy == ItemDomain.SnoozePolicyShiftLater {
+			itemTargets = itemTargets[:1]
+		}
+		// 後ろのステップから先送りすることで、前のステップの先送りを後ろのステップの新しい日付で検証する
+		snoozedIDs := make(map[string]struct{})
+		for i := len(itemTargets) - 1; i >= 0; i-- {
+			moved, err := ItemDomain.SnoozeReviewdates(reviewdates, itemTargets[i].ReviewdateID, input.Days, input.Policy, calendar)
+			if err != nil {
+				return nil, err
+			}
+			reviewdates = replaceReviewdates(reviewdates, moved)
+			for _, rd := range moved {
+				snoozedIDs[rd.ReviewdateID] = struct{}{}
+			}
+		}
+		for _, rd := range reviewdates {
+			if _, ok := snoozedIDs[rd.ReviewdateID]; ok {
+				snoozedReviewdates = append(snoozedReviewdates, rd)
+			}
+		}
+	}
+
+	res := &BulkSnoozeReviewDatesOutput{
+		ReviewDates: make([]UpdateReviewDateOutput, len(snoozedReviewdates)),
+	}
+	for i, rd := range snoozedReviewdates {
+		res.ReviewDates[i] = UpdateReviewDateOutput{
+			ReviewDateID:         rd.ReviewdateID,
+			UserID:               rd.UserID,
+			CategoryID:           rd.CategoryID,
+			BoxID:                rd.BoxID,
+			ItemID:               rd.ItemID,
+			StepNumber:           rd.StepNumber,
+			InitialScheduledDate: rd.InitialScheduledDate.Format("2006-01-02"),
+			ScheduledDate:        rd.ScheduledDate.Format("2006-01-02"),
+			IsCompleted:          rd.IsCompleted,
+		}
+	}
+	if len(snoozedReviewdates) == 0 {
+		return res, nil
+	}
+
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err := iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindSnoozeReviewDate, itemIDs...)
+		if err != nil {
+			return err
+		}
+		err = iu.itemRepo.UpdateReviewDates(ctx, snoozedReviewdates, input.UserID)
+		if err != nil {
+			return err
+		}
+		for _, itemID := range itemIDs {
+			err = iu.itemRepo.IncrementSlipCount(ctx, itemID, input.UserID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// まとめて操作する今日の未完了の復習日を、復習物・ステップ番号順に取得する
+func (iu *ItemUsecase) getBulkTargetReviewDates(ctx context.Context, userID string, today string, categoryID *string, boxID *string, unclassified bool, reviewDateIDs []string) ([]*ItemDomain.DailyReviewDate, time.Time, error) {
+	parsedToday, err := time.Parse("2006-01-02", today)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	scope, err := ItemDomain.NewDailyReviewScope(categoryID, boxID, unclassified, reviewDateIDs)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	dailyDates, err := iu.itemRepo.GetAllDailyReviewDates(ctx, userID, parsedToday)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	targets, err := scope.Filter(dailyDates)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return targets, parsedToday, nil
+}
+
+// 同じIDの復習日を置き換えた新しいスライスを返す
+func replaceReviewdates(reviewdates []*ItemDomain.Reviewdate, replacements []*ItemDomain.Reviewdate) []*ItemDomain.Reviewdate {
+	byID := make(map[string]*ItemDomain.Reviewdate, len(replacements))
+	for _, rd := range replacements {
+		byID[rd.ReviewdateID] = rd
+	}
+	replaced := make([]*ItemDomain.Reviewdate, len(reviewdates))
+	for i, rd := range reviewdates {
+		if r, ok := byID[rd.ReviewdateID]; ok {
+			replaced[i] = r
+		} else {
+			replaced[i] = rd
+		}
+	}
+	return replaced
+}
+
+// 復習物の復習日を未完了に更新
+func (iu *ItemUsecase) UpdateReviewDateAsInCompleted(ctx context.Context, input UpdateReviewDateAsInCompletedInput) (*UpdateReviewDateAsInCompletedOutput, error) {
+	targetItem, err := iu.itemRepo.GetItemByID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var isItemFinished bool
+	if targetItem.IsFinished {
+		isItemFinished = true
+	}
+
+	reviewedAt := time.Now().UTC()
+	parsedReviewedDate, err := iu.resolveToday(ctx, input.UserID, input.Today, reviewedAt)
+	if err != nil {
+		return nil, err
+	}
+	reviewLog, err := ItemDomain.NewReviewLog(
+		uuid.NewString(),
+		input.UserID,
+		input.ItemID,
+		&input.ReviewDateID,
+		input.StepNumber,
+		ItemDomain.ReviewOutcomeUncompleted,
+		nil,
+		nil,
+		parsedReviewedDate,
+		reviewedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	targetEditedAt, err := iu.itemRepo.GetEditedAtByItemID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resultEditedAt := targetEditedAt
+	// 復習物が完了済みの場合は、復習日を未完了に戻すと同時に復習物も未完了に戻す
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err = iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindIncompleteReviewDate, input.ItemID)
+		if err != nil {
+			return err
+		}
+		err = iu.itemRepo.UpdateReviewDateAsInCompleted(ctx, input.ReviewDateID, input.UserID)
+		if err != nil {
+			return err
+		}
+		err = iu.itemRepo.CreateReviewLog(ctx, reviewLog)
+		if err != nil {
+			return err
+		}
+
+		if isItemFinished {
+			resultEditedAt = time.Now().UTC()
+			err = iu.itemRepo.UpdateItemAsUnFinished(ctx, input.ItemID, input.UserID, resultEditedAt)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resReviewdate := &UpdateReviewDateAsInCompletedOutput{
+		ReviewDateID: input.ReviewDateID,
+		UserID:       input.UserID,
+		IsCompleted:  false,
+		IsFinished:   isItemFinished,
+		EditedAt:     resultEditedAt,
+	}
+
+	return resReviewdate, nil
+}
+
+// 途中完了復習物再開リクエスト
+func (iu *ItemUsecase) UpdateItemAsUnFinishedForce(ctx context.Context, input UpdateItemAsUnFinishedForceInput) (*UpdateItemAsUnFinishedForceOutput, error) {
+
+	ReviewDates, err := iu.itemRepo.GetReviewDatesByItemID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var firstInCompletedScheduledDate time.Time
+	var firstInCompletedInitialScheduledDate time.Time
+	var firstInCompletedStepNumber int
+	for _, ReviewDate := range ReviewDates {
+		if !ReviewDate.IsCompleted {
+			firstInCompletedScheduledDate = ReviewDate.ScheduledDate
+			firstInCompletedInitialScheduledDate = ReviewDate.InitialScheduledDate
+			firstInCompletedStepNumber = ReviewDate.StepNumber
+			break
+		}
+	}
+
+	var shouldUpdateScheduledDates bool
+	parsedToday, err := time.Parse("2006-01-02", input.Today)
+	if err != nil {
+		return nil, err
+	}
+	var newReviewdates []*ItemDomain.Reviewdate
 	if parsedToday.Before(firstInCompletedScheduledDate) || parsedToday.Equal(firstInCompletedScheduledDate) {
 		shouldUpdateScheduledDates = false
 	} else {
@@ -891,7 +1686,11 @@ func (iu *ItemUsecase) UpdateItemAsUnFinishedForce(ctx context.Context, input Up
 			return nil, err
 		}
 		FakeLearnedDate := parsedLearnedDate.AddDate(0, 0, calculatedDuration)
-		patternSteps, err := iu.patternRepo.GetAllPatternStepsByPatternID(ctx, input.PatternID, input.UserID)
+		targetItem, err := iu.itemRepo.GetItemByID(ctx, input.ItemID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		patternSteps, err := iu.getPatternStepsOfItem(ctx, targetItem)
 		if err != nil {
 			return nil, err
 		}
@@ -899,8 +1698,12 @@ func (iu *ItemUsecase) UpdateItemAsUnFinishedForce(ctx context.Context, input Up
 		for i, rd := range ReviewDates {
 			reviewDateIDs[i] = rd.ReviewdateID
 		}
+		scheduler, err := iu.resolveScheduler(ctx, input.PatternID, input.UserID, input.ItemID)
+		if err != nil {
+			return nil, err
+		}
 
-		newReviewdates, err = iu.scheduler.FormatWithOverdueMarkedInCompletedWithIDs(
+		newReviewdates, err = scheduler.FormatWithOverdueMarkedInCompletedWithIDs(
 			patternSteps,
 			reviewDateIDs,
 			input.UserID,
@@ -925,40 +1728,168 @@ func (iu *ItemUsecase) UpdateItemAsUnFinishedForce(ctx context.Context, input Up
 
 	editedAt := time.Now().UTC()
 
-	if !shouldUpdateScheduledDates {
-		err := iu.itemRepo.UpdateItemAsUnFinished(ctx, input.ItemID, input.UserID, editedAt)
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err = iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindUnfinishItem, input.ItemID)
 		if err != nil {
-			return nil, err
+			return err
 		}
-	} else {
-		err := iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
-			err = iu.itemRepo.UpdateItemAsUnFinished(ctx, input.ItemID, input.UserID, editedAt)
+		err = iu.itemRepo.UpdateItemAsUnFinished(ctx, input.ItemID, input.UserID, editedAt)
+		if err != nil {
+			return err
+		}
+
+		// 再開する日に合わせて未完了の復習日をずらす必要がある場合のみ更新
+		if shouldUpdateScheduledDates {
+			err = iu.itemRepo.UpdateReviewDates(ctx, filteredReviewdates, input.UserID)
 			if err != nil {
 				return err
 			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			err = iu.itemRepo.UpdateReviewDates(ctx, filteredReviewdates, input.UserID)
+	// 最新の復習日たちをDBから取得（クライアントで復習日のうち何回目以降を上書きすべきか考慮せずに済むため）
+	latestReviewdates, err := iu.itemRepo.GetReviewDatesByItemID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &UpdateItemAsUnFinishedForceOutput{
+		ItemID:     input.ItemID,
+		UserID:     input.UserID,
+		IsFinished: false,
+		EditedAt:   editedAt,
+	}
+	res.ReviewDates = make([]UpdateReviewDateOutput, len(latestReviewdates))
+	for i, rs := range latestReviewdates {
+		res.ReviewDates[i] = UpdateReviewDateOutput{
+			ReviewDateID:         rs.ReviewdateID,
+			UserID:               rs.UserID,
+			CategoryID:           rs.CategoryID,
+			BoxID:                rs.BoxID,
+			ItemID:               rs.ItemID,
+			StepNumber:           rs.StepNumber,
+			InitialScheduledDate: rs.InitialScheduledDate.Format("2006-01-02"),
+			ScheduledDate:        rs.ScheduledDate.Format("2006-01-02"),
+			IsCompleted:          rs.IsCompleted,
+		}
+	}
+
+	return res, nil
+}
+
+// 復習物が使用する復習パターンを最新バージョンに更新する
+// 完了済みと期限切れの復習日はそのまま残し、未完了かつ今日以降の復習日にだけ最新のステップを反映する
+func (iu *ItemUsecase) UpgradeItemPattern(ctx context.Context, input UpgradeItemPatternInput) (*UpgradeItemPatternOutput, error) {
+	parsedToday, err := time.Parse("2006-01-02", input.Today)
+	if err != nil {
+		return nil, err
+	}
+
+	targetItem, err := iu.itemRepo.GetItemByID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if targetItem.PatternID == nil {
+		return nil, ItemDomain.ErrItemHasNoPattern
+	}
+	if targetItem.IsFinished {
+		return nil, ItemDomain.ErrItemAlreadyFinished
+	}
+
+	targetPattern, err := iu.patternRepo.FindPatternByPatternID(ctx, *targetItem.PatternID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if targetItem.PatternVersion == targetPattern.Version {
+		return nil, ItemDomain.ErrItemPatternAlreadyLatest
+	}
+	// 復習パターンの変更時のapply_to_futureと同じく、ステップの間隔どおりに復習日が決まるパターンだけを対象にする
+	if targetPattern.SchedulerKind != PatternDomain.SchedulerKindFixedSteps || targetPattern.IntervalFuzz {
+		return nil, ItemDomain.ErrItemPatternUpgradeNotSupported
+	}
+
+	latestPatternSteps, err := iu.patternRepo.GetAllPatternStepsByPatternID(ctx, targetPattern.PatternID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	currentReviewdates, err := iu.itemRepo.GetReviewDatesByItemID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := iu.userRepo.GetRestDaysByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// 計算し直す前の復習日をMigrateReviewdatesToSteps側で差し引くため、この復習物の復習日も含めて数える
+	load, err := iu.reviewLoad(ctx, input.UserID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	migration, err := ItemDomain.MigrateReviewdatesToSteps(targetItem, currentReviewdates, latestPatternSteps, parsedToday, calendar, load)
+	if err != nil {
+		return nil, err
+	}
+
+	editedAt := time.Now().UTC()
+	targetItem.PatternVersion = PatternDomain.StepsVersion(latestPatternSteps)
+	targetItem.EditedAt = editedAt
+
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err = iu.saveItemOperation(ctx, input.UserID, ItemDomain.ItemOperationKindUpgradeItemPattern, input.ItemID)
+		if err != nil {
+			return err
+		}
+		err = iu.itemRepo.UpdateItem(ctx, targetItem)
+		if err != nil {
+			return err
+		}
+		if len(migration.UpdatedReviewdates) > 0 {
+			err = iu.itemRepo.UpdateReviewDates(ctx, migration.UpdatedReviewdates, input.UserID)
+			if err != nil {
+				return err
+			}
+		}
+		if len(migration.CreatedReviewdates) > 0 {
+			if _, err := iu.itemRepo.CreateReviewdates(ctx, migration.CreatedReviewdates); err != nil {
+				return err
+			}
+		}
+		if len(migration.DeletedReviewdateIDs) > 0 {
+			err = iu.itemRepo.DeleteReviewDatesByIDs(ctx, migration.DeletedReviewdateIDs, input.UserID)
+			if err != nil {
+				return err
+			}
+		}
+		if migration.IsFinished {
+			err = iu.itemRepo.UpdateItemAsFinished(ctx, input.ItemID, input.UserID, editedAt)
 			if err != nil {
 				return err
 			}
-			return nil
-		})
-		if err != nil {
-			return nil, err
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 最新の復習日たちをDBから取得（クライアントで復習日のうち何回目以降を上書きすべきか考慮せずに済むため）
+	// 最新の復習日たちをDBから取得（追加・削除された復習日もまとめて返すため）
 	latestReviewdates, err := iu.itemRepo.GetReviewDatesByItemID(ctx, input.ItemID, input.UserID)
 	if err != nil {
 		return nil, err
 	}
 
-	res := &UpdateItemAsUnFinishedForceOutput{
-		ItemID:     input.ItemID,
-		UserID:     input.UserID,
-		IsFinished: false,
-		EditedAt:   editedAt,
+	res := &UpgradeItemPatternOutput{
+		ItemID:         targetItem.ItemID,
+		UserID:         targetItem.UserID,
+		PatternID:      targetPattern.PatternID,
+		PatternVersion: targetItem.PatternVersion,
+		IsFinished:     migration.IsFinished,
+		EditedAt:       editedAt,
 	}
 	res.ReviewDates = make([]UpdateReviewDateOutput, len(latestReviewdates))
 	for i, rs := range latestReviewdates {
@@ -981,18 +1912,114 @@ func (iu *ItemUsecase) UpdateItemAsUnFinishedForce(ctx context.Context, input Up
 // 物理削除
 // TODO: 論理削除に変更する（影響範囲を確認してから）
 func (iu *ItemUsecase) DeleteItem(ctx context.Context, itemID string, userID string) error {
-	err := iu.itemRepo.DeleteItem(ctx, itemID, userID)
+	return iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		err := iu.saveItemOperation(ctx, userID, ItemDomain.ItemOperationKindDeleteItem, itemID)
+		if err != nil {
+			return err
+		}
+		return iu.itemRepo.DeleteItem(ctx, itemID, userID)
+	})
+}
+
+// 操作の直前の復習物と復習日の状態を保存し、後から取り消せるようにする
+func (iu *ItemUsecase) saveItemOperation(ctx context.Context, userID string, kind string, itemIDs ...string) error {
+	operation, err := ItemDomain.NewItemOperation(uuid.NewString(), userID, kind, itemIDs, time.Now().UTC())
 	if err != nil {
 		return err
 	}
-	return nil
+	return iu.itemRepo.SaveItemOperation(ctx, operation)
+}
+
+// 直近の操作を新しい順にcount件取り消す。取り消せる操作がcount件に満たない場合は何も取り消さない
+func (iu *ItemUsecase) UndoItemOperations(ctx context.Context, input UndoItemOperationsInput) (*UndoItemOperationsOutput, error) {
+	if err := ItemDomain.ValidateUndoCount(input.Count); err != nil {
+		return nil, err
+	}
+
+	operatedFrom := time.Now().UTC().Add(-ItemDomain.UndoWindow)
+	operations, err := iu.itemRepo.GetItemOperationsByUserID(ctx, input.UserID, operatedFrom, input.Count)
+	if err != nil {
+		return nil, err
+	}
+	if len(operations) < input.Count {
+		return nil, ItemDomain.ErrNotEnoughItemOperationsToUndo
+	}
+
+	// 新しい操作から順に戻すことで、最も古い操作の直前の状態になる
+	err = iu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		for _, op := range operations {
+			err := iu.itemRepo.RestoreItemOperation(ctx, op.OperationID, input.UserID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &UndoItemOperationsOutput{
+		Operations: make([]UndoneItemOperationOutput, len(operations)),
+	}
+	for i, op := range operations {
+		res.Operations[i] = UndoneItemOperationOutput{
+			OperationID: op.OperationID,
+			Kind:        op.Kind,
+			ItemIDs:     op.ItemIDs,
+			OperatedAt:  op.OperatedAt,
+		}
+	}
+	return res, nil
+}
+
+// tagIDを指定した場合はそのタグが付いた復習物だけに絞り込む（空文字の場合はそのまま返す）
+func (iu *ItemUsecase) filterItemsByTag(ctx context.Context, items []*ItemDomain.Item, tagID string, userID string) ([]*ItemDomain.Item, error) {
+	if tagID == "" {
+		return items, nil
+	}
+	tagged, err := iu.getTaggedItemIDSet(ctx, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]*ItemDomain.Item, 0, len(items))
+	for _, it := range items {
+		if _, ok := tagged[it.ItemID]; ok {
+			filtered = append(filtered, it)
+		}
+	}
+	return filtered, nil
+}
+
+// 集計をDB側でタグで絞り込むため、空文字のtagIDはnil（絞り込まない）にする
+func optionalTagID(tagID string) *string {
+	if tagID == "" {
+		return nil
+	}
+	return &tagID
+}
+
+func (iu *ItemUsecase) getTaggedItemIDSet(ctx context.Context, tagID string, userID string) (map[string]struct{}, error) {
+	itemIDs, err := iu.itemRepo.GetItemIDsByTagID(ctx, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
+	tagged := make(map[string]struct{}, len(itemIDs))
+	for _, id := range itemIDs {
+		tagged[id] = struct{}{}
+	}
+	return tagged, nil
 }
 
-func (iu *ItemUsecase) GetAllUnFinishedItemsByBoxID(ctx context.Context, boxID string, userID string) ([]*GetItemOutput, error) {
+func (iu *ItemUsecase) GetAllUnFinishedItemsByBoxID(ctx context.Context, boxID string, userID string, tagID string) ([]*GetItemOutput, error) {
 	items, err := iu.itemRepo.GetAllUnFinishedItemsByBoxID(ctx, boxID, userID)
 	if err != nil {
 		return nil, err
 	}
+	items, err = iu.filterItemsByTag(ctx, items, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	// ItemIDをキーに未完了復習物をマップ化
 	unfinishedItemMap := make(map[string]struct{}, len(items))
@@ -1047,29 +2074,34 @@ func (iu *ItemUsecase) GetAllUnFinishedItemsByBoxID(ctx context.Context, boxID s
 	result := make([]*GetItemOutput, len(items))
 	for i, it := range items {
 		result[i] = &GetItemOutput{
-			ItemID:       it.ItemID,
-			UserID:       it.UserID,
-			CategoryID:   it.CategoryID,
-			BoxID:        it.BoxID,
-			PatternID:    it.PatternID,
-			Name:         it.Name,
-			Detail:       it.Detail,
-			LearnedDate:  it.LearnedDate.Format("2006-01-02"),
-			IsFinished:   it.IsFinished,
-			RegisteredAt: it.RegisteredAt,
-			EditedAt:     it.EditedAt,
-			ReviewDates:  reviewdatesByItem[it.ItemID],
+			ItemID:         it.ItemID,
+			UserID:         it.UserID,
+			CategoryID:     it.CategoryID,
+			BoxID:          it.BoxID,
+			PatternID:      it.PatternID,
+			PatternVersion: it.PatternVersion,
+			Name:           it.Name,
+			Detail:         it.Detail,
+			LearnedDate:    it.LearnedDate.Format("2006-01-02"),
+			IsFinished:     it.IsFinished,
+			RegisteredAt:   it.RegisteredAt,
+			EditedAt:       it.EditedAt,
+			ReviewDates:    reviewdatesByItem[it.ItemID],
 		}
 	}
 
 	return result, nil
 }
 
-func (iu *ItemUsecase) GetAllUnFinishedUnclassifiedItemsByUserID(ctx context.Context, userID string) ([]*GetItemOutput, error) {
+func (iu *ItemUsecase) GetAllUnFinishedUnclassifiedItemsByUserID(ctx context.Context, userID string, tagID string) ([]*GetItemOutput, error) {
 	items, err := iu.itemRepo.GetAllUnFinishedUnclassifiedItemsByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	items, err = iu.filterItemsByTag(ctx, items, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	// 復習物IDをキーに未分類未完了復習物をマップ化
 	unfinishedItemMap := make(map[string]struct{}, len(items))
@@ -1124,29 +2156,34 @@ func (iu *ItemUsecase) GetAllUnFinishedUnclassifiedItemsByUserID(ctx context.Con
 	result := make([]*GetItemOutput, len(items))
 	for i, it := range items {
 		result[i] = &GetItemOutput{
-			ItemID:       it.ItemID,
-			UserID:       it.UserID,
-			CategoryID:   it.CategoryID,
-			BoxID:        it.BoxID,
-			PatternID:    it.PatternID,
-			Name:         it.Name,
-			Detail:       it.Detail,
-			LearnedDate:  it.LearnedDate.Format("2006-01-02"),
-			IsFinished:   it.IsFinished,
-			RegisteredAt: it.RegisteredAt,
-			EditedAt:     it.EditedAt,
-			ReviewDates:  reviewdatesByItem[it.ItemID],
+			ItemID:         it.ItemID,
+			UserID:         it.UserID,
+			CategoryID:     it.CategoryID,
+			BoxID:          it.BoxID,
+			PatternID:      it.PatternID,
+			PatternVersion: it.PatternVersion,
+			Name:           it.Name,
+			Detail:         it.Detail,
+			LearnedDate:    it.LearnedDate.Format("2006-01-02"),
+			IsFinished:     it.IsFinished,
+			RegisteredAt:   it.RegisteredAt,
+			EditedAt:       it.EditedAt,
+			ReviewDates:    reviewdatesByItem[it.ItemID],
 		}
 	}
 
 	return result, nil
 }
 
-func (iu *ItemUsecase) GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx context.Context, userID string, categoryID string) ([]*GetItemOutput, error) {
+func (iu *ItemUsecase) GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx context.Context, userID string, categoryID string, tagID string) ([]*GetItemOutput, error) {
 	items, err := iu.itemRepo.GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx, categoryID, userID)
 	if err != nil {
 		return nil, err
 	}
+	items, err = iu.filterItemsByTag(ctx, items, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	// 復習物IDをキーに未分類未完了復習物をマップ化
 	unfinishedItemMap := make(map[string]struct{}, len(items))
@@ -1196,18 +2233,19 @@ func (iu *ItemUsecase) GetAllUnFinishedUnclassifiedItemsByCategoryID(ctx context
 	result := make([]*GetItemOutput, len(items))
 	for i, it := range items {
 		result[i] = &GetItemOutput{
-			ItemID:       it.ItemID,
-			UserID:       it.UserID,
-			CategoryID:   it.CategoryID,
-			BoxID:        it.BoxID,
-			PatternID:    it.PatternID,
-			Name:         it.Name,
-			Detail:       it.Detail,
-			LearnedDate:  it.LearnedDate.Format("2006-01-02"),
-			IsFinished:   it.IsFinished,
-			RegisteredAt: it.RegisteredAt,
-			EditedAt:     it.EditedAt,
-			ReviewDates:  reviewdatesByItem[it.ItemID],
+			ItemID:         it.ItemID,
+			UserID:         it.UserID,
+			CategoryID:     it.CategoryID,
+			BoxID:          it.BoxID,
+			PatternID:      it.PatternID,
+			PatternVersion: it.PatternVersion,
+			Name:           it.Name,
+			Detail:         it.Detail,
+			LearnedDate:    it.LearnedDate.Format("2006-01-02"),
+			IsFinished:     it.IsFinished,
+			RegisteredAt:   it.RegisteredAt,
+			EditedAt:       it.EditedAt,
+			ReviewDates:    reviewdatesByItem[it.ItemID],
 		}
 	}
 
@@ -1255,13 +2293,13 @@ func (iu *ItemUsecase) CountUnclassifiedItemsByUserID(ctx context.Context, userI
 	return count, nil
 }
 
-func (iu *ItemUsecase) CountDailyDatesGroupedByBoxByUserID(ctx context.Context, userID string, today string) ([]*DailyCountGroupedByBoxOutput, error) {
+func (iu *ItemUsecase) CountDailyDatesGroupedByBoxByUserID(ctx context.Context, userID string, today string, tagID string) ([]*DailyCountGroupedByBoxOutput, error) {
 	parsedToday, err := time.Parse("2006-01-02", today)
 	if err != nil {
 		return nil, err
 	}
 
-	counts, err := iu.itemRepo.CountDailyDatesGroupedByBoxByUserID(ctx, userID, parsedToday)
+	counts, err := iu.itemRepo.CountDailyDatesGroupedByBoxByUserID(ctx, userID, parsedToday, optionalTagID(tagID))
 	if err != nil {
 		return nil, err
 	}
@@ -1277,13 +2315,13 @@ func (iu *ItemUsecase) CountDailyDatesGroupedByBoxByUserID(ctx context.Context,
 	return result, nil
 }
 
-func (iu *ItemUsecase) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, userID string, today string) ([]*UnclassifiedDailyDatesCountGroupedByCategoryOutput, error) {
+func (iu *ItemUsecase) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx context.Context, userID string, today string, tagID string) ([]*UnclassifiedDailyDatesCountGroupedByCategoryOutput, error) {
 	parsedToday, err := time.Parse("2006-01-02", today)
 	if err != nil {
 		return nil, err
 	}
 
-	counts, err := iu.itemRepo.CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx, userID, parsedToday)
+	counts, err := iu.itemRepo.CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx, userID, parsedToday, optionalTagID(tagID))
 	if err != nil {
 		return nil, err
 	}
@@ -1298,13 +2336,13 @@ func (iu *ItemUsecase) CountDailyDatesUnclassifiedGroupedByCategoryByUserID(ctx
 	return result, nil
 }
 
-func (iu *ItemUsecase) CountDailyDatesUnclassifiedByUserID(ctx context.Context, userID string, today string) (int, error) {
+func (iu *ItemUsecase) CountDailyDatesUnclassifiedByUserID(ctx context.Context, userID string, today string, tagID string) (int, error) {
 	parsedToday, err := time.Parse("2006-01-02", today)
 	if err != nil {
 		return 0, err
 	}
 
-	count, err := iu.itemRepo.CountDailyDatesUnclassifiedByUserID(ctx, userID, parsedToday)
+	count, err := iu.itemRepo.CountDailyDatesUnclassifiedByUserID(ctx, userID, parsedToday, optionalTagID(tagID))
 	if err != nil {
 		return 0, err
 	}
@@ -1312,13 +2350,13 @@ func (iu *ItemUsecase) CountDailyDatesUnclassifiedByUserID(ctx context.Context,
 }
 
 // 今日の全復習日数を取得
-func (iu *ItemUsecase) CountAllDailyReviewDates(ctx context.Context, userID string, today string) (int, error) {
+func (iu *ItemUsecase) CountAllDailyReviewDates(ctx context.Context, userID string, today string, tagID string) (int, error) {
 	parsedToday, err := time.Parse("2006-01-02", today)
 	if err != nil {
 		return 0, err
 	}
 
-	count, err := iu.itemRepo.CountAllDailyReviewDates(ctx, userID, parsedToday)
+	count, err := iu.itemRepo.CountAllDailyReviewDates(ctx, userID, parsedToday, optionalTagID(tagID))
 	if err != nil {
 		return 0, err
 	}
@@ -1328,17 +2366,42 @@ func (iu *ItemUsecase) CountAllDailyReviewDates(ctx context.Context, userID stri
 // TODO: ボックスレベルの完了済みの過去日の復習日を今日に変更するユースケース実装
 // TODO: 完了した復習物（is_finishedがtrue）を取得するユースケース実装
 
-func (iu *ItemUsecase) GetAllDailyReviewDates(ctx context.Context, userID string, today string) (*GetDailyReviewDatesOutput, error) {
+// orderを指定した場合は優先度の高い復習日から並べ、limitを指定した場合（0より大きい場合）は先頭から指定件数だけ返す。
+// グループは並び替えた後の最初の復習日の順で並ぶ
+func (iu *ItemUsecase) GetAllDailyReviewDates(ctx context.Context, userID string, today string, limit int, order string, tagID string) (*GetDailyReviewDatesOutput, error) {
 	parsedToday, err := time.Parse("2006-01-02", today)
 	if err != nil {
 		return nil, err
 	}
+	orderKeys, err := ItemDomain.ParseDailyReviewOrder(order)
+	if err != nil {
+		return nil, err
+	}
+	if err := ItemDomain.ValidateDailyReviewLimit(limit); err != nil {
+		return nil, err
+	}
 
 	// ユーザー直下（NULL／NULL）の未分類ボックス今日の復習日、カテゴリー毎（非NULL／NULL）の未分類ボックスの今日の復習日、ボックス毎（非NULL／非NULL）の復習日をまとめて取得。
 	dailyDates, err := iu.itemRepo.GetAllDailyReviewDates(ctx, userID, parsedToday)
 	if err != nil {
 		return nil, err
 	}
+	if tagID != "" {
+		tagged, err := iu.getTaggedItemIDSet(ctx, tagID, userID)
+		if err != nil {
+			return nil, err
+		}
+		filtered := make([]*ItemDomain.DailyReviewDate, 0, len(dailyDates))
+		for _, d := range dailyDates {
+			if _, ok := tagged[d.ItemID]; ok {
+				filtered = append(filtered, d)
+			}
+		}
+		dailyDates = filtered
+	}
+
+	ItemDomain.SortDailyReviewDates(dailyDates, orderKeys, parsedToday)
+	dailyDates = ItemDomain.LimitDailyReviewDates(dailyDates, limit)
 
 	// 一意なIDを保持するためのセットを作成
 	categorySet := make(map[string]struct{})
@@ -1551,12 +2614,357 @@ func (iu *ItemUsecase) GetAllDailyReviewDates(ctx context.Context, userID string
 	return out, nil
 }
 
+// 連続学習日数・直近の完了率・平均の遅れを求める（今日の指定がなければユーザーのタイムゾーンでの今日を基準にする）
+func (iu *ItemUsecase) GetReviewStats(ctx context.Context, userID string, today string) (*GetReviewStatsOutput, error) {
+	parsedToday, err := iu.resolveToday(ctx, userID, today, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	completedDates, err := iu.itemRepo.GetCompletedDatesByUserID(ctx, userID, parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	currentStreak, longestStreak := ItemDomain.CalcStreaks(completedDates, parsedToday)
+
+	// 最も長い期間分をまとめて取得し、期間毎に集計する
+	maxWindowDays := 0
+	for _, days := range ItemDomain.CompletionRateWindowDays {
+		if days > maxWindowDays {
+			maxWindowDays = days
+		}
+	}
+	counts, err := iu.itemRepo.CountReviewCompletionByUserID(ctx, userID, parsedToday.AddDate(0, 0, -(maxWindowDays-1)), parsedToday)
+	if err != nil {
+		return nil, err
+	}
+	rates := ItemDomain.CalcCompletionRates(counts, parsedToday, ItemDomain.CompletionRateWindowDays)
+
+	averageDelayDays, err := iu.itemRepo.GetAverageReviewDelayByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &GetReviewStatsOutput{
+		Today:            parsedToday.Format("2006-01-02"),
+		CurrentStreak:    currentStreak,
+		LongestStreak:    longestStreak,
+		CompletionRates:  make([]CompletionRateOutput, len(rates)),
+		AverageDelayDays: averageDelayDays,
+	}
+	for i, rate := range rates {
+		res.CompletionRates[i] = CompletionRateOutput{
+			Days:           rate.Days,
+			DueCount:       rate.DueCount,
+			CompletedCount: rate.CompletedCount,
+			Rate:           rate.Rate,
+		}
+	}
+	return res, nil
+}
+
+// 復習物の復習日を完了・未完了にした履歴を新しい順に取得する
+func (iu *ItemUsecase) GetItemHistory(ctx context.Context, itemID string, userID string) (*GetItemHistoryOutput, error) {
+	logs, err := iu.itemRepo.GetReviewLogsByItemID(ctx, itemID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &GetItemHistoryOutput{
+		ItemID: itemID,
+		Logs:   make([]ReviewLogOutput, len(logs)),
+	}
+	for i, l := range logs {
+		res.Logs[i] = ReviewLogOutput{
+			ReviewLogID:     l.ReviewLogID,
+			ReviewDateID:    l.ReviewDateID,
+			StepNumber:      l.StepNumber,
+			Outcome:         l.Outcome,
+			Grade:           l.Grade,
+			DurationSeconds: l.DurationSeconds,
+			ReviewedDate:    l.ReviewedDate.Format("2006-01-02"),
+			ReviewedAt:      l.ReviewedAt,
+		}
+	}
+	return res, nil
+}
+
+// 何度もずらされたり想起に失敗したりしている、完了していない復習物（リーチ）を取得する
+func (iu *ItemUsecase) GetLeechItems(ctx context.Context, userID string) (*GetLeechItemsOutput, error) {
+	leeches, err := iu.itemRepo.GetLeechItemsByUserID(ctx, userID, ItemDomain.LeechSlipThreshold, ItemDomain.LeechFailureThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &GetLeechItemsOutput{
+		SlipThreshold:    ItemDomain.LeechSlipThreshold,
+		FailureThreshold: ItemDomain.LeechFailureThreshold,
+		Items:            make([]LeechItemOutput, len(leeches)),
+	}
+	for i, l := range leeches {
+		res.Items[i] = LeechItemOutput{
+			ItemID:       l.ItemID,
+			CategoryID:   l.CategoryID,
+			BoxID:        l.BoxID,
+			Name:         l.Name,
+			SlipCount:    l.SlipCount,
+			FailureCount: l.FailureCount,
+		}
+	}
+	return res, nil
+}
+
+func (iu *ItemUsecase) GetReviewForecast(ctx context.Context, userID string, from string, days int, tagID string) (*GetReviewForecastOutput, error) {
+	parsedFrom, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return nil, err
+	}
+	if days < 1 || days > ItemDomain.MaxForecastDays {
+		return nil, ItemDomain.ErrInvalidForecastDays
+	}
+	parsedTo := parsedFrom.AddDate(0, 0, days-1)
+
+	counts, err := iu.itemRepo.CountReviewForecastByUserID(ctx, userID, parsedFrom, parsedTo, optionalTagID(tagID))
+	if err != nil {
+		return nil, err
+	}
+
+	// 一意なIDを保持するためのセットを作成
+	categorySet := make(map[string]struct{})
+	boxSet := make(map[string]struct{})
+	for _, c := range counts {
+		if c.CategoryID != nil {
+			categorySet[*c.CategoryID] = struct{}{}
+		}
+		if c.BoxID != nil {
+			boxSet[*c.BoxID] = struct{}{}
+		}
+	}
+
+	// カテゴリー名を一括取得
+	categoryIDs := make([]string, 0, len(categorySet))
+	for id := range categorySet {
+		categoryIDs = append(categoryIDs, id)
+	}
+	categoryMap := make(map[string]string, len(categoryIDs))
+	if len(categoryIDs) > 0 {
+		categories, err := iu.categoryRepo.GetCategoryNamesByCategoryIDs(ctx, categoryIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range categories {
+			categoryMap[c.ID] = c.Name
+		}
+	}
+
+	// ボックス名を一括取得
+	boxIDs := make([]string, 0, len(boxSet))
+	for id := range boxSet {
+		boxIDs = append(boxIDs, id)
+	}
+	boxNameMap := make(map[string]string, len(boxIDs))
+	if len(boxIDs) > 0 {
+		boxes, err := iu.boxRepo.GetBoxNamesByBoxIDs(ctx, boxIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range boxes {
+			boxNameMap[b.BoxID] = b.Name
+		}
+	}
+
+	// 復習がない日も0件として返すため、期間内の全日付を先に用意する
+	out := &GetReviewForecastOutput{
+		From: from,
+		Days: make([]ReviewForecastDayOutput, days),
+	}
+	dayIndex := make(map[string]int, days)
+	for i := 0; i < days; i++ {
+		date := parsedFrom.AddDate(0, 0, i).Format("2006-01-02")
+		out.Days[i] = ReviewForecastDayOutput{
+			Date:       date,
+			Categories: []ReviewForecastCategoryOutput{},
+		}
+		dayIndex[date] = i
+	}
+
+	categoryIndex := make(map[string]int)
+	for _, c := range counts {
+		date := c.ScheduledDate.Format("2006-01-02")
+		di, ok := dayIndex[date]
+		if !ok {
+			continue
+		}
+		day := &out.Days[di]
+		day.Count += c.Count
+
+		// 未分類 (category=nil && box=nil)の場合、ユーザー直下の未分類に加算
+		if c.CategoryID == nil {
+			day.UnclassifiedCount += c.Count
+			continue
+		}
+
+		// カテゴリーグループ初期化
+		key := date + "|" + *c.CategoryID
+		ci, ok := categoryIndex[key]
+		if !ok {
+			day.Categories = append(day.Categories, ReviewForecastCategoryOutput{
+				CategoryID:   *c.CategoryID,
+				CategoryName: categoryMap[*c.CategoryID],
+				Boxes:        []ReviewForecastBoxOutput{},
+			})
+			ci = len(day.Categories) - 1
+			categoryIndex[key] = ci
+		}
+		categoryGroup := &day.Categories[ci]
+		categoryGroup.Count += c.Count
+
+		// ボックス未分類 (box=nil)の場合、カテゴリー毎の未分類に加算
+		if c.BoxID == nil {
+			categoryGroup.UnclassifiedCount += c.Count
+			continue
+		}
+
+		// クエリでボックス毎に集計済みなので、1行が1ボックスになる
+		categoryGroup.Boxes = append(categoryGroup.Boxes, ReviewForecastBoxOutput{
+			BoxID:   *c.BoxID,
+			BoxName: boxNameMap[*c.BoxID],
+			Count:   c.Count,
+		})
+	}
+	return out, nil
+}
+
+func (iu *ItemUsecase) GetActivityHeatmap(ctx context.Context, input GetActivityHeatmapInput) (*GetActivityHeatmapOutput, error) {
+	parsedFrom, err := time.Parse("2006-01-02", input.From)
+	if err != nil {
+		return nil, err
+	}
+	if input.Days < 1 || input.Days > ItemDomain.MaxHeatmapDays {
+		return nil, ItemDomain.ErrInvalidHeatmapDays
+	}
+	parsedTo := parsedFrom.AddDate(0, 0, input.Days-1)
+
+	filter, err := ItemDomain.NewHeatmapFilter(input.CategoryID, input.BoxID, input.Unclassified)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewCounts, err := iu.itemRepo.CountCompletedReviewsByDate(ctx, input.UserID, parsedFrom, parsedTo, filter)
+	if err != nil {
+		return nil, err
+	}
+	learnedCounts, err := iu.itemRepo.CountLearnedItemsByDate(ctx, input.UserID, parsedFrom, parsedTo, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// 活動がない日も0件として返すため、期間内の全日付を先に用意する
+	out := &GetActivityHeatmapOutput{
+		From: input.From,
+		Days: make([]HeatmapDayOutput, input.Days),
+	}
+	dayIndex := make(map[string]int, input.Days)
+	for i := 0; i < input.Days; i++ {
+		date := parsedFrom.AddDate(0, 0, i).Format("2006-01-02")
+		out.Days[i] = HeatmapDayOutput{Date: date}
+		dayIndex[date] = i
+	}
+
+	for _, c := range reviewCounts {
+		if di, ok := dayIndex[c.Date.Format("2006-01-02")]; ok {
+			out.Days[di].ReviewCount += c.Count
+		}
+	}
+	for _, c := range learnedCounts {
+		if di, ok := dayIndex[c.Date.Format("2006-01-02")]; ok {
+			out.Days[di].LearnedCount += c.Count
+		}
+	}
+	return out, nil
+}
+
+func (iu *ItemUsecase) SearchItems(ctx context.Context, input SearchItemsInput) (*SearchItemsOutput, error) {
+	terms, err := ItemDomain.ParseSearchQuery(input.Query)
+	if err != nil {
+		return nil, err
+	}
+	limit := input.Limit
+	if limit == 0 {
+		limit = ItemDomain.DefaultSearchLimit
+	}
+	if err := ItemDomain.ValidateSearchLimit(limit); err != nil {
+		return nil, err
+	}
+
+	var learnedFrom, learnedTo *time.Time
+	if input.LearnedFrom != "" {
+		parsed, err := time.Parse("2006-01-02", input.LearnedFrom)
+		if err != nil {
+			return nil, err
+		}
+		learnedFrom = &parsed
+	}
+	if input.LearnedTo != "" {
+		parsed, err := time.Parse("2006-01-02", input.LearnedTo)
+		if err != nil {
+			return nil, err
+		}
+		learnedTo = &parsed
+	}
+	filter, err := ItemDomain.NewItemSearchFilter(input.CategoryID, input.BoxID, learnedFrom, learnedTo, input.IsFinished)
+	if err != nil {
+		return nil, err
+	}
+
+	// 順位付けはDBから取得した候補全体で行い、その上位limit件を返す
+	items, total, err := iu.itemRepo.SearchItems(ctx, input.UserID, terms, filter, ItemDomain.MaxSearchCandidates)
+	if err != nil {
+		return nil, err
+	}
+	hits := ItemDomain.RankItemSearchResults(items, terms, len(items))
+
+	out := &SearchItemsOutput{
+		Terms: terms,
+		Total: total,
+		Items: make([]SearchItemOutput, 0, min(limit, len(hits))),
+	}
+	for _, hit := range hits[:min(limit, len(hits))] {
+		out.Items = append(out.Items, SearchItemOutput{
+			ItemID:        hit.Item.ItemID,
+			CategoryID:    hit.Item.CategoryID,
+			BoxID:         hit.Item.BoxID,
+			Name:          hit.Item.Name,
+			Detail:        hit.Item.Detail,
+			LearnedDate:   hit.Item.LearnedDate.Format("2006-01-02"),
+			IsFinished:    hit.Item.IsFinished,
+			EditedAt:      hit.Item.EditedAt,
+			Score:         hit.Score,
+			NameHighlight: toHighlightSegmentOutputs(hit.NameHighlight),
+			DetailSnippet: toHighlightSegmentOutputs(hit.DetailSnippet),
+		})
+	}
+	return out, nil
+}
+
+func toHighlightSegmentOutputs(segments []ItemDomain.HighlightSegment) []HighlightSegmentOutput {
+	outputs := make([]HighlightSegmentOutput, len(segments))
+	for i, s := range segments {
+		outputs[i] = HighlightSegmentOutput{Text: s.Text, Matched: s.Matched}
+	}
+	return outputs
+}
+
 // 完了済み復習物取得系
-func (iu *ItemUsecase) GetFinishedItemsByBoxID(ctx context.Context, boxID string, userID string) ([]*GetItemOutput, error) {
+func (iu *ItemUsecase) GetFinishedItemsByBoxID(ctx context.Context, boxID string, userID string, tagID string) ([]*GetItemOutput, error) {
 	items, err := iu.itemRepo.GetFinishedItemsByBoxID(ctx, boxID, userID)
 	if err != nil {
 		return nil, err
 	}
+	items, err = iu.filterItemsByTag(ctx, items, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	// 復習物IDをキーに完了済み復習物をマップ化
 	finishedItemMap := make(map[string]struct{}, len(items))
@@ -1593,28 +3001,33 @@ func (iu *ItemUsecase) GetFinishedItemsByBoxID(ctx context.Context, boxID string
 	result := make([]*GetItemOutput, 0, len(items))
 	for _, item := range items {
 		result = append(result, &GetItemOutput{
-			ItemID:       item.ItemID,
-			UserID:       item.UserID,
-			CategoryID:   item.CategoryID,
-			BoxID:        item.BoxID,
-			PatternID:    item.PatternID,
-			Name:         item.Name,
-			Detail:       item.Detail,
-			LearnedDate:  item.LearnedDate.Format("2006-01-02"),
-			IsFinished:   item.IsFinished,
-			RegisteredAt: item.RegisteredAt,
-			EditedAt:     item.EditedAt,
-			ReviewDates:  reviewdatesByItem[item.ItemID],
+			ItemID:         item.ItemID,
+			UserID:         item.UserID,
+			CategoryID:     item.CategoryID,
+			BoxID:          item.BoxID,
+			PatternID:      item.PatternID,
+			PatternVersion: item.PatternVersion,
+			Name:           item.Name,
+			Detail:         item.Detail,
+			LearnedDate:    item.LearnedDate.Format("2006-01-02"),
+			IsFinished:     item.IsFinished,
+			RegisteredAt:   item.RegisteredAt,
+			EditedAt:       item.EditedAt,
+			ReviewDates:    reviewdatesByItem[item.ItemID],
 		})
 	}
 	return result, nil
 }
 
-func (iu *ItemUsecase) GetUnclassfiedFinishedItemsByCategoryID(ctx context.Context, userID string, categoryID string) ([]*GetItemOutput, error) {
+func (iu *ItemUsecase) GetUnclassfiedFinishedItemsByCategoryID(ctx context.Context, userID string, categoryID string, tagID string) ([]*GetItemOutput, error) {
 	items, err := iu.itemRepo.GetUnclassfiedFinishedItemsByCategoryID(ctx, categoryID, userID)
 	if err != nil {
 		return nil, err
 	}
+	items, err = iu.filterItemsByTag(ctx, items, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	// 復習物IDをキーに完了済み復習物をマップ化
 	finishedItemMap := make(map[string]struct{}, len(items))
@@ -1651,28 +3064,33 @@ func (iu *ItemUsecase) GetUnclassfiedFinishedItemsByCategoryID(ctx context.Conte
 	result := make([]*GetItemOutput, 0, len(items))
 	for _, item := range items {
 		result = append(result, &GetItemOutput{
-			ItemID:       item.ItemID,
-			UserID:       item.UserID,
-			CategoryID:   item.CategoryID,
-			BoxID:        item.BoxID,
-			PatternID:    item.PatternID,
-			Name:         item.Name,
-			Detail:       item.Detail,
-			LearnedDate:  item.LearnedDate.Format("2006-01-02"),
-			IsFinished:   item.IsFinished,
-			RegisteredAt: item.RegisteredAt,
-			EditedAt:     item.EditedAt,
-			ReviewDates:  reviewdatesByItem[item.ItemID],
+			ItemID:         item.ItemID,
+			UserID:         item.UserID,
+			CategoryID:     item.CategoryID,
+			BoxID:          item.BoxID,
+			PatternID:      item.PatternID,
+			PatternVersion: item.PatternVersion,
+			Name:           item.Name,
+			Detail:         item.Detail,
+			LearnedDate:    item.LearnedDate.Format("2006-01-02"),
+			IsFinished:     item.IsFinished,
+			RegisteredAt:   item.RegisteredAt,
+			EditedAt:       item.EditedAt,
+			ReviewDates:    reviewdatesByItem[item.ItemID],
 		})
 	}
 	return result, nil
 }
 
-func (iu *ItemUsecase) GetUnclassfiedFinishedItemsByUserID(ctx context.Context, userID string) ([]*GetItemOutput, error) {
+func (iu *ItemUsecase) GetUnclassfiedFinishedItemsByUserID(ctx context.Context, userID string, tagID string) ([]*GetItemOutput, error) {
 	items, err := iu.itemRepo.GetUnclassfiedFinishedItemsByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	items, err = iu.filterItemsByTag(ctx, items, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	// 復習物IDをキーに完了済み復習物をマップ化
 	finishedItemMap := make(map[string]struct{}, len(items))
@@ -1709,18 +3127,19 @@ func (iu *ItemUsecase) GetUnclassfiedFinishedItemsByUserID(ctx context.Context,
 	result := make([]*GetItemOutput, 0, len(items))
 	for _, item := range items {
 		result = append(result, &GetItemOutput{
-			ItemID:       item.ItemID,
-			UserID:       item.UserID,
-			CategoryID:   item.CategoryID,
-			BoxID:        item.BoxID,
-			PatternID:    item.PatternID,
-			Name:         item.Name,
-			Detail:       item.Detail,
-			LearnedDate:  item.LearnedDate.Format("2006-01-02"),
-			IsFinished:   item.IsFinished,
-			RegisteredAt: item.RegisteredAt,
-			EditedAt:     item.EditedAt,
-			ReviewDates:  reviewdatesByItem[item.ItemID],
+			ItemID:         item.ItemID,
+			UserID:         item.UserID,
+			CategoryID:     item.CategoryID,
+			BoxID:          item.BoxID,
+			PatternID:      item.PatternID,
+			PatternVersion: item.PatternVersion,
+			Name:           item.Name,
+			Detail:         item.Detail,
+			LearnedDate:    item.LearnedDate.Format("2006-01-02"),
+			IsFinished:     item.IsFinished,
+			RegisteredAt:   item.RegisteredAt,
+			EditedAt:       item.EditedAt,
+			ReviewDates:    reviewdatesByItem[item.ItemID],
 		})
 	}
 	return result, nil
@@ -7,4 +7,8 @@ type IPatternUsecase interface {
 	GetPatternsByUserID(ctx context.Context, userID string) ([]*GetPatternOutput, error)
 	UpdatePattern(ctx context.Context, pattern UpdatePatternInput) (*UpdatePatternOutput, error)
 	DeletePattern(ctx context.Context, patternID string, userID string) error
+
+	// 組み込みの復習パターン
+	GetPatternPresets(ctx context.Context, userID string) ([]*GetPatternPresetOutput, error)
+	InstallPatternPreset(ctx context.Context, input InstallPatternPresetInput) (*CreatePatternOutput, error)
 }
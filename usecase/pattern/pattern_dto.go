@@ -8,10 +8,16 @@ type CreatePatternStepInput struct {
 }
 
 type CreatePatternInput struct {
-	UserID       string
-	Name         string
-	TargetWeight string
-	Steps        []CreatePatternStepInput
+	UserID                 string
+	Name                   string
+	TargetWeight           string
+	SchedulerKind          string
+	TargetRetention        float64
+	IntervalFuzz           bool
+	IntervalFromCompletion bool
+	OverduePolicy          string
+	OverdueSpreadDays      int
+	Steps                  []CreatePatternStepInput
 }
 
 type CreatePatternStepOutput struct {
@@ -23,13 +29,20 @@ type CreatePatternStepOutput struct {
 }
 
 type CreatePatternOutput struct {
-	ID           string
-	UserID       string
-	Name         string
-	TargetWeight string
-	RegisteredAt time.Time
-	EditedAt     time.Time
-	Steps        []CreatePatternStepOutput
+	ID                     string
+	UserID                 string
+	Name                   string
+	TargetWeight           string
+	SchedulerKind          string
+	TargetRetention        float64
+	IntervalFuzz           bool
+	IntervalFromCompletion bool
+	OverduePolicy          string
+	OverdueSpreadDays      int
+	Version                int
+	RegisteredAt           time.Time
+	EditedAt               time.Time
+	Steps                  []CreatePatternStepOutput
 }
 
 type GetPatternStepOutput struct {
@@ -40,13 +53,20 @@ type GetPatternStepOutput struct {
 }
 
 type GetPatternOutput struct {
-	PatternID    string
-	UserID       string
-	Name         string
-	TargetWeight string
-	RegisteredAt time.Time
-	EditedAt     time.Time
-	Steps        []GetPatternStepOutput
+	PatternID              string
+	UserID                 string
+	Name                   string
+	TargetWeight           string
+	SchedulerKind          string
+	TargetRetention        float64
+	IntervalFuzz           bool
+	IntervalFromCompletion bool
+	OverduePolicy          string
+	OverdueSpreadDays      int
+	Version                int // 最新のバージョン。復習物のPatternVersionと異なれば古いステップで復習日を計算している
+	RegisteredAt           time.Time
+	EditedAt               time.Time
+	Steps                  []GetPatternStepOutput
 }
 
 type UpdatePatternStepInput struct {
@@ -57,11 +77,19 @@ type UpdatePatternStepInput struct {
 }
 
 type UpdatePatternInput struct {
-	PatternID    string
-	UserID       string
-	Name         string
-	TargetWeight string
-	Steps        []UpdatePatternStepInput
+	PatternID              string
+	UserID                 string
+	Name                   string
+	TargetWeight           string
+	SchedulerKind          string
+	TargetRetention        float64
+	IntervalFuzz           *bool  // nilの場合は現在の設定を維持
+	IntervalFromCompletion *bool  // nilの場合は現在の設定を維持
+	OverduePolicy          string // 空の場合は現在の設定を維持
+	OverdueSpreadDays      int    // 0の場合は現在の設定を維持
+	Steps                  []UpdatePatternStepInput
+	StepMigration          string // 復習物が紐づいている場合のステップ変更の反映方法。空の場合はkeep_existingと同じ
+	Today                  string // StepMigrationがapply_to_futureの場合に必要
 }
 
 type UpdatePatternStepOutput struct {
@@ -73,11 +101,39 @@ type UpdatePatternStepOutput struct {
 }
 
 type UpdatePatternOutput struct {
-	PatternID    string
-	UserID       string
-	Name         string
+	PatternID              string
+	UserID                 string
+	Name                   string
+	TargetWeight           string
+	SchedulerKind          string
+	TargetRetention        float64
+	IntervalFuzz           bool
+	IntervalFromCompletion bool
+	OverduePolicy          string
+	OverdueSpreadDays      int
+	Version                int
+	RegisteredAt           time.Time
+	EditedAt               time.Time
+	Steps                  []UpdatePatternStepOutput
+	MigratedItemCount      int // ステップ変更を反映して復習日を計算し直した復習物の数
+}
+
+// 組み込みの復習パターン一覧取得用のDTO
+type PatternPresetStepOutput struct {
+	StepNumber   int
+	IntervalDays int
+}
+
+type GetPatternPresetOutput struct {
+	Key          string
+	Name         string // ユーザーの言語の名前
+	Description  string // ユーザーの言語の説明
 	TargetWeight string
-	RegisteredAt time.Time
-	EditedAt     time.Time
-	Steps        []UpdatePatternStepOutput
+	Steps        []PatternPresetStepOutput
+}
+
+// 組み込みの復習パターンを追加するリクエスト用のDTO（作成した復習パターンはCreatePatternOutputで返す）
+type InstallPatternPresetInput struct {
+	UserID    string
+	PresetKey string
 }
@@ -7,12 +7,14 @@ import (
 	"github.com/google/uuid"
 	itemDomain "github.com/minminseo/recall-setter/domain/item"
 	patternDomain "github.com/minminseo/recall-setter/domain/pattern"
+	userDomain "github.com/minminseo/recall-setter/domain/user"
 	"github.com/minminseo/recall-setter/usecase/transaction"
 )
 
 type patternUsecase struct {
 	patternRepo patternDomain.IPatternRepository
 	itemRepo    itemDomain.IItemRepository
+	userRepo    userDomain.UserRepository
 	// ここでtransactionManagerを使うのは、patternとpatternStepを同一トランザクションで永続化するため。
 	transactionManeger transaction.ITransactionManager
 }
@@ -20,11 +22,13 @@ type patternUsecase struct {
 func NewPatternUsecase(
 	patternRepo patternDomain.IPatternRepository,
 	itemRepo itemDomain.IItemRepository,
+	userRepo userDomain.UserRepository,
 	transactionManeger transaction.ITransactionManager,
 ) IPatternUsecase {
 	return &patternUsecase{
 		patternRepo:        patternRepo,
 		itemRepo:           itemRepo,
+		userRepo:           userRepo,
 		transactionManeger: transactionManeger,
 	}
 }
@@ -32,6 +36,19 @@ func NewPatternUsecase(
 func (pu *patternUsecase) CreatePattern(ctx context.Context, in CreatePatternInput) (*CreatePatternOutput, error) {
 	patternID := uuid.NewString()
 
+	schedulerKind := schedulerKindOrDefault(in.SchedulerKind)
+	targetRetention := targetRetentionOrDefault(in.TargetRetention)
+	overduePolicy := overduePolicyOrDefault(in.OverduePolicy)
+	overdueSpreadDays := overdueSpreadDaysOrDefault(in.OverdueSpreadDays)
+	// FSRS方式では復習ステップを目標記憶保持率から自動生成する
+	if schedulerKind == patternDomain.SchedulerKindFSRS {
+		intervalDays := itemDomain.FSRSProjectedIntervalDays(targetRetention, itemDomain.FSRSDefaultReviewCount)
+		in.Steps = make([]CreatePatternStepInput, len(intervalDays))
+		for i, days := range intervalDays {
+			in.Steps[i] = CreatePatternStepInput{StepNumber: i + 1, IntervalDays: days}
+		}
+	}
+
 	registeredAt := time.Now().UTC()
 	editedAt := registeredAt
 
@@ -40,6 +57,12 @@ func (pu *patternUsecase) CreatePattern(ctx context.Context, in CreatePatternInp
 		in.UserID,
 		in.Name,
 		in.TargetWeight,
+		schedulerKind,
+		targetRetention,
+		in.IntervalFuzz,
+		in.IntervalFromCompletion,
+		overduePolicy,
+		overdueSpreadDays,
 		registeredAt,
 		editedAt,
 	)
@@ -56,6 +79,7 @@ func (pu *patternUsecase) CreatePattern(ctx context.Context, in CreatePatternInp
 			patternID,
 			s.StepNumber,
 			s.IntervalDays,
+			newPattern.Version,
 		)
 		if err != nil {
 			return nil, err
@@ -88,12 +112,19 @@ func (pu *patternUsecase) CreatePattern(ctx context.Context, in CreatePatternInp
 	}
 
 	out := &CreatePatternOutput{
-		ID:           newPattern.PatternID,
-		UserID:       newPattern.UserID,
-		Name:         newPattern.Name,
-		TargetWeight: string(newPattern.TargetWeight),
-		RegisteredAt: newPattern.RegisteredAt,
-		EditedAt:     newPattern.EditedAt,
+		ID:                     newPattern.PatternID,
+		UserID:                 newPattern.UserID,
+		Name:                   newPattern.Name,
+		TargetWeight:           string(newPattern.TargetWeight),
+		SchedulerKind:          newPattern.SchedulerKind,
+		TargetRetention:        newPattern.TargetRetention,
+		IntervalFuzz:           newPattern.IntervalFuzz,
+		IntervalFromCompletion: newPattern.IntervalFromCompletion,
+		OverduePolicy:          newPattern.OverduePolicy,
+		OverdueSpreadDays:      newPattern.OverdueSpreadDays,
+		Version:                newPattern.Version,
+		RegisteredAt:           newPattern.RegisteredAt,
+		EditedAt:               newPattern.EditedAt,
 	}
 	out.Steps = make([]CreatePatternStepOutput, len(newSteps))
 	for i, ps := range newSteps {
@@ -136,13 +167,20 @@ func (pu *patternUsecase) GetPatternsByUserID(ctx context.Context, userID string
 	result = make([]*GetPatternOutput, 0, len(allPatterns))
 	for _, domainPattern := range allPatterns {
 		patternOutput := &GetPatternOutput{
-			PatternID:    domainPattern.PatternID,
-			UserID:       domainPattern.UserID,
-			Name:         domainPattern.Name,
-			TargetWeight: domainPattern.TargetWeight,
-			RegisteredAt: domainPattern.RegisteredAt,
-			EditedAt:     domainPattern.EditedAt,
-			Steps:        stepsByPattern[domainPattern.PatternID],
+			PatternID:              domainPattern.PatternID,
+			UserID:                 domainPattern.UserID,
+			Name:                   domainPattern.Name,
+			TargetWeight:           domainPattern.TargetWeight,
+			SchedulerKind:          domainPattern.SchedulerKind,
+			TargetRetention:        domainPattern.TargetRetention,
+			IntervalFuzz:           domainPattern.IntervalFuzz,
+			IntervalFromCompletion: domainPattern.IntervalFromCompletion,
+			OverduePolicy:          domainPattern.OverduePolicy,
+			OverdueSpreadDays:      domainPattern.OverdueSpreadDays,
+			Version:                domainPattern.Version,
+			RegisteredAt:           domainPattern.RegisteredAt,
+			EditedAt:               domainPattern.EditedAt,
+			Steps:                  stepsByPattern[domainPattern.PatternID],
 		}
 		result = append(result, patternOutput)
 	}
@@ -151,6 +189,13 @@ func (pu *patternUsecase) GetPatternsByUserID(ctx context.Context, userID string
 }
 
 func (pu *patternUsecase) UpdatePattern(ctx context.Context, input UpdatePatternInput) (*UpdatePatternOutput, error) {
+	// 今日以降の復習日に反映する場合は、その基準になる今日の日付が必要
+	if input.StepMigration == patternDomain.StepMigrationApplyToFuture {
+		if _, err := time.Parse("2006-01-02", input.Today); err != nil {
+			return nil, patternDomain.ErrInvalidStepMigrationToday
+		}
+	}
+
 	targetPattern, err := pu.patternRepo.FindPatternByPatternID(ctx, input.PatternID, input.UserID)
 	if err != nil {
 		return nil, err
@@ -160,16 +205,59 @@ func (pu *patternUsecase) UpdatePattern(ctx context.Context, input UpdatePattern
 		return nil, err
 	}
 
+	// スケジューリング方式、目標記憶保持率、間隔の揺らぎ、間隔の起点、期限切れの復習日の扱い方の指定がなければ現在の設定を維持
+	schedulerKind := input.SchedulerKind
+	if schedulerKind == "" {
+		schedulerKind = targetPattern.SchedulerKind
+	}
+	targetRetention := input.TargetRetention
+	if targetRetention == 0 {
+		targetRetention = targetPattern.TargetRetention
+	}
+	intervalFuzz := targetPattern.IntervalFuzz
+	if input.IntervalFuzz != nil {
+		intervalFuzz = *input.IntervalFuzz
+	}
+	intervalFromCompletion := targetPattern.IntervalFromCompletion
+	if input.IntervalFromCompletion != nil {
+		intervalFromCompletion = *input.IntervalFromCompletion
+	}
+	overduePolicy := input.OverduePolicy
+	if overduePolicy == "" {
+		overduePolicy = targetPattern.OverduePolicy
+	}
+	overdueSpreadDays := input.OverdueSpreadDays
+	if overdueSpreadDays == 0 {
+		overdueSpreadDays = targetPattern.OverdueSpreadDays
+	}
+	// FSRS方式では復習ステップを目標記憶保持率から自動生成する
+	if schedulerKind == patternDomain.SchedulerKindFSRS {
+		intervalDays := itemDomain.FSRSProjectedIntervalDays(targetRetention, itemDomain.FSRSDefaultReviewCount)
+		input.Steps = make([]UpdatePatternStepInput, len(intervalDays))
+		for i, days := range intervalDays {
+			input.Steps[i] = UpdatePatternStepInput{PatternID: input.PatternID, StepNumber: i + 1, IntervalDays: days}
+		}
+	}
+
 	// 変更部分の判定
 	// pattern
-	isPatternChanged := targetPattern.Name != input.Name || targetPattern.TargetWeight != input.TargetWeight
+	isPatternChanged := targetPattern.Name != input.Name ||
+		targetPattern.TargetWeight != input.TargetWeight ||
+		targetPattern.SchedulerKind != schedulerKind ||
+		targetPattern.TargetRetention != targetRetention ||
+		targetPattern.IntervalFuzz != intervalFuzz ||
+		targetPattern.IntervalFromCompletion != intervalFromCompletion ||
+		targetPattern.OverduePolicy != overduePolicy ||
+		targetPattern.OverdueSpreadDays != overdueSpreadDays
 
 	// steps
 	isStepsChanged := len(targetPatternSteps) != len(input.Steps)
-	for i := range targetPatternSteps {
-		if targetPatternSteps[i].IntervalDays != input.Steps[i].IntervalDays {
-			isStepsChanged = true
-			break
+	if !isStepsChanged {
+		for i := range targetPatternSteps {
+			if targetPatternSteps[i].IntervalDays != input.Steps[i].IntervalDays {
+				isStepsChanged = true
+				break
+			}
 		}
 	}
 
@@ -177,6 +265,8 @@ func (pu *patternUsecase) UpdatePattern(ctx context.Context, input UpdatePattern
 		return nil, patternDomain.ErrNoDiff
 	}
 
+	isMigrateItems := false
+	isBumpVersion := false
 	if isStepsChanged {
 		hasItemByPatternID := false
 		hasItemByPatternID, err = pu.itemRepo.IsPatternRelatedToItemByPatternID(ctx, input.PatternID, input.UserID)
@@ -184,17 +274,39 @@ func (pu *patternUsecase) UpdatePattern(ctx context.Context, input UpdatePattern
 			return nil, err
 		}
 		if hasItemByPatternID {
-			return nil, patternDomain.ErrPatternRelatedToItemUpdate
+			// FSRS方式のステップは復習日の初回の見積もりにすぎないため、復習物が紐づいている場合はステップを据え置いて目標記憶保持率だけ更新する
+			if schedulerKind == patternDomain.SchedulerKindFSRS {
+				isStepsChanged = false
+			} else {
+				// 既存の復習物が今のステップを参照し続けられるように、新しいバージョンとしてステップを作る。
+				// 指定がなければ既存の復習物は今のバージョンのまま残す
+				isBumpVersion = true
+				switch input.StepMigration {
+				case patternDomain.StepMigrationApplyToFuture:
+					// 既存の復習日に新しいステップを反映するのは、ステップの間隔どおりに復習日が決まる方式だけ。
+					// 想起度や箱で間隔が変わる方式と、復習物ごとに間隔を揺らすパターンでは、ステップだけからは復習日を決められない
+					if schedulerKind != patternDomain.SchedulerKindFixedSteps || intervalFuzz {
+						return nil, patternDomain.ErrStepMigrationNotSupported
+					}
+					isMigrateItems = true
+				case patternDomain.StepMigrationKeepExisting, "":
+				default:
+					return nil, patternDomain.ErrInvalidStepMigration
+				}
+			}
 		}
 	}
 
+	editedAt := time.Now().UTC()
 	if isPatternChanged {
-		editedAt := time.Now().UTC()
-		err = targetPattern.Set(input.Name, input.TargetWeight, editedAt)
+		err = targetPattern.Set(input.Name, input.TargetWeight, schedulerKind, targetRetention, intervalFuzz, intervalFromCompletion, overduePolicy, overdueSpreadDays, editedAt)
 		if err != nil {
 			return nil, err
 		}
 	}
+	if isBumpVersion {
+		targetPattern.BumpVersion(editedAt)
+	}
 
 	var newSteps []*patternDomain.PatternStep
 	if isStepsChanged {
@@ -207,6 +319,7 @@ func (pu *patternUsecase) UpdatePattern(ctx context.Context, input UpdatePattern
 				input.PatternID,
 				s.StepNumber,
 				s.IntervalDays,
+				targetPattern.Version,
 			)
 			if err != nil {
 				return nil, err
@@ -219,21 +332,33 @@ func (pu *patternUsecase) UpdatePattern(ctx context.Context, input UpdatePattern
 		}
 	}
 
+	// 紐づく復習物の未完了かつ今日以降の復習日に新しいステップを反映する
+	var migrations map[string]*itemDomain.StepMigration
+	if isMigrateItems {
+		migrations, err = pu.migrateItemsToSteps(ctx, input, newSteps)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// patternとstepは別テーブルなので同一トランザクションで永続化
 	err = pu.transactionManeger.RunInTransaction(ctx, func(ctx context.Context) error {
-		// パターンに変更がある場合、パターンを更新
-		if isPatternChanged {
+		// パターンに変更がある場合、またはバージョンを上げる場合、パターンを更新
+		if isPatternChanged || isBumpVersion {
 			err = pu.patternRepo.UpdatePattern(ctx, targetPattern)
 			if err != nil {
 				return err
 			}
 		}
 
-		// ステップに変更がある場合、古いステップを一括削除→新しいステップを一括挿入
+		// ステップに変更がある場合、新しいステップを一括挿入
+		// 復習物が紐づいていなければ古いステップを参照する復習物はないので、古いステップを一括削除して同じバージョンのまま置き換える
 		if isStepsChanged {
-			err = pu.patternRepo.DeletePatternSteps(ctx, input.PatternID, input.UserID)
-			if err != nil {
-				return err
+			if !isBumpVersion {
+				err = pu.patternRepo.DeletePatternSteps(ctx, input.PatternID, input.UserID)
+				if err != nil {
+					return err
+				}
 			}
 
 			// "_"←はCopyfromの返り値の、「挿入された行数」。使わないのでブランク識別子にする。
@@ -241,6 +366,39 @@ func (pu *patternUsecase) UpdatePattern(ctx context.Context, input UpdatePattern
 				return err
 			}
 		}
+
+		// 新しいステップを反映した未完了の復習物は新しいバージョンに上げる
+		if isMigrateItems {
+			err = pu.itemRepo.UpdatePatternVersionOfUnFinishedItems(ctx, input.PatternID, targetPattern.Version, input.UserID)
+			if err != nil {
+				return err
+			}
+		}
+		for itemID, migration := range migrations {
+			if len(migration.UpdatedReviewdates) > 0 {
+				err = pu.itemRepo.UpdateReviewDates(ctx, migration.UpdatedReviewdates, input.UserID)
+				if err != nil {
+					return err
+				}
+			}
+			if len(migration.CreatedReviewdates) > 0 {
+				if _, err := pu.itemRepo.CreateReviewdates(ctx, migration.CreatedReviewdates); err != nil {
+					return err
+				}
+			}
+			if len(migration.DeletedReviewdateIDs) > 0 {
+				err = pu.itemRepo.DeleteReviewDatesByIDs(ctx, migration.DeletedReviewdateIDs, input.UserID)
+				if err != nil {
+					return err
+				}
+			}
+			if migration.IsFinished {
+				err = pu.itemRepo.UpdateItemAsFinished(ctx, itemID, input.UserID, time.Now().UTC())
+				if err != nil {
+					return err
+				}
+			}
+		}
 		return nil
 	})
 	if err != nil {
@@ -248,12 +406,20 @@ func (pu *patternUsecase) UpdatePattern(ctx context.Context, input UpdatePattern
 	}
 
 	resPattern := &UpdatePatternOutput{
-		PatternID:    targetPattern.PatternID,
-		UserID:       targetPattern.UserID,
-		Name:         targetPattern.Name,
-		TargetWeight: targetPattern.TargetWeight,
-		RegisteredAt: targetPattern.RegisteredAt,
-		EditedAt:     targetPattern.EditedAt,
+		PatternID:              targetPattern.PatternID,
+		UserID:                 targetPattern.UserID,
+		Name:                   targetPattern.Name,
+		TargetWeight:           targetPattern.TargetWeight,
+		SchedulerKind:          targetPattern.SchedulerKind,
+		TargetRetention:        targetPattern.TargetRetention,
+		IntervalFuzz:           targetPattern.IntervalFuzz,
+		IntervalFromCompletion: targetPattern.IntervalFromCompletion,
+		OverduePolicy:          targetPattern.OverduePolicy,
+		OverdueSpreadDays:      targetPattern.OverdueSpreadDays,
+		Version:                targetPattern.Version,
+		RegisteredAt:           targetPattern.RegisteredAt,
+		EditedAt:               targetPattern.EditedAt,
+		MigratedItemCount:      len(migrations),
 	}
 	resPattern.Steps = make([]UpdatePatternStepOutput, len(newSteps))
 	for i, s := range newSteps {
@@ -288,3 +454,138 @@ func (pu *patternUsecase) DeletePattern(ctx context.Context, patternID, userID s
 	}
 	return nil
 }
+
+// 組み込みの復習パターンをユーザーの言語で返す
+func (pu *patternUsecase) GetPatternPresets(ctx context.Context, userID string) ([]*GetPatternPresetOutput, error) {
+	user, err := pu.userRepo.GetSettingByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	language := user.Language
+
+	presets := patternDomain.PatternPresets()
+	res := make([]*GetPatternPresetOutput, len(presets))
+	for i, preset := range presets {
+		steps := make([]PatternPresetStepOutput, len(preset.IntervalDays))
+		for j, days := range preset.IntervalDays {
+			steps[j] = PatternPresetStepOutput{
+				StepNumber:   j + 1,
+				IntervalDays: days,
+			}
+		}
+		res[i] = &GetPatternPresetOutput{
+			Key:          preset.Key,
+			Name:         preset.Name(language),
+			Description:  preset.Description(language),
+			TargetWeight: preset.TargetWeight,
+			Steps:        steps,
+		}
+	}
+	return res, nil
+}
+
+// 組み込みの復習パターンを、ユーザーの言語の名前でユーザーの復習パターンとして作成する
+func (pu *patternUsecase) InstallPatternPreset(ctx context.Context, input InstallPatternPresetInput) (*CreatePatternOutput, error) {
+	preset, err := patternDomain.FindPatternPreset(input.PresetKey)
+	if err != nil {
+		return nil, err
+	}
+	user, err := pu.userRepo.GetSettingByID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	language := user.Language
+
+	steps := make([]CreatePatternStepInput, len(preset.IntervalDays))
+	for i, days := range preset.IntervalDays {
+		steps[i] = CreatePatternStepInput{
+			StepNumber:   i + 1,
+			IntervalDays: days,
+		}
+	}
+	return pu.CreatePattern(ctx, CreatePatternInput{
+		UserID:       input.UserID,
+		Name:         preset.Name(language),
+		TargetWeight: preset.TargetWeight,
+		Steps:        steps,
+	})
+}
+
+// パターンに紐づく未完了の復習物ごとに、新しいステップを反映した結果を計算する（変更がない復習物は含めない）
+func (pu *patternUsecase) migrateItemsToSteps(ctx context.Context, input UpdatePatternInput, newSteps []*patternDomain.PatternStep) (map[string]*itemDomain.StepMigration, error) {
+	parsedToday, err := time.Parse("2006-01-02", input.Today)
+	if err != nil {
+		return nil, err
+	}
+	items, err := pu.itemRepo.GetUnFinishedItemsByPatternID(ctx, input.PatternID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	reviewdates, err := pu.itemRepo.GetReviewDatesOfUnFinishedItemsByPatternID(ctx, input.PatternID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := pu.userRepo.GetRestDaysByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	reviewLimit, err := pu.userRepo.GetReviewLimitByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// 反映する復習物の復習日も含めて数えるため、除外する復習物は指定しない
+	counts, err := pu.itemRepo.CountIncompleteReviewDatesByUserID(ctx, input.UserID, nil)
+	if err != nil {
+		return nil, err
+	}
+	load := itemDomain.NewReviewLoad(reviewLimit.MaxReviewsPerDay, counts)
+
+	reviewdatesByItemID := make(map[string][]*itemDomain.Reviewdate)
+	for _, rd := range reviewdates {
+		reviewdatesByItemID[rd.ItemID] = append(reviewdatesByItemID[rd.ItemID], rd)
+	}
+
+	migrations := make(map[string]*itemDomain.StepMigration)
+	for _, item := range items {
+		migration, err := itemDomain.MigrateReviewdatesToSteps(item, reviewdatesByItemID[item.ItemID], newSteps, parsedToday, calendar, load)
+		if err != nil {
+			return nil, err
+		}
+		if migration.IsChanged() {
+			migrations[item.ItemID] = migration
+		}
+	}
+	return migrations, nil
+}
+
+// スケジューリング方式の指定がない場合は従来の固定ステップ方式とする
+func schedulerKindOrDefault(schedulerKind string) string {
+	if schedulerKind == "" {
+		return patternDomain.SchedulerKindFixedSteps
+	}
+	return schedulerKind
+}
+
+// 目標記憶保持率の指定がない場合はデフォルト値とする
+func targetRetentionOrDefault(targetRetention float64) float64 {
+	if targetRetention == 0 {
+		return patternDomain.DefaultTargetRetention
+	}
+	return targetRetention
+}
+
+// 期限切れの復習日の扱い方の指定がない場合は従来通り全ての復習日をずらす
+func overduePolicyOrDefault(overduePolicy string) string {
+	if overduePolicy == "" {
+		return patternDomain.OverduePolicySlideAll
+	}
+	return overduePolicy
+}
+
+// 期限切れの復習物を振り分ける日数の指定がない場合はデフォルト値とする
+func overdueSpreadDaysOrDefault(overdueSpreadDays int) int {
+	if overdueSpreadDays == 0 {
+		return patternDomain.DefaultOverdueSpreadDays
+	}
+	return overdueSpreadDays
+}
@@ -0,0 +1,14 @@
+package tag
+
+import "context"
+
+type ITagUsecase interface {
+	CreateTag(ctx context.Context, tag CreateTagInput) (*CreateTagOutput, error)
+	GetTagsByUserID(ctx context.Context, userID string) ([]*GetTagOutput, error)
+	UpdateTag(ctx context.Context, tag UpdateTagInput) (*UpdateTagOutput, error)
+	DeleteTag(ctx context.Context, tagID string, userID string) error
+
+	// 復習物に付いているタグ
+	GetTagsByItemID(ctx context.Context, itemID string, userID string) ([]*GetTagOutput, error)
+	SetItemTags(ctx context.Context, input SetItemTagsInput) ([]*GetTagOutput, error)
+}
@@ -0,0 +1,43 @@
+package tag
+
+import "time"
+
+type CreateTagInput struct {
+	UserID string
+	Name   string
+}
+
+type CreateTagOutput struct {
+	ID           string
+	UserID       string
+	Name         string
+	RegisteredAt time.Time
+	EditedAt     time.Time
+}
+
+type GetTagOutput struct {
+	ID           string
+	UserID       string
+	Name         string
+	RegisteredAt time.Time
+	EditedAt     time.Time
+}
+
+type UpdateTagInput struct {
+	ID     string
+	UserID string
+	Name   string
+}
+
+type UpdateTagOutput struct {
+	ID       string
+	UserID   string
+	Name     string
+	EditedAt time.Time
+}
+
+type SetItemTagsInput struct {
+	ItemID string
+	UserID string
+	TagIDs []string
+}
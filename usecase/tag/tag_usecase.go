@@ -0,0 +1,181 @@
+package tag
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	tagDomain "github.com/minminseo/recall-setter/domain/tag"
+	"github.com/minminseo/recall-setter/usecase/transaction"
+)
+
+type tagUsecase struct {
+	tagRepo tagDomain.ITagRepository
+	// 復習物のタグを付け直す時に、外す処理と付ける処理を同一トランザクションで行うため。
+	transactionManager transaction.ITransactionManager
+}
+
+func NewTagUsecase(
+	tagRepo tagDomain.ITagRepository,
+	transactionManager transaction.ITransactionManager,
+) ITagUsecase {
+	return &tagUsecase{
+		tagRepo:            tagRepo,
+		transactionManager: transactionManager,
+	}
+}
+
+func (tu *tagUsecase) CreateTag(ctx context.Context, input CreateTagInput) (*CreateTagOutput, error) {
+	id := uuid.NewString()
+	registeredAt := time.Now().UTC()
+	editedAt := registeredAt
+
+	newTag, err := tagDomain.NewTag(id, input.UserID, input.Name, registeredAt, editedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := tu.tagRepo.GetAllByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tagDomain.ValidateUniqueName(tags, newTag.Name, ""); err != nil {
+		return nil, err
+	}
+
+	err = tu.tagRepo.Create(ctx, newTag)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &CreateTagOutput{
+		ID:           newTag.ID,
+		UserID:       newTag.UserID,
+		Name:         newTag.Name,
+		RegisteredAt: newTag.RegisteredAt,
+		EditedAt:     newTag.EditedAt,
+	}
+	return res, nil
+}
+
+func (tu *tagUsecase) GetTagsByUserID(ctx context.Context, userID string) ([]*GetTagOutput, error) {
+	tags, err := tu.tagRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toGetTagOutputs(tags), nil
+}
+
+func (tu *tagUsecase) UpdateTag(ctx context.Context, input UpdateTagInput) (*UpdateTagOutput, error) {
+	tags, err := tu.tagRepo.GetAllByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var targetTag *tagDomain.Tag
+	for _, t := range tags {
+		if t.ID == input.ID {
+			targetTag = t
+			break
+		}
+	}
+	if targetTag == nil {
+		return nil, tagDomain.ErrTagNotFound
+	}
+
+	editedAt := time.Now().UTC()
+	if err := targetTag.Set(input.Name, editedAt); err != nil {
+		return nil, err
+	}
+	if err := tagDomain.ValidateUniqueName(tags, targetTag.Name, targetTag.ID); err != nil {
+		return nil, err
+	}
+
+	err = tu.tagRepo.Update(ctx, targetTag)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &UpdateTagOutput{
+		ID:       targetTag.ID,
+		UserID:   targetTag.UserID,
+		Name:     targetTag.Name,
+		EditedAt: targetTag.EditedAt,
+	}
+	return res, nil
+}
+
+// タグを削除すると、復習物との紐付けもまとめて削除される
+func (tu *tagUsecase) DeleteTag(ctx context.Context, tagID string, userID string) error {
+	return tu.tagRepo.Delete(ctx, tagID, userID)
+}
+
+func (tu *tagUsecase) GetTagsByItemID(ctx context.Context, itemID string, userID string) ([]*GetTagOutput, error) {
+	exists, err := tu.tagRepo.ExistsItemByID(ctx, itemID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, tagDomain.ErrItemNotFound
+	}
+
+	tags, err := tu.tagRepo.GetTagsByItemID(ctx, itemID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toGetTagOutputs(tags), nil
+}
+
+// 復習物のタグをTagIDsのタグに置き換える（空の場合は全て外す）
+func (tu *tagUsecase) SetItemTags(ctx context.Context, input SetItemTagsInput) ([]*GetTagOutput, error) {
+	exists, err := tu.tagRepo.ExistsItemByID(ctx, input.ItemID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, tagDomain.ErrItemNotFound
+	}
+
+	tags, err := tu.tagRepo.GetAllByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	tagIDs, err := tagDomain.ValidateItemTagIDs(tags, input.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		return tu.tagRepo.ReplaceItemTags(ctx, input.ItemID, input.UserID, tagIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 付けたタグをタグ一覧と同じ順（登録順）で返す
+	selected := make(map[string]struct{}, len(tagIDs))
+	for _, id := range tagIDs {
+		selected[id] = struct{}{}
+	}
+	itemTags := make([]*tagDomain.Tag, 0, len(tagIDs))
+	for _, t := range tags {
+		if _, ok := selected[t.ID]; ok {
+			itemTags = append(itemTags, t)
+		}
+	}
+	return toGetTagOutputs(itemTags), nil
+}
+
+func toGetTagOutputs(tags []*tagDomain.Tag) []*GetTagOutput {
+	out := make([]*GetTagOutput, len(tags))
+	for i, t := range tags {
+		out[i] = &GetTagOutput{
+			ID:           t.ID,
+			UserID:       t.UserID,
+			Name:         t.Name,
+			RegisteredAt: t.RegisteredAt,
+			EditedAt:     t.EditedAt,
+		}
+	}
+	return out
+}
@@ -0,0 +1,313 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	tagDomain "github.com/minminseo/recall-setter/domain/tag"
+	"github.com/minminseo/recall-setter/usecase/transaction"
+)
+
+func newTestTags() []*tagDomain.Tag {
+	registeredAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	return []*tagDomain.Tag{
+		{ID: "tag1", UserID: "user1", Name: "重要", RegisteredAt: registeredAt, EditedAt: registeredAt},
+		{ID: "tag2", UserID: "user1", Name: "苦手", RegisteredAt: registeredAt.Add(time.Hour), EditedAt: registeredAt.Add(time.Hour)},
+	}
+}
+
+func TestCreateTag(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		input     CreateTagInput
+		mockSetup func(*tagDomain.MockITagRepository)
+		wantErr   error
+	}{
+		{
+			name:  "正常系_タグ作成成功",
+			input: CreateTagInput{UserID: "user1", Name: "暗記"},
+			mockSetup: func(repo *tagDomain.MockITagRepository) {
+				gomock.InOrder(
+					repo.EXPECT().GetAllByUserID(ctx, "user1").Return(newTestTags(), nil).Times(1),
+					repo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1),
+				)
+			},
+		},
+		{
+			name:      "異常系_空のNameでの作成失敗",
+			input:     CreateTagInput{UserID: "user1", Name: ""},
+			mockSetup: func(repo *tagDomain.MockITagRepository) {},
+			wantErr:   errors.New("タグ名は必須です"),
+		},
+		{
+			name:  "異常系_同名のタグが既にある",
+			input: CreateTagInput{UserID: "user1", Name: "重要"},
+			mockSetup: func(repo *tagDomain.MockITagRepository) {
+				repo.EXPECT().GetAllByUserID(ctx, "user1").Return(newTestTags(), nil).Times(1)
+			},
+			wantErr: tagDomain.ErrDuplicateTagName,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := tagDomain.NewMockITagRepository(ctrl)
+			mockTransactionManager := transaction.NewMockITransactionManager(ctrl)
+			usecase := NewTagUsecase(mockRepo, mockTransactionManager)
+
+			tc.mockSetup(mockRepo)
+			got, err := usecase.CreateTag(ctx, tc.input)
+			if tc.wantErr != nil {
+				if err == nil || err.Error() != tc.wantErr.Error() {
+					t.Fatalf("CreateTag() error = %v, wantErr %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if got.ID == "" || got.UserID != tc.input.UserID || got.Name != tc.input.Name || got.RegisteredAt.IsZero() {
+				t.Errorf("CreateTag() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestUpdateTag(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		input     UpdateTagInput
+		mockSetup func(*tagDomain.MockITagRepository)
+		wantName  string
+		wantErr   error
+	}{
+		{
+			name:  "正常系_タグ名を更新",
+			input: UpdateTagInput{ID: "tag1", UserID: "user1", Name: "最重要"},
+			mockSetup: func(repo *tagDomain.MockITagRepository) {
+				gomock.InOrder(
+					repo.EXPECT().GetAllByUserID(ctx, "user1").Return(newTestTags(), nil).Times(1),
+					repo.EXPECT().Update(ctx, gomock.Any()).Return(nil).Times(1),
+				)
+			},
+			wantName: "最重要",
+		},
+		{
+			name:  "正常系_同じ名前のまま更新",
+			input: UpdateTagInput{ID: "tag1", UserID: "user1", Name: "重要"},
+			mockSetup: func(repo *tagDomain.MockITagRepository) {
+				gomock.InOrder(
+					repo.EXPECT().GetAllByUserID(ctx, "user1").Return(newTestTags(), nil).Times(1),
+					repo.EXPECT().Update(ctx, gomock.Any()).Return(nil).Times(1),
+				)
+			},
+			wantName: "重要",
+		},
+		{
+			name:  "異常系_他のタグと同名",
+			input: UpdateTagInput{ID: "tag1", UserID: "user1", Name: "苦手"},
+			mockSetup: func(repo *tagDomain.MockITagRepository) {
+				repo.EXPECT().GetAllByUserID(ctx, "user1").Return(newTestTags(), nil).Times(1)
+			},
+			wantErr: tagDomain.ErrDuplicateTagName,
+		},
+		{
+			name:  "異常系_存在しないタグ",
+			input: UpdateTagInput{ID: "unknown", UserID: "user1", Name: "暗記"},
+			mockSetup: func(repo *tagDomain.MockITagRepository) {
+				repo.EXPECT().GetAllByUserID(ctx, "user1").Return(newTestTags(), nil).Times(1)
+			},
+			wantErr: tagDomain.ErrTagNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := tagDomain.NewMockITagRepository(ctrl)
+			mockTransactionManager := transaction.NewMockITransactionManager(ctrl)
+			usecase := NewTagUsecase(mockRepo, mockTransactionManager)
+
+			tc.mockSetup(mockRepo)
+			got, err := usecase.UpdateTag(ctx, tc.input)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("UpdateTag() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			if got.ID != tc.input.ID || got.Name != tc.wantName || got.EditedAt.IsZero() {
+				t.Errorf("UpdateTag() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestSetItemTags(t *testing.T) {
+	ctx := context.Background()
+	tags := newTestTags()
+
+	tests := []struct {
+		name      string
+		input     SetItemTagsInput
+		mockSetup func(*tagDomain.MockITagRepository, *transaction.MockITransactionManager)
+		want      []*GetTagOutput
+		wantErr   error
+	}{
+		{
+			name:  "正常系_重複を除いて登録順で返す",
+			input: SetItemTagsInput{ItemID: "item1", UserID: "user1", TagIDs: []string{"tag2", "tag1", "tag2"}},
+			mockSetup: func(repo *tagDomain.MockITagRepository, txManager *transaction.MockITransactionManager) {
+				gomock.InOrder(
+					repo.EXPECT().ExistsItemByID(ctx, "item1", "user1").Return(true, nil).Times(1),
+					repo.EXPECT().GetAllByUserID(ctx, "user1").Return(tags, nil).Times(1),
+					txManager.EXPECT().RunInTransaction(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).Times(1),
+					repo.EXPECT().ReplaceItemTags(ctx, "item1", "user1", []string{"tag2", "tag1"}).Return(nil).Times(1),
+				)
+			},
+			want: []*GetTagOutput{
+				{ID: "tag1", UserID: "user1", Name: "重要", RegisteredAt: tags[0].RegisteredAt, EditedAt: tags[0].EditedAt},
+				{ID: "tag2", UserID: "user1", Name: "苦手", RegisteredAt: tags[1].RegisteredAt, EditedAt: tags[1].EditedAt},
+			},
+		},
+		{
+			name:  "正常系_タグを全て外す",
+			input: SetItemTagsInput{ItemID: "item1", UserID: "user1", TagIDs: []string{}},
+			mockSetup: func(repo *tagDomain.MockITagRepository, txManager *transaction.MockITransactionManager) {
+				gomock.InOrder(
+					repo.EXPECT().ExistsItemByID(ctx, "item1", "user1").Return(true, nil).Times(1),
+					repo.EXPECT().GetAllByUserID(ctx, "user1").Return(tags, nil).Times(1),
+					txManager.EXPECT().RunInTransaction(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).Times(1),
+					repo.EXPECT().ReplaceItemTags(ctx, "item1", "user1", []string{}).Return(nil).Times(1),
+				)
+			},
+			want: []*GetTagOutput{},
+		},
+		{
+			name:  "異常系_存在しない復習物",
+			input: SetItemTagsInput{ItemID: "unknown", UserID: "user1", TagIDs: []string{"tag1"}},
+			mockSetup: func(repo *tagDomain.MockITagRepository, txManager *transaction.MockITransactionManager) {
+				repo.EXPECT().ExistsItemByID(ctx, "unknown", "user1").Return(false, nil).Times(1)
+			},
+			wantErr: tagDomain.ErrItemNotFound,
+		},
+		{
+			name:  "異常系_ユーザーのタグにないID",
+			input: SetItemTagsInput{ItemID: "item1", UserID: "user1", TagIDs: []string{"tag1", "other-user-tag"}},
+			mockSetup: func(repo *tagDomain.MockITagRepository, txManager *transaction.MockITransactionManager) {
+				gomock.InOrder(
+					repo.EXPECT().ExistsItemByID(ctx, "item1", "user1").Return(true, nil).Times(1),
+					repo.EXPECT().GetAllByUserID(ctx, "user1").Return(tags, nil).Times(1),
+				)
+			},
+			wantErr: tagDomain.ErrTagNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := tagDomain.NewMockITagRepository(ctrl)
+			mockTransactionManager := transaction.NewMockITransactionManager(ctrl)
+			usecase := NewTagUsecase(mockRepo, mockTransactionManager)
+
+			tc.mockSetup(mockRepo, mockTransactionManager)
+			got, err := usecase.SetItemTags(ctx, tc.input)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("SetItemTags() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SetItemTags() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetTagsByItemID(t *testing.T) {
+	ctx := context.Background()
+	tags := newTestTags()
+
+	tests := []struct {
+		name      string
+		itemID    string
+		mockSetup func(*tagDomain.MockITagRepository)
+		want      []*GetTagOutput
+		wantErr   error
+	}{
+		{
+			name:   "正常系",
+			itemID: "item1",
+			mockSetup: func(repo *tagDomain.MockITagRepository) {
+				gomock.InOrder(
+					repo.EXPECT().ExistsItemByID(ctx, "item1", "user1").Return(true, nil).Times(1),
+					repo.EXPECT().GetTagsByItemID(ctx, "item1", "user1").Return(tags[1:], nil).Times(1),
+				)
+			},
+			want: []*GetTagOutput{
+				{ID: "tag2", UserID: "user1", Name: "苦手", RegisteredAt: tags[1].RegisteredAt, EditedAt: tags[1].EditedAt},
+			},
+		},
+		{
+			name:   "異常系_存在しない復習物",
+			itemID: "unknown",
+			mockSetup: func(repo *tagDomain.MockITagRepository) {
+				repo.EXPECT().ExistsItemByID(ctx, "unknown", "user1").Return(false, nil).Times(1)
+			},
+			wantErr: tagDomain.ErrItemNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := tagDomain.NewMockITagRepository(ctrl)
+			mockTransactionManager := transaction.NewMockITransactionManager(ctrl)
+			usecase := NewTagUsecase(mockRepo, mockTransactionManager)
+
+			tc.mockSetup(mockRepo)
+			got, err := usecase.GetTagsByItemID(ctx, tc.itemID, "user1")
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("GetTagsByItemID() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetTagsByItemID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
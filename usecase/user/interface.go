@@ -9,6 +9,12 @@ type IUserUsecase interface {
 	UpdateSetting(ctx context.Context, user UpdateUserInput) (*UpdateUserOutput, error)
 	UpdatePassword(ctx context.Context, userID, password string) error
 	VerifyEmail(ctx context.Context, input VerifyEmailInput) (*LoginUserOutput, error)
+	GetRestDays(ctx context.Context, userID string) (*GetRestDaysOutput, error)
+	UpdateRestDays(ctx context.Context, input UpdateRestDaysInput) (*UpdateRestDaysOutput, error)
+	GetReviewLimit(ctx context.Context, userID string) (*GetReviewLimitOutput, error)
+	UpdateReviewLimit(ctx context.Context, input UpdateReviewLimitInput) (*UpdateReviewLimitOutput, error)
+	PreviewVacation(ctx context.Context, input CreateVacationInput) (*PreviewVacationOutput, error)
+	CreateVacation(ctx context.Context, input CreateVacationInput) (*CreateVacationOutput, error)
 }
 
 type iEmailSender interface {
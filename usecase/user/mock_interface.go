@@ -40,6 +40,51 @@ func (m *MockIUserUsecase) EXPECT() *MockIUserUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CreateVacation mocks base method.
+func (m *MockIUserUsecase) CreateVacation(ctx context.Context, input CreateVacationInput) (*CreateVacationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVacation", ctx, input)
+	ret0, _ := ret[0].(*CreateVacationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVacation indicates an expected call of CreateVacation.
+func (mr *MockIUserUsecaseMockRecorder) CreateVacation(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVacation", reflect.TypeOf((*MockIUserUsecase)(nil).CreateVacation), ctx, input)
+}
+
+// GetRestDays mocks base method.
+func (m *MockIUserUsecase) GetRestDays(ctx context.Context, userID string) (*GetRestDaysOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRestDays", ctx, userID)
+	ret0, _ := ret[0].(*GetRestDaysOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRestDays indicates an expected call of GetRestDays.
+func (mr *MockIUserUsecaseMockRecorder) GetRestDays(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRestDays", reflect.TypeOf((*MockIUserUsecase)(nil).GetRestDays), ctx, userID)
+}
+
+// GetReviewLimit mocks base method.
+func (m *MockIUserUsecase) GetReviewLimit(ctx context.Context, userID string) (*GetReviewLimitOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewLimit", ctx, userID)
+	ret0, _ := ret[0].(*GetReviewLimitOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewLimit indicates an expected call of GetReviewLimit.
+func (mr *MockIUserUsecaseMockRecorder) GetReviewLimit(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewLimit", reflect.TypeOf((*MockIUserUsecase)(nil).GetReviewLimit), ctx, userID)
+}
+
 // GetUserSetting mocks base method.
 func (m *MockIUserUsecase) GetUserSetting(ctx context.Context, userID string) (*GetUserOutput, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +115,21 @@ func (mr *MockIUserUsecaseMockRecorder) LogIn(ctx, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogIn", reflect.TypeOf((*MockIUserUsecase)(nil).LogIn), ctx, user)
 }
 
+// PreviewVacation mocks base method.
+func (m *MockIUserUsecase) PreviewVacation(ctx context.Context, input CreateVacationInput) (*PreviewVacationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewVacation", ctx, input)
+	ret0, _ := ret[0].(*PreviewVacationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewVacation indicates an expected call of PreviewVacation.
+func (mr *MockIUserUsecaseMockRecorder) PreviewVacation(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewVacation", reflect.TypeOf((*MockIUserUsecase)(nil).PreviewVacation), ctx, input)
+}
+
 // SignUp mocks base method.
 func (m *MockIUserUsecase) SignUp(ctx context.Context, user CreateUserInput) (*CreateUserOutput, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +159,36 @@ func (mr *MockIUserUsecaseMockRecorder) UpdatePassword(ctx, userID, password any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockIUserUsecase)(nil).UpdatePassword), ctx, userID, password)
 }
 
+// UpdateRestDays mocks base method.
+func (m *MockIUserUsecase) UpdateRestDays(ctx context.Context, input UpdateRestDaysInput) (*UpdateRestDaysOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRestDays", ctx, input)
+	ret0, _ := ret[0].(*UpdateRestDaysOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRestDays indicates an expected call of UpdateRestDays.
+func (mr *MockIUserUsecaseMockRecorder) UpdateRestDays(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRestDays", reflect.TypeOf((*MockIUserUsecase)(nil).UpdateRestDays), ctx, input)
+}
+
+// UpdateReviewLimit mocks base method.
+func (m *MockIUserUsecase) UpdateReviewLimit(ctx context.Context, input UpdateReviewLimitInput) (*UpdateReviewLimitOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReviewLimit", ctx, input)
+	ret0, _ := ret[0].(*UpdateReviewLimitOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReviewLimit indicates an expected call of UpdateReviewLimit.
+func (mr *MockIUserUsecaseMockRecorder) UpdateReviewLimit(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewLimit", reflect.TypeOf((*MockIUserUsecase)(nil).UpdateReviewLimit), ctx, input)
+}
+
 // UpdateSetting mocks base method.
 func (m *MockIUserUsecase) UpdateSetting(ctx context.Context, user UpdateUserInput) (*UpdateUserOutput, error) {
 	m.ctrl.T.Helper()
@@ -50,3 +50,59 @@ type VerifyEmailInput struct {
 	Email string
 	Code  string
 }
+
+type GetRestDaysOutput struct {
+	Weekdays []int
+	Dates    []string
+}
+
+type UpdateRestDaysInput struct {
+	UserID   string
+	Weekdays []int
+	Dates    []string
+}
+
+type UpdateRestDaysOutput struct {
+	Weekdays []int
+	Dates    []string
+}
+
+type GetReviewLimitOutput struct {
+	MaxReviewsPerDay int
+}
+
+type UpdateReviewLimitInput struct {
+	UserID           string
+	MaxReviewsPerDay int
+}
+
+type UpdateReviewLimitOutput struct {
+	MaxReviewsPerDay int
+}
+
+type CreateVacationInput struct {
+	UserID    string
+	StartDate string
+	EndDate   string
+	Today     string
+}
+
+type VacationDailyLoadOutput struct {
+	Date   string
+	Before int
+	After  int
+}
+
+type PreviewVacationOutput struct {
+	StartDate  string
+	EndDate    string
+	ShiftDays  int
+	DailyLoads []VacationDailyLoadOutput
+}
+
+type CreateVacationOutput struct {
+	VacationID string
+	StartDate  string
+	EndDate    string
+	ShiftDays  int
+}
@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	userDomain "github.com/minminseo/recall-setter/domain/user"
@@ -287,3 +288,180 @@ func (uu *userUsecase) UpdatePassword(ctx context.Context, userID, password stri
 	}
 	return nil
 }
+
+func (uu *userUsecase) GetRestDays(ctx context.Context, userID string) (*GetRestDaysOutput, error) {
+	restDays, err := uu.userRepo.GetRestDaysByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	weekdays, dates := formatRestDays(restDays)
+	return &GetRestDaysOutput{
+		Weekdays: weekdays,
+		Dates:    dates,
+	}, nil
+}
+
+// 休息日は曜日・日付ともに丸ごと置き換える
+func (uu *userUsecase) UpdateRestDays(ctx context.Context, input UpdateRestDaysInput) (*UpdateRestDaysOutput, error) {
+	weekdays := make([]time.Weekday, len(input.Weekdays))
+	for i, wd := range input.Weekdays {
+		weekdays[i] = time.Weekday(wd)
+	}
+	dates := make([]time.Time, len(input.Dates))
+	for i, d := range input.Dates {
+		parsed, err := time.Parse("2006-01-02", d)
+		if err != nil {
+			return nil, err
+		}
+		dates[i] = parsed
+	}
+
+	restDays, err := userDomain.NewRestDays(input.UserID, weekdays, dates)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		return uu.userRepo.UpdateRestDays(ctx, restDays)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resWeekdays, resDates := formatRestDays(restDays)
+	return &UpdateRestDaysOutput{
+		Weekdays: resWeekdays,
+		Dates:    resDates,
+	}, nil
+}
+
+func (uu *userUsecase) GetReviewLimit(ctx context.Context, userID string) (*GetReviewLimitOutput, error) {
+	reviewLimit, err := uu.userRepo.GetReviewLimitByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &GetReviewLimitOutput{
+		MaxReviewsPerDay: reviewLimit.MaxReviewsPerDay,
+	}, nil
+}
+
+// 設定した上限は、以降に作成・再計算される復習日の負荷分散に使われる
+func (uu *userUsecase) UpdateReviewLimit(ctx context.Context, input UpdateReviewLimitInput) (*UpdateReviewLimitOutput, error) {
+	reviewLimit, err := userDomain.NewReviewLimit(input.UserID, input.MaxReviewsPerDay)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uu.userRepo.UpdateReviewLimit(ctx, reviewLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateReviewLimitOutput{
+		MaxReviewsPerDay: reviewLimit.MaxReviewsPerDay,
+	}, nil
+}
+
+// 休暇を登録せずに、登録した場合の日毎の未完了の復習数を見積もる
+func (uu *userUsecase) PreviewVacation(ctx context.Context, input CreateVacationInput) (*PreviewVacationOutput, error) {
+	vacation, err := parseVacationInput("", input)
+	if err != nil {
+		return nil, err
+	}
+
+	isOverlapped, err := uu.userRepo.HasOverlappingVacation(ctx, vacation.UserID, vacation.StartDate, vacation.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if isOverlapped {
+		return nil, userDomain.ErrVacationOverlapped
+	}
+
+	counts, err := uu.userRepo.CountIncompleteReviewDatesFromDate(ctx, vacation.UserID, vacation.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	restDays, err := uu.userRepo.GetRestDaysByUserID(ctx, vacation.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	loads := vacation.PreviewLoad(counts, restDays)
+	out := &PreviewVacationOutput{
+		StartDate:  vacation.StartDate.Format("2006-01-02"),
+		EndDate:    vacation.EndDate.Format("2006-01-02"),
+		ShiftDays:  vacation.Days(),
+		DailyLoads: make([]VacationDailyLoadOutput, len(loads)),
+	}
+	for i, l := range loads {
+		out.DailyLoads[i] = VacationDailyLoadOutput{
+			Date:   l.Date.Format("2006-01-02"),
+			Before: l.Before,
+			After:  l.After,
+		}
+	}
+	return out, nil
+}
+
+// 休暇を登録し、開始日以降の未完了の復習日を休暇の日数分だけずらす
+func (uu *userUsecase) CreateVacation(ctx context.Context, input CreateVacationInput) (*CreateVacationOutput, error) {
+	vacation, err := parseVacationInput(uuid.NewString(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	// 休暇の登録と復習日のずらしは同一トランザクションで行う
+	err = uu.transactionManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		isOverlapped, err := uu.userRepo.HasOverlappingVacation(ctx, vacation.UserID, vacation.StartDate, vacation.EndDate)
+		if err != nil {
+			return err
+		}
+		if isOverlapped {
+			return userDomain.ErrVacationOverlapped
+		}
+
+		if err := uu.userRepo.CreateVacation(ctx, vacation); err != nil {
+			return err
+		}
+		return uu.userRepo.ShiftIncompleteReviewDatesFromDate(ctx, vacation.UserID, vacation.StartDate, vacation.Days())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateVacationOutput{
+		VacationID: vacation.VacationID,
+		StartDate:  vacation.StartDate.Format("2006-01-02"),
+		EndDate:    vacation.EndDate.Format("2006-01-02"),
+		ShiftDays:  vacation.Days(),
+	}, nil
+}
+
+func parseVacationInput(vacationID string, input CreateVacationInput) (*userDomain.Vacation, error) {
+	startDate, err := time.Parse("2006-01-02", input.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := time.Parse("2006-01-02", input.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	today, err := time.Parse("2006-01-02", input.Today)
+	if err != nil {
+		return nil, err
+	}
+	return userDomain.NewVacation(vacationID, input.UserID, startDate, endDate, today)
+}
+
+func formatRestDays(restDays *userDomain.RestDays) ([]int, []string) {
+	weekdays := make([]int, len(restDays.Weekdays))
+	for i, wd := range restDays.Weekdays {
+		weekdays[i] = int(wd)
+	}
+	dates := make([]string, len(restDays.Dates))
+	for i, d := range restDays.Dates {
+		dates[i] = d.Format("2006-01-02")
+	}
+	return weekdays, dates
+}
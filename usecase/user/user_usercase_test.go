@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/crypto/bcrypt"
 
@@ -898,3 +900,638 @@ func TestUserUsecase_UpdatePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestUserUsecase_GetRestDays(t *testing.T) {
+	testID := "test-id"
+
+	tests := []struct {
+		name     string
+		mockFunc func(*userDomain.MockUserRepository)
+		want     *GetRestDaysOutput
+		wantErr  bool
+	}{
+		{
+			name: "休息日取得成功",
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository) {
+				restDays := &userDomain.RestDays{
+					UserID:   testID,
+					Weekdays: []time.Weekday{time.Sunday, time.Saturday},
+					Dates:    []time.Time{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+				}
+				mockUserRepo.EXPECT().
+					GetRestDaysByUserID(gomock.Any(), testID).
+					Return(restDays, nil).
+					Times(1)
+			},
+			want: &GetRestDaysOutput{
+				Weekdays: []int{0, 6},
+				Dates:    []string{"2024-01-01"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "休息日取得失敗",
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository) {
+				mockUserRepo.EXPECT().
+					GetRestDaysByUserID(gomock.Any(), testID).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserRepo := userDomain.NewMockUserRepository(ctrl)
+			mockEmailVerificationRepo := userDomain.NewMockEmailVerificationRepository(ctrl)
+			mockTransactionManager := transaction.NewMockITransactionManager(ctrl)
+			mockHasher := userDomain.NewMockIHasher(ctrl)
+			mockEmailSender := NewMockiEmailSender(ctrl)
+			mockTokenGenerator := NewMockiTokenGenerator(ctrl)
+			mockCryptoService, _ := userDomain.NewCryptoService("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+			usecase := NewUserUsecase(
+				mockUserRepo,
+				mockEmailVerificationRepo,
+				mockTransactionManager,
+				mockCryptoService,
+				mockHasher,
+				mockEmailSender,
+				mockTokenGenerator,
+			)
+
+			tt.mockFunc(mockUserRepo)
+			got, err := usecase.GetRestDays(context.Background(), testID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetRestDays() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetRestDays() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_UpdateRestDays(t *testing.T) {
+	testID := "test-id"
+
+	tests := []struct {
+		name     string
+		input    UpdateRestDaysInput
+		mockFunc func(*userDomain.MockUserRepository, *transaction.MockITransactionManager)
+		want     *UpdateRestDaysOutput
+		wantErr  bool
+	}{
+		{
+			name: "休息日更新成功",
+			input: UpdateRestDaysInput{
+				UserID:   testID,
+				Weekdays: []int{6},
+				Dates:    []string{"2024-05-03", "2024-05-06"},
+			},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository, mockTransactionManager *transaction.MockITransactionManager) {
+				wantRestDays := &userDomain.RestDays{
+					UserID:   testID,
+					Weekdays: []time.Weekday{time.Saturday},
+					Dates: []time.Time{
+						time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC),
+						time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC),
+					},
+				}
+				gomock.InOrder(
+					mockTransactionManager.EXPECT().
+						RunInTransaction(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+							return fn(ctx)
+						}).
+						Times(1),
+
+					mockUserRepo.EXPECT().
+						UpdateRestDays(gomock.Any(), wantRestDays).
+						Return(nil).
+						Times(1),
+				)
+			},
+			want: &UpdateRestDaysOutput{
+				Weekdays: []int{6},
+				Dates:    []string{"2024-05-03", "2024-05-06"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "日付の形式が不正",
+			input: UpdateRestDaysInput{
+				UserID:   testID,
+				Weekdays: []int{},
+				Dates:    []string{"2024/05/03"},
+			},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository, mockTransactionManager *transaction.MockITransactionManager) {
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "全ての曜日を休息日に指定",
+			input: UpdateRestDaysInput{
+				UserID:   testID,
+				Weekdays: []int{0, 1, 2, 3, 4, 5, 6},
+				Dates:    []string{},
+			},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository, mockTransactionManager *transaction.MockITransactionManager) {
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "休息日更新失敗",
+			input: UpdateRestDaysInput{
+				UserID:   testID,
+				Weekdays: []int{0},
+				Dates:    []string{},
+			},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository, mockTransactionManager *transaction.MockITransactionManager) {
+				gomock.InOrder(
+					mockTransactionManager.EXPECT().
+						RunInTransaction(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+							return fn(ctx)
+						}).
+						Times(1),
+
+					mockUserRepo.EXPECT().
+						UpdateRestDays(gomock.Any(), gomock.Any()).
+						Return(errors.New("update failed")).
+						Times(1),
+				)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserRepo := userDomain.NewMockUserRepository(ctrl)
+			mockEmailVerificationRepo := userDomain.NewMockEmailVerificationRepository(ctrl)
+			mockTransactionManager := transaction.NewMockITransactionManager(ctrl)
+			mockHasher := userDomain.NewMockIHasher(ctrl)
+			mockEmailSender := NewMockiEmailSender(ctrl)
+			mockTokenGenerator := NewMockiTokenGenerator(ctrl)
+			mockCryptoService, _ := userDomain.NewCryptoService("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+			usecase := NewUserUsecase(
+				mockUserRepo,
+				mockEmailVerificationRepo,
+				mockTransactionManager,
+				mockCryptoService,
+				mockHasher,
+				mockEmailSender,
+				mockTokenGenerator,
+			)
+
+			tt.mockFunc(mockUserRepo, mockTransactionManager)
+			got, err := usecase.UpdateRestDays(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpdateRestDays() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("UpdateRestDays() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_GetReviewLimit(t *testing.T) {
+	testID := "test-id"
+
+	tests := []struct {
+		name     string
+		mockFunc func(*userDomain.MockUserRepository)
+		want     *GetReviewLimitOutput
+		wantErr  bool
+	}{
+		{
+			name: "1日の最大復習数取得成功",
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository) {
+				mockUserRepo.EXPECT().
+					GetReviewLimitByUserID(gomock.Any(), testID).
+					Return(&userDomain.ReviewLimit{UserID: testID, MaxReviewsPerDay: 30}, nil).
+					Times(1)
+			},
+			want:    &GetReviewLimitOutput{MaxReviewsPerDay: 30},
+			wantErr: false,
+		},
+		{
+			name: "1日の最大復習数取得失敗",
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository) {
+				mockUserRepo.EXPECT().
+					GetReviewLimitByUserID(gomock.Any(), testID).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserRepo := userDomain.NewMockUserRepository(ctrl)
+			mockEmailVerificationRepo := userDomain.NewMockEmailVerificationRepository(ctrl)
+			mockTransactionManager := transaction.NewMockITransactionManager(ctrl)
+			mockHasher := userDomain.NewMockIHasher(ctrl)
+			mockEmailSender := NewMockiEmailSender(ctrl)
+			mockTokenGenerator := NewMockiTokenGenerator(ctrl)
+			mockCryptoService, _ := userDomain.NewCryptoService("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+			usecase := NewUserUsecase(
+				mockUserRepo,
+				mockEmailVerificationRepo,
+				mockTransactionManager,
+				mockCryptoService,
+				mockHasher,
+				mockEmailSender,
+				mockTokenGenerator,
+			)
+
+			tt.mockFunc(mockUserRepo)
+			got, err := usecase.GetReviewLimit(context.Background(), testID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetReviewLimit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetReviewLimit() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_UpdateReviewLimit(t *testing.T) {
+	testID := "test-id"
+
+	tests := []struct {
+		name     string
+		input    UpdateReviewLimitInput
+		mockFunc func(*userDomain.MockUserRepository)
+		want     *UpdateReviewLimitOutput
+		wantErr  bool
+	}{
+		{
+			name:  "1日の最大復習数更新成功",
+			input: UpdateReviewLimitInput{UserID: testID, MaxReviewsPerDay: 50},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository) {
+				mockUserRepo.EXPECT().
+					UpdateReviewLimit(gomock.Any(), &userDomain.ReviewLimit{UserID: testID, MaxReviewsPerDay: 50}).
+					Return(nil).
+					Times(1)
+			},
+			want:    &UpdateReviewLimitOutput{MaxReviewsPerDay: 50},
+			wantErr: false,
+		},
+		{
+			name:     "負の値を指定",
+			input:    UpdateReviewLimitInput{UserID: testID, MaxReviewsPerDay: -1},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository) {},
+			want:     nil,
+			wantErr:  true,
+		},
+		{
+			name:  "1日の最大復習数更新失敗",
+			input: UpdateReviewLimitInput{UserID: testID, MaxReviewsPerDay: 50},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository) {
+				mockUserRepo.EXPECT().
+					UpdateReviewLimit(gomock.Any(), gomock.Any()).
+					Return(errors.New("update failed")).
+					Times(1)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserRepo := userDomain.NewMockUserRepository(ctrl)
+			mockEmailVerificationRepo := userDomain.NewMockEmailVerificationRepository(ctrl)
+			mockTransactionManager := transaction.NewMockITransactionManager(ctrl)
+			mockHasher := userDomain.NewMockIHasher(ctrl)
+			mockEmailSender := NewMockiEmailSender(ctrl)
+			mockTokenGenerator := NewMockiTokenGenerator(ctrl)
+			mockCryptoService, _ := userDomain.NewCryptoService("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+			usecase := NewUserUsecase(
+				mockUserRepo,
+				mockEmailVerificationRepo,
+				mockTransactionManager,
+				mockCryptoService,
+				mockHasher,
+				mockEmailSender,
+				mockTokenGenerator,
+			)
+
+			tt.mockFunc(mockUserRepo)
+			got, err := usecase.UpdateReviewLimit(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpdateReviewLimit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("UpdateReviewLimit() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_PreviewVacation(t *testing.T) {
+	testID := "test-id"
+	startDate := time.Date(2025, 8, 4, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 8, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    CreateVacationInput
+		mockFunc func(*userDomain.MockUserRepository)
+		want     *PreviewVacationOutput
+		wantErr  error
+	}{
+		{
+			name: "休暇の見積もり成功",
+			input: CreateVacationInput{
+				UserID:    testID,
+				StartDate: "2025-08-04",
+				EndDate:   "2025-08-05",
+				Today:     "2025-08-01",
+			},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository) {
+				gomock.InOrder(
+					mockUserRepo.EXPECT().
+						HasOverlappingVacation(gomock.Any(), testID, startDate, endDate).
+						Return(false, nil).
+						Times(1),
+					mockUserRepo.EXPECT().
+						CountIncompleteReviewDatesFromDate(gomock.Any(), testID, startDate).
+						Return(map[string]int{"2025-08-04": 2, "2025-08-06": 1}, nil).
+						Times(1),
+					mockUserRepo.EXPECT().
+						GetRestDaysByUserID(gomock.Any(), testID).
+						Return(&userDomain.RestDays{UserID: testID}, nil).
+						Times(1),
+				)
+			},
+			want: &PreviewVacationOutput{
+				StartDate: "2025-08-04",
+				EndDate:   "2025-08-05",
+				ShiftDays: 2,
+				DailyLoads: []VacationDailyLoadOutput{
+					{Date: "2025-08-04", Before: 2, After: 0},
+					{Date: "2025-08-05", Before: 0, After: 0},
+					{Date: "2025-08-06", Before: 1, After: 2},
+					{Date: "2025-08-07", Before: 0, After: 0},
+				},
+			},
+		},
+		{
+			name: "登録済みの休暇と重複",
+			input: CreateVacationInput{
+				UserID:    testID,
+				StartDate: "2025-08-04",
+				EndDate:   "2025-08-05",
+				Today:     "2025-08-01",
+			},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository) {
+				mockUserRepo.EXPECT().
+					HasOverlappingVacation(gomock.Any(), testID, startDate, endDate).
+					Return(true, nil).
+					Times(1)
+			},
+			want:    nil,
+			wantErr: userDomain.ErrVacationOverlapped,
+		},
+		{
+			name: "開始日が今日より前",
+			input: CreateVacationInput{
+				UserID:    testID,
+				StartDate: "2025-07-31",
+				EndDate:   "2025-08-05",
+				Today:     "2025-08-01",
+			},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository) {},
+			want:     nil,
+			wantErr:  errors.New("休暇の開始日は今日以降で指定してください"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserRepo := userDomain.NewMockUserRepository(ctrl)
+			mockEmailVerificationRepo := userDomain.NewMockEmailVerificationRepository(ctrl)
+			mockTransactionManager := transaction.NewMockITransactionManager(ctrl)
+			mockHasher := userDomain.NewMockIHasher(ctrl)
+			mockEmailSender := NewMockiEmailSender(ctrl)
+			mockTokenGenerator := NewMockiTokenGenerator(ctrl)
+			mockCryptoService, _ := userDomain.NewCryptoService("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+			usecase := NewUserUsecase(
+				mockUserRepo,
+				mockEmailVerificationRepo,
+				mockTransactionManager,
+				mockCryptoService,
+				mockHasher,
+				mockEmailSender,
+				mockTokenGenerator,
+			)
+
+			tt.mockFunc(mockUserRepo)
+			got, err := usecase.PreviewVacation(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Fatalf("PreviewVacation() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PreviewVacation() unexpected error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("PreviewVacation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_CreateVacation(t *testing.T) {
+	testID := "test-id"
+	startDate := time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 8, 16, 0, 0, 0, 0, time.UTC)
+	input := CreateVacationInput{
+		UserID:    testID,
+		StartDate: "2025-08-10",
+		EndDate:   "2025-08-16",
+		Today:     "2025-08-01",
+	}
+
+	tests := []struct {
+		name     string
+		input    CreateVacationInput
+		mockFunc func(*userDomain.MockUserRepository, *transaction.MockITransactionManager)
+		want     *CreateVacationOutput
+		wantErr  bool
+	}{
+		{
+			name:  "休暇登録成功",
+			input: input,
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository, mockTransactionManager *transaction.MockITransactionManager) {
+				gomock.InOrder(
+					mockTransactionManager.EXPECT().
+						RunInTransaction(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+							return fn(ctx)
+						}).
+						Times(1),
+					mockUserRepo.EXPECT().
+						HasOverlappingVacation(gomock.Any(), testID, startDate, endDate).
+						Return(false, nil).
+						Times(1),
+					mockUserRepo.EXPECT().
+						CreateVacation(gomock.Any(), gomock.Any()).
+						Return(nil).
+						Times(1),
+					mockUserRepo.EXPECT().
+						ShiftIncompleteReviewDatesFromDate(gomock.Any(), testID, startDate, 7).
+						Return(nil).
+						Times(1),
+				)
+			},
+			want: &CreateVacationOutput{
+				StartDate: "2025-08-10",
+				EndDate:   "2025-08-16",
+				ShiftDays: 7,
+			},
+			wantErr: false,
+		},
+		{
+			name:  "登録済みの休暇と重複",
+			input: input,
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository, mockTransactionManager *transaction.MockITransactionManager) {
+				gomock.InOrder(
+					mockTransactionManager.EXPECT().
+						RunInTransaction(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+							return fn(ctx)
+						}).
+						Times(1),
+					mockUserRepo.EXPECT().
+						HasOverlappingVacation(gomock.Any(), testID, startDate, endDate).
+						Return(true, nil).
+						Times(1),
+				)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:  "復習日のずらしに失敗",
+			input: input,
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository, mockTransactionManager *transaction.MockITransactionManager) {
+				gomock.InOrder(
+					mockTransactionManager.EXPECT().
+						RunInTransaction(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+							return fn(ctx)
+						}).
+						Times(1),
+					mockUserRepo.EXPECT().
+						HasOverlappingVacation(gomock.Any(), testID, startDate, endDate).
+						Return(false, nil).
+						Times(1),
+					mockUserRepo.EXPECT().
+						CreateVacation(gomock.Any(), gomock.Any()).
+						Return(nil).
+						Times(1),
+					mockUserRepo.EXPECT().
+						ShiftIncompleteReviewDatesFromDate(gomock.Any(), testID, startDate, 7).
+						Return(errors.New("update failed")).
+						Times(1),
+				)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "日付の形式が不正",
+			input: CreateVacationInput{
+				UserID:    testID,
+				StartDate: "2025/08/10",
+				EndDate:   "2025-08-16",
+				Today:     "2025-08-01",
+			},
+			mockFunc: func(mockUserRepo *userDomain.MockUserRepository, mockTransactionManager *transaction.MockITransactionManager) {
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserRepo := userDomain.NewMockUserRepository(ctrl)
+			mockEmailVerificationRepo := userDomain.NewMockEmailVerificationRepository(ctrl)
+			mockTransactionManager := transaction.NewMockITransactionManager(ctrl)
+			mockHasher := userDomain.NewMockIHasher(ctrl)
+			mockEmailSender := NewMockiEmailSender(ctrl)
+			mockTokenGenerator := NewMockiTokenGenerator(ctrl)
+			mockCryptoService, _ := userDomain.NewCryptoService("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+			usecase := NewUserUsecase(
+				mockUserRepo,
+				mockEmailVerificationRepo,
+				mockTransactionManager,
+				mockCryptoService,
+				mockHasher,
+				mockEmailSender,
+				mockTokenGenerator,
+			)
+
+			tt.mockFunc(mockUserRepo, mockTransactionManager)
+			got, err := usecase.CreateVacation(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateVacation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// 休暇IDはランダムに生成されるため比較しない
+			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreFields(CreateVacationOutput{}, "VacationID")); diff != "" {
+				t.Errorf("CreateVacation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}